@@ -0,0 +1,8121 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: transaction.proto
+
+package transaction
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Тип транзакции: доход или расход
+type TransactionType int32
+
+const (
+	TransactionType_TRANSACTION_TYPE_UNSPECIFIED TransactionType = 0
+	TransactionType_INCOME                       TransactionType = 1 // Доход
+	TransactionType_EXPENSE                      TransactionType = 2 // Расход
+	TransactionType_TRANSFER                     TransactionType = 3 // Перевод между своими счетами: не доход и не расход
+)
+
+// Enum value maps for TransactionType.
+var (
+	TransactionType_name = map[int32]string{
+		0: "TRANSACTION_TYPE_UNSPECIFIED",
+		1: "INCOME",
+		2: "EXPENSE",
+		3: "TRANSFER",
+	}
+	TransactionType_value = map[string]int32{
+		"TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"INCOME":                       1,
+		"EXPENSE":                      2,
+		"TRANSFER":                     3,
+	}
+)
+
+func (x TransactionType) Enum() *TransactionType {
+	p := new(TransactionType)
+	*p = x
+	return p
+}
+
+func (x TransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionType) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[0]
+}
+
+func (x TransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionType.Descriptor instead.
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+// Поле сортировки списка транзакций
+type TransactionSortField int32
+
+const (
+	TransactionSortField_TRANSACTION_SORT_FIELD_UNSPECIFIED TransactionSortField = 0 // По дате операции
+	TransactionSortField_TRANSACTION_SORT_FIELD_DATE        TransactionSortField = 1 // По дате операции
+	TransactionSortField_TRANSACTION_SORT_FIELD_AMOUNT      TransactionSortField = 2 // По сумме
+	TransactionSortField_TRANSACTION_SORT_FIELD_CREATED_AT  TransactionSortField = 3 // По дате создания записи
+)
+
+// Enum value maps for TransactionSortField.
+var (
+	TransactionSortField_name = map[int32]string{
+		0: "TRANSACTION_SORT_FIELD_UNSPECIFIED",
+		1: "TRANSACTION_SORT_FIELD_DATE",
+		2: "TRANSACTION_SORT_FIELD_AMOUNT",
+		3: "TRANSACTION_SORT_FIELD_CREATED_AT",
+	}
+	TransactionSortField_value = map[string]int32{
+		"TRANSACTION_SORT_FIELD_UNSPECIFIED": 0,
+		"TRANSACTION_SORT_FIELD_DATE":        1,
+		"TRANSACTION_SORT_FIELD_AMOUNT":      2,
+		"TRANSACTION_SORT_FIELD_CREATED_AT":  3,
+	}
+)
+
+func (x TransactionSortField) Enum() *TransactionSortField {
+	p := new(TransactionSortField)
+	*p = x
+	return p
+}
+
+func (x TransactionSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionSortField) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[1]
+}
+
+func (x TransactionSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionSortField.Descriptor instead.
+func (TransactionSortField) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+// Шаг периодов отчёта
+type ReportGranularity int32
+
+const (
+	ReportGranularity_REPORT_GRANULARITY_UNSPECIFIED ReportGranularity = 0 // По месяцам
+	ReportGranularity_REPORT_GRANULARITY_DAY         ReportGranularity = 1
+	ReportGranularity_REPORT_GRANULARITY_WEEK        ReportGranularity = 2 // Неделя начинается с понедельника
+	ReportGranularity_REPORT_GRANULARITY_MONTH       ReportGranularity = 3
+	ReportGranularity_REPORT_GRANULARITY_YEAR        ReportGranularity = 4
+)
+
+// Enum value maps for ReportGranularity.
+var (
+	ReportGranularity_name = map[int32]string{
+		0: "REPORT_GRANULARITY_UNSPECIFIED",
+		1: "REPORT_GRANULARITY_DAY",
+		2: "REPORT_GRANULARITY_WEEK",
+		3: "REPORT_GRANULARITY_MONTH",
+		4: "REPORT_GRANULARITY_YEAR",
+	}
+	ReportGranularity_value = map[string]int32{
+		"REPORT_GRANULARITY_UNSPECIFIED": 0,
+		"REPORT_GRANULARITY_DAY":         1,
+		"REPORT_GRANULARITY_WEEK":        2,
+		"REPORT_GRANULARITY_MONTH":       3,
+		"REPORT_GRANULARITY_YEAR":        4,
+	}
+)
+
+func (x ReportGranularity) Enum() *ReportGranularity {
+	p := new(ReportGranularity)
+	*p = x
+	return p
+}
+
+func (x ReportGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[2].Descriptor()
+}
+
+func (ReportGranularity) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[2]
+}
+
+func (x ReportGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportGranularity.Descriptor instead.
+func (ReportGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+// Периодичность повторяющейся транзакции
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY       RecurrenceFrequency = 1
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY      RecurrenceFrequency = 2
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY     RecurrenceFrequency = 3 // Если в месяце нет такого дня, берётся последний день месяца
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY      RecurrenceFrequency = 4
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKLY",
+		3: "RECURRENCE_FREQUENCY_MONTHLY",
+		4: "RECURRENCE_FREQUENCY_YEARLY",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKLY":      2,
+		"RECURRENCE_FREQUENCY_MONTHLY":     3,
+		"RECURRENCE_FREQUENCY_YEARLY":      4,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[3].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[3]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+// Формат выгрузки
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // CSV
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_JSON        ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_OFX         ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSON",
+		3: "EXPORT_FORMAT_OFX",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSON":        2,
+		"EXPORT_FORMAT_OFX":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[4]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+// Роль участника общего бюджета
+type HouseholdRole int32
+
+const (
+	HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED HouseholdRole = 0
+	HouseholdRole_HOUSEHOLD_ROLE_OWNER       HouseholdRole = 1 // Владелец: управляет участниками и приглашениями
+	HouseholdRole_HOUSEHOLD_ROLE_EDITOR      HouseholdRole = 2 // Добавляет и меняет свои транзакции
+	HouseholdRole_HOUSEHOLD_ROLE_VIEWER      HouseholdRole = 3 // Только просмотр
+)
+
+// Enum value maps for HouseholdRole.
+var (
+	HouseholdRole_name = map[int32]string{
+		0: "HOUSEHOLD_ROLE_UNSPECIFIED",
+		1: "HOUSEHOLD_ROLE_OWNER",
+		2: "HOUSEHOLD_ROLE_EDITOR",
+		3: "HOUSEHOLD_ROLE_VIEWER",
+	}
+	HouseholdRole_value = map[string]int32{
+		"HOUSEHOLD_ROLE_UNSPECIFIED": 0,
+		"HOUSEHOLD_ROLE_OWNER":       1,
+		"HOUSEHOLD_ROLE_EDITOR":      2,
+		"HOUSEHOLD_ROLE_VIEWER":      3,
+	}
+)
+
+func (x HouseholdRole) Enum() *HouseholdRole {
+	p := new(HouseholdRole)
+	*p = x
+	return p
+}
+
+func (x HouseholdRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HouseholdRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[5].Descriptor()
+}
+
+func (HouseholdRole) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[5]
+}
+
+func (x HouseholdRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HouseholdRole.Descriptor instead.
+func (HouseholdRole) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+// Тип счёта
+type AccountType int32
+
+const (
+	AccountType_ACCOUNT_TYPE_UNSPECIFIED AccountType = 0
+	AccountType_ACCOUNT_TYPE_CASH        AccountType = 1 // Наличные
+	AccountType_ACCOUNT_TYPE_CARD        AccountType = 2 // Дебетовая карта или расчётный счёт
+	AccountType_ACCOUNT_TYPE_SAVINGS     AccountType = 3 // Накопительный счёт или вклад
+	AccountType_ACCOUNT_TYPE_CREDIT      AccountType = 4 // Кредитная карта; остаток может быть отрицательным
+	AccountType_ACCOUNT_TYPE_OTHER       AccountType = 5
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "ACCOUNT_TYPE_UNSPECIFIED",
+		1: "ACCOUNT_TYPE_CASH",
+		2: "ACCOUNT_TYPE_CARD",
+		3: "ACCOUNT_TYPE_SAVINGS",
+		4: "ACCOUNT_TYPE_CREDIT",
+		5: "ACCOUNT_TYPE_OTHER",
+	}
+	AccountType_value = map[string]int32{
+		"ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"ACCOUNT_TYPE_CASH":        1,
+		"ACCOUNT_TYPE_CARD":        2,
+		"ACCOUNT_TYPE_SAVINGS":     3,
+		"ACCOUNT_TYPE_CREDIT":      4,
+		"ACCOUNT_TYPE_OTHER":       5,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[6].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[6]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+// Направление займа
+type LoanDirection int32
+
+const (
+	LoanDirection_LOAN_DIRECTION_UNSPECIFIED LoanDirection = 0
+	LoanDirection_LOAN_DIRECTION_LENT        LoanDirection = 1 // Мы дали в долг
+	LoanDirection_LOAN_DIRECTION_BORROWED    LoanDirection = 2 // Мы взяли в долг
+)
+
+// Enum value maps for LoanDirection.
+var (
+	LoanDirection_name = map[int32]string{
+		0: "LOAN_DIRECTION_UNSPECIFIED",
+		1: "LOAN_DIRECTION_LENT",
+		2: "LOAN_DIRECTION_BORROWED",
+	}
+	LoanDirection_value = map[string]int32{
+		"LOAN_DIRECTION_UNSPECIFIED": 0,
+		"LOAN_DIRECTION_LENT":        1,
+		"LOAN_DIRECTION_BORROWED":    2,
+	}
+)
+
+func (x LoanDirection) Enum() *LoanDirection {
+	p := new(LoanDirection)
+	*p = x
+	return p
+}
+
+func (x LoanDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoanDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[7].Descriptor()
+}
+
+func (LoanDirection) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[7]
+}
+
+func (x LoanDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanDirection.Descriptor instead.
+func (LoanDirection) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+// Вид графика платежей
+type LoanSchedule int32
+
+const (
+	LoanSchedule_LOAN_SCHEDULE_UNSPECIFIED    LoanSchedule = 0
+	LoanSchedule_LOAN_SCHEDULE_ANNUITY        LoanSchedule = 1 // Равные платежи
+	LoanSchedule_LOAN_SCHEDULE_DIFFERENTIATED LoanSchedule = 2 // Равные доли основного долга, проценты на остаток
+)
+
+// Enum value maps for LoanSchedule.
+var (
+	LoanSchedule_name = map[int32]string{
+		0: "LOAN_SCHEDULE_UNSPECIFIED",
+		1: "LOAN_SCHEDULE_ANNUITY",
+		2: "LOAN_SCHEDULE_DIFFERENTIATED",
+	}
+	LoanSchedule_value = map[string]int32{
+		"LOAN_SCHEDULE_UNSPECIFIED":    0,
+		"LOAN_SCHEDULE_ANNUITY":        1,
+		"LOAN_SCHEDULE_DIFFERENTIATED": 2,
+	}
+)
+
+func (x LoanSchedule) Enum() *LoanSchedule {
+	p := new(LoanSchedule)
+	*p = x
+	return p
+}
+
+func (x LoanSchedule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoanSchedule) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_proto_enumTypes[8].Descriptor()
+}
+
+func (LoanSchedule) Type() protoreflect.EnumType {
+	return &file_transaction_proto_enumTypes[8]
+}
+
+func (x LoanSchedule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanSchedule.Descriptor instead.
+func (LoanSchedule) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+// Запрос на добавление транзакции
+type AddTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // ID пользователя
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                // ID категории
+	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"` // Тип: доход или расход
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`                                        // Сумма
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                // Описание
+	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                                              // Дата транзакции
+	AmountDecimal string                 `protobuf:"bytes,7,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`       // Точная сумма строкой ("1234.50"); если задана, amount не используется
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                      // Код валюты ISO 4217 ("RUB", "USD", "EUR"), по умолчанию RUB
+	HouseholdId   string                 `protobuf:"bytes,9,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`             // Общий бюджет; нужна роль owner или editor. Пусто — личная транзакция
+	// Счёт списания (для дохода — зачисления). Пусто — основной счёт в валюте транзакции
+	AccountId string `protobuf:"bytes,10,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Для TRANSFER: счёт зачисления и сумма зачисления в его валюте.
+	// Сумма обязательна, только если валюты счетов различаются
+	TransferAccountId     string `protobuf:"bytes,11,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id,omitempty"`
+	TransferAmountDecimal string `protobuf:"bytes,12,opt,name=transfer_amount_decimal,json=transferAmountDecimal,proto3" json:"transfer_amount_decimal,omitempty"`
+	GoalId                string `protobuf:"bytes,13,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"` // Взнос в цель накоплений
+	LoanId                string `protobuf:"bytes,14,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"` // Погашение займа: INCOME по выданному, EXPENSE по полученному
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AddTransactionRequest) Reset() {
+	*x = AddTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionRequest) ProtoMessage() {}
+
+func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *AddTransactionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AddTransactionRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetTransferAccountId() string {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetTransferAmountDecimal() string {
+	if x != nil {
+		return x.TransferAmountDecimal
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *AddTransactionRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// Ответ на добавление транзакции
+type AddTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTransactionResponse) Reset() {
+	*x = AddTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTransactionResponse) ProtoMessage() {}
+
+func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
+func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddTransactionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// Запрос на получение списка транзакций
+type ListTransactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID пользователя
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                // Кол-во записей (для пагинации)
+	Offset int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`              // Смещение (для пагинации)
+	// Фильтры (пустые значения не применяются)
+	DateFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                      // Дата операции с (включительно)
+	DateTo      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                            // Дата операции по (включительно)
+	Type        TransactionType        `protobuf:"varint,6,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"` // Тип: доход или расход
+	CategoryIds []string               `protobuf:"bytes,7,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`             // Любая из категорий
+	AmountMin   *float64               `protobuf:"fixed64,8,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`           // Минимальная сумма (для старых клиентов, см. amount_min_decimal)
+	AmountMax   *float64               `protobuf:"fixed64,9,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`           // Максимальная сумма (для старых клиентов, см. amount_max_decimal)
+	Search      string                 `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`                                         // Подстрока в описании (без учёта регистра)
+	// Сортировка
+	SortBy    TransactionSortField `protobuf:"varint,11,opt,name=sort_by,json=sortBy,proto3,enum=finplan.transaction.v1.TransactionSortField" json:"sort_by,omitempty"`
+	Ascending bool                 `protobuf:"varint,12,opt,name=ascending,proto3" json:"ascending,omitempty"` // По возрастанию (по умолчанию — по убыванию)
+	// Курсор из next_page_token предыдущего ответа. Если задан, offset не используется
+	PageToken string `protobuf:"bytes,13,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Общий бюджет: транзакции всех участников (автор — в user_id).
+	// Пусто — личные транзакции пользователя
+	HouseholdId string `protobuf:"bytes,14,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	AccountId   string `protobuf:"bytes,15,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Операции по счёту, включая входящие переводы
+	GoalId      string `protobuf:"bytes,16,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`          // Взносы в цель
+	LoanId      string `protobuf:"bytes,17,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`          // Погашения займа
+	// Точные границы суммы строкой ("1234.50"); если заданы, amount_min и amount_max не используются
+	AmountMinDecimal string `protobuf:"bytes,18,opt,name=amount_min_decimal,json=amountMinDecimal,proto3" json:"amount_min_decimal,omitempty"`
+	AmountMaxDecimal string `protobuf:"bytes,19,opt,name=amount_max_decimal,json=amountMaxDecimal,proto3" json:"amount_max_decimal,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetAmountMin() float64 {
+	if x != nil && x.AmountMin != nil {
+		return *x.AmountMin
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetAmountMax() float64 {
+	if x != nil && x.AmountMax != nil {
+		return *x.AmountMax
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetSortBy() TransactionSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return TransactionSortField_TRANSACTION_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetAmountMinDecimal() string {
+	if x != nil {
+		return x.AmountMinDecimal
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetAmountMaxDecimal() string {
+	if x != nil {
+		return x.AmountMaxDecimal
+	}
+	return ""
+}
+
+// Ответ с списком транзакций
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // Всего записей, подходящих под фильтры
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Курсор следующей страницы (пусто, если страниц больше нет)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Запрос на удаление транзакции
+type DeleteTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *DeleteTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Ответ на удаление транзакции
+type DeleteTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Успех удаления
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // Описание результата
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionResponse) Reset() {
+	*x = DeleteTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTransactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на частичное обновление транзакции.
+// Меняются только поля из update_mask: category_id, type, amount (или amount_decimal), currency, description, date
+type UpdateTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`                 // Новые значения полей
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Список изменяемых полей
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *UpdateTransactionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Ответ с обновлённой транзакцией
+type UpdateTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionResponse) ProtoMessage() {}
+
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Запрос на получение баланса по пользователю
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ID пользователя
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // Баланс общего бюджета (нужно участие); пусто — личный баланс
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// Ответ с балансом пользователя.
+// Итоговые суммы пересчитаны в валюту профиля (поле currency)
+type GetBalanceResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	IncomeTotal  float64                `protobuf:"fixed64,1,opt,name=income_total,json=incomeTotal,proto3" json:"income_total,omitempty"`    // Сумма всех доходов
+	ExpenseTotal float64                `protobuf:"fixed64,2,opt,name=expense_total,json=expenseTotal,proto3" json:"expense_total,omitempty"` // Сумма всех расходов
+	Balance      float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`                               // Баланс: доходы - расходы
+	// Те же суммы точной десятичной строкой ("1234.50")
+	IncomeTotalDecimal  string             `protobuf:"bytes,4,opt,name=income_total_decimal,json=incomeTotalDecimal,proto3" json:"income_total_decimal,omitempty"`
+	ExpenseTotalDecimal string             `protobuf:"bytes,5,opt,name=expense_total_decimal,json=expenseTotalDecimal,proto3" json:"expense_total_decimal,omitempty"`
+	BalanceDecimal      string             `protobuf:"bytes,6,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"`
+	Currency            string             `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                       // Валюта профиля, в которую пересчитаны итоги
+	ByCurrency          []*CurrencyBalance `protobuf:"bytes,8,rep,name=by_currency,json=byCurrency,proto3" json:"by_currency,omitempty"` // Итоги в исходных валютах без пересчёта
+	Accounts            []*Account         `protobuf:"bytes,9,rep,name=accounts,proto3" json:"accounts,omitempty"`                       // Остатки по счетам (только у личного баланса)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBalanceResponse) GetIncomeTotal() float64 {
+	if x != nil {
+		return x.IncomeTotal
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetExpenseTotal() float64 {
+	if x != nil {
+		return x.ExpenseTotal
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetIncomeTotalDecimal() string {
+	if x != nil {
+		return x.IncomeTotalDecimal
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetExpenseTotalDecimal() string {
+	if x != nil {
+		return x.ExpenseTotalDecimal
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetByCurrency() []*CurrencyBalance {
+	if x != nil {
+		return x.ByCurrency
+	}
+	return nil
+}
+
+func (x *GetBalanceResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type GetNetWorthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthRequest) Reset() {
+	*x = GetNetWorthRequest{}
+	mi := &file_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthRequest) ProtoMessage() {}
+
+func (x *GetNetWorthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthRequest.ProtoReflect.Descriptor instead.
+func (*GetNetWorthRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNetWorthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Чистая стоимость в валюте профиля (поле currency)
+type GetNetWorthResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	AccountsDecimal string                 `protobuf:"bytes,2,opt,name=accounts_decimal,json=accountsDecimal,proto3" json:"accounts_decimal,omitempty"`   // Сумма остатков по открытым счетам
+	LentDecimal     string                 `protobuf:"bytes,3,opt,name=lent_decimal,json=lentDecimal,proto3" json:"lent_decimal,omitempty"`               // Остаток выданных займов — нам должны
+	BorrowedDecimal string                 `protobuf:"bytes,4,opt,name=borrowed_decimal,json=borrowedDecimal,proto3" json:"borrowed_decimal,omitempty"`   // Остаток полученных займов — мы должны
+	NetWorthDecimal string                 `protobuf:"bytes,5,opt,name=net_worth_decimal,json=netWorthDecimal,proto3" json:"net_worth_decimal,omitempty"` // accounts + lent - borrowed
+	Accounts        []*Account             `protobuf:"bytes,6,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Loans           []*Loan                `protobuf:"bytes,7,rep,name=loans,proto3" json:"loans,omitempty"` // Непогашенные займы
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNetWorthResponse) Reset() {
+	*x = GetNetWorthResponse{}
+	mi := &file_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthResponse) ProtoMessage() {}
+
+func (x *GetNetWorthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthResponse.ProtoReflect.Descriptor instead.
+func (*GetNetWorthResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetNetWorthResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetNetWorthResponse) GetAccountsDecimal() string {
+	if x != nil {
+		return x.AccountsDecimal
+	}
+	return ""
+}
+
+func (x *GetNetWorthResponse) GetLentDecimal() string {
+	if x != nil {
+		return x.LentDecimal
+	}
+	return ""
+}
+
+func (x *GetNetWorthResponse) GetBorrowedDecimal() string {
+	if x != nil {
+		return x.BorrowedDecimal
+	}
+	return ""
+}
+
+func (x *GetNetWorthResponse) GetNetWorthDecimal() string {
+	if x != nil {
+		return x.NetWorthDecimal
+	}
+	return ""
+}
+
+func (x *GetNetWorthResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetNetWorthResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+// Запрос отчёта за период
+type GetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // Начало периода (включительно)
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // Конец периода (включительно)
+	Granularity   ReportGranularity      `protobuf:"varint,4,opt,name=granularity,proto3,enum=finplan.transaction.v1.ReportGranularity" json:"granularity,omitempty"`
+	ByCategory    bool                   `protobuf:"varint,5,opt,name=by_category,json=byCategory,proto3" json:"by_category,omitempty"` // Добавить разбивку по категориям
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportRequest) Reset() {
+	*x = GetReportRequest{}
+	mi := &file_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportRequest) ProtoMessage() {}
+
+func (x *GetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReportRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReportRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetReportRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *GetReportRequest) GetGranularity() ReportGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return ReportGranularity_REPORT_GRANULARITY_UNSPECIFIED
+}
+
+func (x *GetReportRequest) GetByCategory() bool {
+	if x != nil {
+		return x.ByCategory
+	}
+	return false
+}
+
+// Отчёт: ряд периодов в валюте профиля
+type GetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Buckets       []*ReportBucket        `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportResponse) Reset() {
+	*x = GetReportResponse{}
+	mi := &file_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportResponse) ProtoMessage() {}
+
+func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReportResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReportResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetReportResponse) GetBuckets() []*ReportBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// Итоги одного периода
+type ReportBucket struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Начало периода
+	PeriodEnd             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Начало следующего периода (не включается)
+	IncomeDecimal         string                 `protobuf:"bytes,3,opt,name=income_decimal,json=incomeDecimal,proto3" json:"income_decimal,omitempty"`
+	ExpenseDecimal        string                 `protobuf:"bytes,4,opt,name=expense_decimal,json=expenseDecimal,proto3" json:"expense_decimal,omitempty"`
+	NetDecimal            string                 `protobuf:"bytes,5,opt,name=net_decimal,json=netDecimal,proto3" json:"net_decimal,omitempty"`                                    // Доходы - расходы за период
+	OpeningBalanceDecimal string                 `protobuf:"bytes,6,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"` // Остаток на начало периода
+	ClosingBalanceDecimal string                 `protobuf:"bytes,7,opt,name=closing_balance_decimal,json=closingBalanceDecimal,proto3" json:"closing_balance_decimal,omitempty"` // Остаток на конец периода
+	Categories            []*CategoryTotal       `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`                                                      // Только при by_category
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ReportBucket) Reset() {
+	*x = ReportBucket{}
+	mi := &file_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportBucket) ProtoMessage() {}
+
+func (x *ReportBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportBucket.ProtoReflect.Descriptor instead.
+func (*ReportBucket) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReportBucket) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *ReportBucket) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *ReportBucket) GetIncomeDecimal() string {
+	if x != nil {
+		return x.IncomeDecimal
+	}
+	return ""
+}
+
+func (x *ReportBucket) GetExpenseDecimal() string {
+	if x != nil {
+		return x.ExpenseDecimal
+	}
+	return ""
+}
+
+func (x *ReportBucket) GetNetDecimal() string {
+	if x != nil {
+		return x.NetDecimal
+	}
+	return ""
+}
+
+func (x *ReportBucket) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+func (x *ReportBucket) GetClosingBalanceDecimal() string {
+	if x != nil {
+		return x.ClosingBalanceDecimal
+	}
+	return ""
+}
+
+func (x *ReportBucket) GetCategories() []*CategoryTotal {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Суммы по категории за период
+type CategoryTotal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId     string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncomeDecimal  string                 `protobuf:"bytes,2,opt,name=income_decimal,json=incomeDecimal,proto3" json:"income_decimal,omitempty"`
+	ExpenseDecimal string                 `protobuf:"bytes,3,opt,name=expense_decimal,json=expenseDecimal,proto3" json:"expense_decimal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryTotal) Reset() {
+	*x = CategoryTotal{}
+	mi := &file_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTotal) ProtoMessage() {}
+
+func (x *CategoryTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTotal.ProtoReflect.Descriptor instead.
+func (*CategoryTotal) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CategoryTotal) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CategoryTotal) GetIncomeDecimal() string {
+	if x != nil {
+		return x.IncomeDecimal
+	}
+	return ""
+}
+
+func (x *CategoryTotal) GetExpenseDecimal() string {
+	if x != nil {
+		return x.ExpenseDecimal
+	}
+	return ""
+}
+
+// Итоги по одной валюте
+type CurrencyBalance struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	IncomeTotalDecimal  string                 `protobuf:"bytes,2,opt,name=income_total_decimal,json=incomeTotalDecimal,proto3" json:"income_total_decimal,omitempty"`
+	ExpenseTotalDecimal string                 `protobuf:"bytes,3,opt,name=expense_total_decimal,json=expenseTotalDecimal,proto3" json:"expense_total_decimal,omitempty"`
+	BalanceDecimal      string                 `protobuf:"bytes,4,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CurrencyBalance) Reset() {
+	*x = CurrencyBalance{}
+	mi := &file_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyBalance) ProtoMessage() {}
+
+func (x *CurrencyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyBalance.ProtoReflect.Descriptor instead.
+func (*CurrencyBalance) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CurrencyBalance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyBalance) GetIncomeTotalDecimal() string {
+	if x != nil {
+		return x.IncomeTotalDecimal
+	}
+	return ""
+}
+
+func (x *CurrencyBalance) GetExpenseTotalDecimal() string {
+	if x != nil {
+		return x.ExpenseTotalDecimal
+	}
+	return ""
+}
+
+func (x *CurrencyBalance) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+// Транзакция пользователя
+type Transaction struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                       // ID транзакции
+	UserId                string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                 // ID пользователя
+	CategoryId            string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                                     // ID категории
+	Type                  TransactionType        `protobuf:"varint,4,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"`                      // Тип: доход или расход
+	Amount                float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`                                                             // Сумма
+	Description           string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                                     // Описание
+	Date                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`                                                                   // Дата транзакции
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                        // Дата создания
+	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                        // Дата последнего изменения
+	AmountDecimal         string                 `protobuf:"bytes,10,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`                           // Точная сумма строкой ("1234.50")
+	Currency              string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`                                                          // Код валюты ISO 4217
+	HouseholdId           string                 `protobuf:"bytes,12,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`                                 // Общий бюджет (пусто у личных транзакций)
+	AccountId             string                 `protobuf:"bytes,13,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                       // Счёт списания или зачисления
+	TransferAccountId     string                 `protobuf:"bytes,14,opt,name=transfer_account_id,json=transferAccountId,proto3" json:"transfer_account_id,omitempty"`             // Для TRANSFER: счёт зачисления
+	TransferAmountDecimal string                 `protobuf:"bytes,15,opt,name=transfer_amount_decimal,json=transferAmountDecimal,proto3" json:"transfer_amount_decimal,omitempty"` // Для TRANSFER: сумма зачисления в валюте счёта
+	GoalId                string                 `protobuf:"bytes,16,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`                                                // Цель, в которую засчитан взнос
+	LoanId                string                 `protobuf:"bytes,17,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`                                                // Займ, который гасит транзакция
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Transaction) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Transaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Transaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Transaction) GetTransferAccountId() string {
+	if x != nil {
+		return x.TransferAccountId
+	}
+	return ""
+}
+
+func (x *Transaction) GetTransferAmountDecimal() string {
+	if x != nil {
+		return x.TransferAmountDecimal
+	}
+	return ""
+}
+
+func (x *Transaction) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *Transaction) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// Категория транзакций. Системные категории имеют пустой user_id
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // ID категории
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // ID владельца (пусто у системных)
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                              // Название
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                // Описание
+	Type          TransactionType        `protobuf:"varint,5,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"` // Тип: доход или расход
+	Archived      bool                   `protobuf:"varint,6,opt,name=archived,proto3" json:"archived,omitempty"`                                     // Архивирована ли категория
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // Дата создания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Category) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Запрос на создание категории
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type          TransactionType        `protobuf:"varint,4,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+// Ответ на создание категории
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateCategoryResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+// Запрос на получение категорий пользователя (вместе с системными)
+type ListCategoriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type            TransactionType        `protobuf:"varint,2,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"`  // Фильтр по типу (UNSPECIFIED — все)
+	IncludeArchived bool                   `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Показывать архивные
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_transaction_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListCategoriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListCategoriesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// Ответ со списком категорий
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_transaction_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Запрос на переименование категории
+type RenameCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryRequest) Reset() {
+	*x = RenameCategoryRequest{}
+	mi := &file_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryRequest) ProtoMessage() {}
+
+func (x *RenameCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RenameCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenameCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *RenameCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenameCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Ответ на переименование категории
+type RenameCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryResponse) Reset() {
+	*x = RenameCategoryResponse{}
+	mi := &file_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryResponse) ProtoMessage() {}
+
+func (x *RenameCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryResponse.ProtoReflect.Descriptor instead.
+func (*RenameCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RenameCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RenameCategoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на архивацию категории
+type ArchiveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCategoryRequest) Reset() {
+	*x = ArchiveCategoryRequest{}
+	mi := &file_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCategoryRequest) ProtoMessage() {}
+
+func (x *ArchiveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArchiveCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ArchiveCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Ответ на архивацию категории
+type ArchiveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveCategoryResponse) Reset() {
+	*x = ArchiveCategoryResponse{}
+	mi := &file_transaction_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCategoryResponse) ProtoMessage() {}
+
+func (x *ArchiveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArchiveCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ArchiveCategoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Месячный лимит расходов по категории
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	LimitDecimal  string                 `protobuf:"bytes,4,opt,name=limit_decimal,json=limitDecimal,proto3" json:"limit_decimal,omitempty"` // Лимит на месяц ("15000.00")
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                             // Валюта лимита; траты пересчитываются в неё
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_transaction_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Budget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Budget) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Budget) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *Budget) GetLimitDecimal() string {
+	if x != nil {
+		return x.LimitDecimal
+	}
+	return ""
+}
+
+func (x *Budget) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Budget) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Budget) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Исполнение бюджета за месяц
+type BudgetStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Budget           *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	SpentDecimal     string                 `protobuf:"bytes,2,opt,name=spent_decimal,json=spentDecimal,proto3" json:"spent_decimal,omitempty"`             // Потрачено за месяц
+	RemainingDecimal string                 `protobuf:"bytes,3,opt,name=remaining_decimal,json=remainingDecimal,proto3" json:"remaining_decimal,omitempty"` // Остаток лимита; отрицательный при перерасходе
+	Overspent        bool                   `protobuf:"varint,4,opt,name=overspent,proto3" json:"overspent,omitempty"`                                      // Траты превысили лимит
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_transaction_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BudgetStatus) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetSpentDecimal() string {
+	if x != nil {
+		return x.SpentDecimal
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetRemainingDecimal() string {
+	if x != nil {
+		return x.RemainingDecimal
+	}
+	return ""
+}
+
+func (x *BudgetStatus) GetOverspent() bool {
+	if x != nil {
+		return x.Overspent
+	}
+	return false
+}
+
+// Установка лимита. Если для категории уже есть бюджет, лимит заменяется
+type SetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	LimitDecimal  string                 `protobuf:"bytes,3,opt,name=limit_decimal,json=limitDecimal,proto3" json:"limit_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // По умолчанию RUB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetRequest) Reset() {
+	*x = SetBudgetRequest{}
+	mi := &file_transaction_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetRequest) ProtoMessage() {}
+
+func (x *SetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetLimitDecimal() string {
+	if x != nil {
+		return x.LimitDecimal
+	}
+	return ""
+}
+
+func (x *SetBudgetRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SetBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetResponse) Reset() {
+	*x = SetBudgetResponse{}
+	mi := &file_transaction_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetResponse) ProtoMessage() {}
+
+func (x *SetBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetBudgetResponse) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+// Бюджеты пользователя с исполнением за месяц
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Month         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // Любая дата месяца; по умолчанию текущий месяц
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_transaction_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBudgetsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBudgetsRequest) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*BudgetStatus        `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	Month         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"` // Первый день месяца, за который посчитаны траты
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_transaction_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*BudgetStatus {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *ListBudgetsResponse) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId      string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_transaction_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteBudgetRequest) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *DeleteBudgetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetResponse) Reset() {
+	*x = DeleteBudgetResponse{}
+	mi := &file_transaction_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteBudgetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteBudgetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Шаблон повторяющейся транзакции
+type RecurringTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Type          TransactionType        `protobuf:"varint,4,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,5,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     RecurrenceFrequency    `protobuf:"varint,8,opt,name=frequency,proto3,enum=finplan.transaction.v1.RecurrenceFrequency" json:"frequency,omitempty"`
+	Interval      int32                  `protobuf:"varint,9,opt,name=interval,proto3" json:"interval,omitempty"`                    // Каждые N периодов
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Дата первого повторения
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Не создавать повторения позже этой даты (необязательно)
+	Count         int32                  `protobuf:"varint,12,opt,name=count,proto3" json:"count,omitempty"`                         // Максимум повторений, 0 — без ограничения
+	NextDate      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_date,json=nextDate,proto3" json:"next_date,omitempty"`    // Дата следующего повторения
+	Occurrences   int32                  `protobuf:"varint,14,opt,name=occurrences,proto3" json:"occurrences,omitempty"`             // Сколько повторений уже создано или пропущено
+	Paused        bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	Finished      bool                   `protobuf:"varint,16,opt,name=finished,proto3" json:"finished,omitempty"` // Повторений больше не будет
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringTransaction) Reset() {
+	*x = RecurringTransaction{}
+	mi := &file_transaction_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringTransaction) ProtoMessage() {}
+
+func (x *RecurringTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringTransaction.ProtoReflect.Descriptor instead.
+func (*RecurringTransaction) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecurringTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *RecurringTransaction) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecurringTransaction) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RecurringTransaction) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetNextDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDate
+	}
+	return nil
+}
+
+func (x *RecurringTransaction) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *RecurringTransaction) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *RecurringTransaction) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *RecurringTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=finplan.transaction.v1.TransactionType" json:"type,omitempty"`
+	AmountDecimal string                 `protobuf:"bytes,4,opt,name=amount_decimal,json=amountDecimal,proto3" json:"amount_decimal,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Frequency     RecurrenceFrequency    `protobuf:"varint,7,opt,name=frequency,proto3,enum=finplan.transaction.v1.RecurrenceFrequency" json:"frequency,omitempty"`
+	Interval      int32                  `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"` // По умолчанию 1
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Count         int32                  `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringTransactionRequest) Reset() {
+	*x = CreateRecurringTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *CreateRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateRecurringTransactionRequest) GetAmountDecimal() string {
+	if x != nil {
+		return x.AmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRecurringTransactionRequest) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *CreateRecurringTransactionRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *CreateRecurringTransactionRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateRecurringTransactionRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateRecurringTransactionRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CreateRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringTransactionResponse) Reset() {
+	*x = CreateRecurringTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *CreateRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateRecurringTransactionResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type ListRecurringTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringTransactionsRequest) Reset() {
+	*x = ListRecurringTransactionsRequest{}
+	mi := &file_transaction_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringTransactionsRequest) ProtoMessage() {}
+
+func (x *ListRecurringTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListRecurringTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRecurringTransactionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Recurring     []*RecurringTransaction `protobuf:"bytes,1,rep,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringTransactionsResponse) Reset() {
+	*x = ListRecurringTransactionsResponse{}
+	mi := &file_transaction_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringTransactionsResponse) ProtoMessage() {}
+
+func (x *ListRecurringTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListRecurringTransactionsResponse) GetRecurring() []*RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+// Пауза или возобновление. После возобновления пропущенные за время паузы даты не создаются
+type PauseRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurringId   string                 `protobuf:"bytes,1,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRecurringTransactionRequest) Reset() {
+	*x = PauseRecurringTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *PauseRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PauseRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PauseRecurringTransactionRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *PauseRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PauseRecurringTransactionRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type PauseRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRecurringTransactionResponse) Reset() {
+	*x = PauseRecurringTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *PauseRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PauseRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PauseRecurringTransactionResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+// Пропуск ближайшего повторения без создания транзакции
+type SkipRecurringOccurrenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurringId   string                 `protobuf:"bytes,1,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipRecurringOccurrenceRequest) Reset() {
+	*x = SkipRecurringOccurrenceRequest{}
+	mi := &file_transaction_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipRecurringOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipRecurringOccurrenceRequest) ProtoMessage() {}
+
+func (x *SkipRecurringOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipRecurringOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SkipRecurringOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SkipRecurringOccurrenceRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *SkipRecurringOccurrenceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SkipRecurringOccurrenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recurring     *RecurringTransaction  `protobuf:"bytes,1,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipRecurringOccurrenceResponse) Reset() {
+	*x = SkipRecurringOccurrenceResponse{}
+	mi := &file_transaction_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipRecurringOccurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipRecurringOccurrenceResponse) ProtoMessage() {}
+
+func (x *SkipRecurringOccurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipRecurringOccurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SkipRecurringOccurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SkipRecurringOccurrenceResponse) GetRecurring() *RecurringTransaction {
+	if x != nil {
+		return x.Recurring
+	}
+	return nil
+}
+
+type DeleteRecurringTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurringId   string                 `protobuf:"bytes,1,opt,name=recurring_id,json=recurringId,proto3" json:"recurring_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringTransactionRequest) Reset() {
+	*x = DeleteRecurringTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteRecurringTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteRecurringTransactionRequest) GetRecurringId() string {
+	if x != nil {
+		return x.RecurringId
+	}
+	return ""
+}
+
+func (x *DeleteRecurringTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteRecurringTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecurringTransactionResponse) Reset() {
+	*x = DeleteRecurringTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecurringTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecurringTransactionResponse) ProtoMessage() {}
+
+func (x *DeleteRecurringTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecurringTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteRecurringTransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteRecurringTransactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Названия колонок CSV (по заголовку) для полей транзакции
+type ImportColumnMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                               // По умолчанию "date"
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                           // По умолчанию "amount"
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // По умолчанию "description"; необязательная колонка
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                               // INCOME/EXPENSE; если колонки нет, тип определяется по знаку суммы
+	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Если колонки нет, берутся категории по умолчанию
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                       // Если колонки нет, берётся currency из настроек
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportColumnMapping) Reset() {
+	*x = ImportColumnMapping{}
+	mi := &file_transaction_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportColumnMapping) ProtoMessage() {}
+
+func (x *ImportColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportColumnMapping.ProtoReflect.Descriptor instead.
+func (*ImportColumnMapping) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportColumnMapping) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Настройки разбора CSV
+type ImportOptions struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Delimiter                string                 `protobuf:"bytes,1,opt,name=delimiter,proto3" json:"delimiter,omitempty"`                                       // Разделитель полей, по умолчанию ","
+	DateFormat               string                 `protobuf:"bytes,2,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`                   // Формат даты: "YYYY-MM-DD" (по умолчанию), "DD.MM.YYYY" и т.п.
+	DecimalSeparator         string                 `protobuf:"bytes,3,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"` // "." (по умолчанию) или ","
+	Columns                  *ImportColumnMapping   `protobuf:"bytes,4,opt,name=columns,proto3" json:"columns,omitempty"`
+	DefaultIncomeCategoryId  string                 `protobuf:"bytes,5,opt,name=default_income_category_id,json=defaultIncomeCategoryId,proto3" json:"default_income_category_id,omitempty"`    // Категория для доходов без колонки category_id
+	DefaultExpenseCategoryId string                 `protobuf:"bytes,6,opt,name=default_expense_category_id,json=defaultExpenseCategoryId,proto3" json:"default_expense_category_id,omitempty"` // Категория для расходов без колонки category_id
+	Currency                 string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                                                     // Валюта по умолчанию
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_transaction_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportOptions) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetColumns() *ImportColumnMapping {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetDefaultIncomeCategoryId() string {
+	if x != nil {
+		return x.DefaultIncomeCategoryId
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDefaultExpenseCategoryId() string {
+	if x != nil {
+		return x.DefaultExpenseCategoryId
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ImportTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Csv           []byte                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	Options       *ImportOptions         `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsRequest) Reset() {
+	*x = ImportTransactionsRequest{}
+	mi := &file_transaction_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsRequest) ProtoMessage() {}
+
+func (x *ImportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportTransactionsRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportTransactionsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Результат обработки одной строки файла
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Номер строки в файле (заголовок — строка 1)
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // Для принятых строк
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                      // Причина отказа
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_transaction_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ImportRowResult) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected      int32                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsResponse) Reset() {
+	*x = ImportTransactionsResponse{}
+	mi := &file_transaction_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsResponse) ProtoMessage() {}
+
+func (x *ImportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportTransactionsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ExportTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Те же фильтры и сортировка, что у списка; limit, offset и page_token не используются
+	Filter        *ListTransactionsRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        ExportFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=finplan.transaction.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_transaction_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportTransactionsRequest) GetFilter() *ListTransactionsRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportTransactionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// Очередная часть файла выгрузки
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_transaction_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Общий бюджет семьи
+type Household struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,4,opt,name=role,proto3,enum=finplan.transaction.v1.HouseholdRole" json:"role,omitempty"` // Роль запросившего пользователя
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_transaction_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Household) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Household) ProtoMessage() {}
+
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Household) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Household) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Household) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+func (x *Household) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Участник общего бюджета
+type HouseholdMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,3,opt,name=role,proto3,enum=finplan.transaction.v1.HouseholdRole" json:"role,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	mi := &file_transaction_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMember) ProtoMessage() {}
+
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HouseholdMember) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+func (x *HouseholdMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type CreateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Становится владельцем
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdRequest) Reset() {
+	*x = CreateHouseholdRequest{}
+	mi := &file_transaction_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateHouseholdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateHouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=household,proto3" json:"household,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdResponse) Reset() {
+	*x = CreateHouseholdResponse{}
+	mi := &file_transaction_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdResponse) ProtoMessage() {}
+
+func (x *CreateHouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdResponse.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateHouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+// Общие бюджеты, в которых участвует пользователь
+type ListHouseholdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsRequest) Reset() {
+	*x = ListHouseholdsRequest{}
+	mi := &file_transaction_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsRequest) ProtoMessage() {}
+
+func (x *ListHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListHouseholdsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListHouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdsResponse) Reset() {
+	*x = ListHouseholdsResponse{}
+	mi := &file_transaction_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdsResponse) ProtoMessage() {}
+
+func (x *ListHouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListHouseholdsResponse) GetHouseholds() []*Household {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+type ListHouseholdMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdMembersRequest) Reset() {
+	*x = ListHouseholdMembersRequest{}
+	mi := &file_transaction_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdMembersRequest) ProtoMessage() {}
+
+func (x *ListHouseholdMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListHouseholdMembersRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListHouseholdMembersRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ListHouseholdMembersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListHouseholdMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*HouseholdMember     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHouseholdMembersResponse) Reset() {
+	*x = ListHouseholdMembersResponse{}
+	mi := &file_transaction_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHouseholdMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHouseholdMembersResponse) ProtoMessage() {}
+
+func (x *ListHouseholdMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHouseholdMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListHouseholdMembersResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListHouseholdMembersResponse) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// Приглашение в общий бюджет. Код показывается один раз, хранится только его хеш
+type InviteHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // Владелец
+	Role          HouseholdRole          `protobuf:"varint,3,opt,name=role,proto3,enum=finplan.transaction.v1.HouseholdRole" json:"role,omitempty"` // editor или viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteHouseholdMemberRequest) Reset() {
+	*x = InviteHouseholdMemberRequest{}
+	mi := &file_transaction_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *InviteHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InviteHouseholdMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *InviteHouseholdMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteHouseholdMemberRequest) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+type InviteHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Одноразовый код для приглашённого
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteHouseholdMemberResponse) Reset() {
+	*x = InviteHouseholdMemberResponse{}
+	mi := &file_transaction_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *InviteHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InviteHouseholdMemberResponse) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InviteHouseholdMemberResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteHouseholdMemberResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type AcceptHouseholdInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationRequest) Reset() {
+	*x = AcceptHouseholdInvitationRequest{}
+	mi := &file_transaction_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AcceptHouseholdInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptHouseholdInvitationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type AcceptHouseholdInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *HouseholdMember       `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptHouseholdInvitationResponse) Reset() {
+	*x = AcceptHouseholdInvitationResponse{}
+	mi := &file_transaction_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptHouseholdInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptHouseholdInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptHouseholdInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptHouseholdInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptHouseholdInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AcceptHouseholdInvitationResponse) GetMember() *HouseholdMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateHouseholdMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Владелец
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=member_user_id,json=memberUserId,proto3" json:"member_user_id,omitempty"`
+	Role          HouseholdRole          `protobuf:"varint,4,opt,name=role,proto3,enum=finplan.transaction.v1.HouseholdRole" json:"role,omitempty"` // editor или viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) Reset() {
+	*x = UpdateHouseholdMemberRoleRequest{}
+	mi := &file_transaction_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdMemberRoleRequest) GetRole() HouseholdRole {
+	if x != nil {
+		return x.Role
+	}
+	return HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+type UpdateHouseholdMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *HouseholdMember       `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdMemberRoleResponse) Reset() {
+	*x = UpdateHouseholdMemberRoleResponse{}
+	mi := &file_transaction_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateHouseholdMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateHouseholdMemberRoleResponse) GetMember() *HouseholdMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// Исключение участника владельцем или выход из бюджета (member_user_id = user_id).
+// Транзакции участника остаются в общем бюджете
+type RemoveHouseholdMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=member_user_id,json=memberUserId,proto3" json:"member_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberRequest) Reset() {
+	*x = RemoveHouseholdMemberRequest{}
+	mi := &file_transaction_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberRequest) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RemoveHouseholdMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *RemoveHouseholdMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveHouseholdMemberRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+type RemoveHouseholdMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHouseholdMemberResponse) Reset() {
+	*x = RemoveHouseholdMemberResponse{}
+	mi := &file_transaction_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHouseholdMemberResponse) ProtoMessage() {}
+
+func (x *RemoveHouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveHouseholdMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveHouseholdMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Счёт пользователя с текущим остатком
+type Account struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=finplan.transaction.v1.AccountType" json:"type,omitempty"`
+	Currency              string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	OpeningBalanceDecimal string                 `protobuf:"bytes,6,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"` // Остаток на момент создания
+	BalanceDecimal        string                 `protobuf:"bytes,7,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"`                        // Текущий остаток: начальный + доходы - расходы ± переводы
+	IsDefault             bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`                                      // Основной счёт валюты для транзакций без account_id
+	Archived              bool                   `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_transaction_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+func (x *Account) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+func (x *Account) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Account) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                  AccountType            `protobuf:"varint,3,opt,name=type,proto3,enum=finplan.transaction.v1.AccountType" json:"type,omitempty"`
+	Currency              string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                                          // По умолчанию RUB
+	OpeningBalanceDecimal string                 `protobuf:"bytes,5,opt,name=opening_balance_decimal,json=openingBalanceDecimal,proto3" json:"opening_balance_decimal,omitempty"` // По умолчанию 0
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_transaction_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetOpeningBalanceDecimal() string {
+	if x != nil {
+		return x.OpeningBalanceDecimal
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_transaction_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_transaction_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListAccountsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_transaction_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// Архивация счёта: новые операции по нему запрещены, история и остаток сохраняются
+type ArchiveAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountRequest) Reset() {
+	*x = ArchiveAccountRequest{}
+	mi := &file_transaction_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountRequest) ProtoMessage() {}
+
+func (x *ArchiveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ArchiveAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ArchiveAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ArchiveAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountResponse) Reset() {
+	*x = ArchiveAccountResponse{}
+	mi := &file_transaction_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountResponse) ProtoMessage() {}
+
+func (x *ArchiveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ArchiveAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ArchiveAccountResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Цель накоплений с прогрессом и прогнозом.
+// Поля начиная с contributed_decimal вычисляются и при изменении цели игнорируются
+type Goal struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmountDecimal     string                 `protobuf:"bytes,4,opt,name=target_amount_decimal,json=targetAmountDecimal,proto3" json:"target_amount_decimal,omitempty"`
+	Currency                string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Deadline                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"` // Срок; не задан — цель без срока
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ContributedDecimal      string                 `protobuf:"bytes,8,opt,name=contributed_decimal,json=contributedDecimal,proto3" json:"contributed_decimal,omitempty"`                     // Сумма взносов в валюте цели
+	RemainingDecimal        string                 `protobuf:"bytes,9,opt,name=remaining_decimal,json=remainingDecimal,proto3" json:"remaining_decimal,omitempty"`                           // Осталось накопить
+	ProgressPercent         float64                `protobuf:"fixed64,10,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`                           // Может превышать 100
+	RequiredMonthlyDecimal  string                 `protobuf:"bytes,11,opt,name=required_monthly_decimal,json=requiredMonthlyDecimal,proto3" json:"required_monthly_decimal,omitempty"`      // Сколько откладывать в месяц, чтобы успеть к сроку
+	AverageNetIncomeDecimal string                 `protobuf:"bytes,12,opt,name=average_net_income_decimal,json=averageNetIncomeDecimal,proto3" json:"average_net_income_decimal,omitempty"` // Средний чистый доход за последние месяцы
+	ProjectedCompletion     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=projected_completion,json=projectedCompletion,proto3" json:"projected_completion,omitempty"`                 // Прогноз по среднему доходу; не задан, если доход не положительный
+	Completed               bool                   `protobuf:"varint,14,opt,name=completed,proto3" json:"completed,omitempty"`
+	OnTrack                 bool                   `protobuf:"varint,15,opt,name=on_track,json=onTrack,proto3" json:"on_track,omitempty"` // Прогноз укладывается в срок
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_transaction_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Goal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Goal) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Goal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Goal) GetTargetAmountDecimal() string {
+	if x != nil {
+		return x.TargetAmountDecimal
+	}
+	return ""
+}
+
+func (x *Goal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Goal) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Goal) GetContributedDecimal() string {
+	if x != nil {
+		return x.ContributedDecimal
+	}
+	return ""
+}
+
+func (x *Goal) GetRemainingDecimal() string {
+	if x != nil {
+		return x.RemainingDecimal
+	}
+	return ""
+}
+
+func (x *Goal) GetProgressPercent() float64 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *Goal) GetRequiredMonthlyDecimal() string {
+	if x != nil {
+		return x.RequiredMonthlyDecimal
+	}
+	return ""
+}
+
+func (x *Goal) GetAverageNetIncomeDecimal() string {
+	if x != nil {
+		return x.AverageNetIncomeDecimal
+	}
+	return ""
+}
+
+func (x *Goal) GetProjectedCompletion() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProjectedCompletion
+	}
+	return nil
+}
+
+func (x *Goal) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Goal) GetOnTrack() bool {
+	if x != nil {
+		return x.OnTrack
+	}
+	return false
+}
+
+type CreateGoalRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmountDecimal string                 `protobuf:"bytes,3,opt,name=target_amount_decimal,json=targetAmountDecimal,proto3" json:"target_amount_decimal,omitempty"`
+	Currency            string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // По умолчанию RUB
+	Deadline            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"` // Необязательный срок
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_transaction_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetTargetAmountDecimal() string {
+	if x != nil {
+		return x.TargetAmountDecimal
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type CreateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalResponse) Reset() {
+	*x = CreateGoalResponse{}
+	mi := &file_transaction_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalResponse) ProtoMessage() {}
+
+func (x *CreateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalResponse.ProtoReflect.Descriptor instead.
+func (*CreateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type ListGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_transaction_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsRequest) ProtoMessage() {}
+
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListGoalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_transaction_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGoalsResponse) ProtoMessage() {}
+
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListGoalsResponse) GetGoals() []*Goal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GetGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalRequest) Reset() {
+	*x = GetGoalRequest{}
+	mi := &file_transaction_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalRequest) ProtoMessage() {}
+
+func (x *GetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *GetGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalResponse) Reset() {
+	*x = GetGoalResponse{}
+	mi := &file_transaction_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalResponse) ProtoMessage() {}
+
+func (x *GetGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalResponse.ProtoReflect.Descriptor instead.
+func (*GetGoalResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+// Частичное обновление цели: name, target_amount_decimal, currency, deadline.
+// deadline в маске без значения снимает срок
+type UpdateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Goal          *Goal                  `protobuf:"bytes,3,opt,name=goal,proto3" json:"goal,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_transaction_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalRequest) ProtoMessage() {}
+
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateGoalRequest) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *UpdateGoalRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoalResponse) Reset() {
+	*x = UpdateGoalResponse{}
+	mi := &file_transaction_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoalResponse) ProtoMessage() {}
+
+func (x *UpdateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+// Удаление цели; привязанные транзакции остаются без цели
+type DeleteGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_transaction_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalRequest) ProtoMessage() {}
+
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteGoalRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *DeleteGoalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoalResponse) Reset() {
+	*x = DeleteGoalResponse{}
+	mi := &file_transaction_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoalResponse) ProtoMessage() {}
+
+func (x *DeleteGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGoalResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteGoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteGoalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Привязка существующей транзакции или перевода к цели (или отвязка)
+type LinkGoalTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        string                 `protobuf:"bytes,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGoalTransactionRequest) Reset() {
+	*x = LinkGoalTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGoalTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGoalTransactionRequest) ProtoMessage() {}
+
+func (x *LinkGoalTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGoalTransactionRequest.ProtoReflect.Descriptor instead.
+func (*LinkGoalTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LinkGoalTransactionRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *LinkGoalTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkGoalTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type LinkGoalTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkGoalTransactionResponse) Reset() {
+	*x = LinkGoalTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkGoalTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkGoalTransactionResponse) ProtoMessage() {}
+
+func (x *LinkGoalTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkGoalTransactionResponse.ProtoReflect.Descriptor instead.
+func (*LinkGoalTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *LinkGoalTransactionResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+// Займ с текущим остатком.
+// Поля начиная с paid_decimal вычисляются по графику и привязанным погашениям
+type Loan struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Counterparty         string                 `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"` // Кому дали или у кого взяли
+	Direction            LoanDirection          `protobuf:"varint,4,opt,name=direction,proto3,enum=finplan.transaction.v1.LoanDirection" json:"direction,omitempty"`
+	Schedule             LoanSchedule           `protobuf:"varint,5,opt,name=schedule,proto3,enum=finplan.transaction.v1.LoanSchedule" json:"schedule,omitempty"`
+	PrincipalDecimal     string                 `protobuf:"bytes,6,opt,name=principal_decimal,json=principalDecimal,proto3" json:"principal_decimal,omitempty"`
+	Currency             string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	AnnualRatePercent    float64                `protobuf:"fixed64,8,opt,name=annual_rate_percent,json=annualRatePercent,proto3" json:"annual_rate_percent,omitempty"` // Годовая ставка, 0 — беспроцентный
+	TermMonths           int32                  `protobuf:"varint,9,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	StartDate            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Дата выдачи; первый платёж через месяц
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidDecimal          string                 `protobuf:"bytes,12,opt,name=paid_decimal,json=paidDecimal,proto3" json:"paid_decimal,omitempty"`                              // Сумма погашений в валюте займа
+	OutstandingDecimal   string                 `protobuf:"bytes,13,opt,name=outstanding_decimal,json=outstandingDecimal,proto3" json:"outstanding_decimal,omitempty"`         // Остаток основного долга
+	TotalInterestDecimal string                 `protobuf:"bytes,14,opt,name=total_interest_decimal,json=totalInterestDecimal,proto3" json:"total_interest_decimal,omitempty"` // Проценты за весь срок по графику
+	NextPayment          *LoanPayment           `protobuf:"bytes,15,opt,name=next_payment,json=nextPayment,proto3" json:"next_payment,omitempty"`                              // Ближайший непокрытый платёж; не задан у погашенного займа
+	Closed               bool                   `protobuf:"varint,16,opt,name=closed,proto3" json:"closed,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	mi := &file_transaction_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Loan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Loan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Loan) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *Loan) GetDirection() LoanDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return LoanDirection_LOAN_DIRECTION_UNSPECIFIED
+}
+
+func (x *Loan) GetSchedule() LoanSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return LoanSchedule_LOAN_SCHEDULE_UNSPECIFIED
+}
+
+func (x *Loan) GetPrincipalDecimal() string {
+	if x != nil {
+		return x.PrincipalDecimal
+	}
+	return ""
+}
+
+func (x *Loan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Loan) GetAnnualRatePercent() float64 {
+	if x != nil {
+		return x.AnnualRatePercent
+	}
+	return 0
+}
+
+func (x *Loan) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *Loan) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetPaidDecimal() string {
+	if x != nil {
+		return x.PaidDecimal
+	}
+	return ""
+}
+
+func (x *Loan) GetOutstandingDecimal() string {
+	if x != nil {
+		return x.OutstandingDecimal
+	}
+	return ""
+}
+
+func (x *Loan) GetTotalInterestDecimal() string {
+	if x != nil {
+		return x.TotalInterestDecimal
+	}
+	return ""
+}
+
+func (x *Loan) GetNextPayment() *LoanPayment {
+	if x != nil {
+		return x.NextPayment
+	}
+	return nil
+}
+
+func (x *Loan) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+// Платёж по графику
+type LoanPayment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Number           int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Date             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	PaymentDecimal   string                 `protobuf:"bytes,3,opt,name=payment_decimal,json=paymentDecimal,proto3" json:"payment_decimal,omitempty"`
+	PrincipalDecimal string                 `protobuf:"bytes,4,opt,name=principal_decimal,json=principalDecimal,proto3" json:"principal_decimal,omitempty"`
+	InterestDecimal  string                 `protobuf:"bytes,5,opt,name=interest_decimal,json=interestDecimal,proto3" json:"interest_decimal,omitempty"`
+	BalanceDecimal   string                 `protobuf:"bytes,6,opt,name=balance_decimal,json=balanceDecimal,proto3" json:"balance_decimal,omitempty"` // Остаток основного долга после платежа
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LoanPayment) Reset() {
+	*x = LoanPayment{}
+	mi := &file_transaction_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanPayment) ProtoMessage() {}
+
+func (x *LoanPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanPayment.ProtoReflect.Descriptor instead.
+func (*LoanPayment) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LoanPayment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *LoanPayment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetPaymentDecimal() string {
+	if x != nil {
+		return x.PaymentDecimal
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetPrincipalDecimal() string {
+	if x != nil {
+		return x.PrincipalDecimal
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetInterestDecimal() string {
+	if x != nil {
+		return x.InterestDecimal
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetBalanceDecimal() string {
+	if x != nil {
+		return x.BalanceDecimal
+	}
+	return ""
+}
+
+type CreateLoanRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Counterparty      string                 `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Direction         LoanDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=finplan.transaction.v1.LoanDirection" json:"direction,omitempty"`
+	Schedule          LoanSchedule           `protobuf:"varint,4,opt,name=schedule,proto3,enum=finplan.transaction.v1.LoanSchedule" json:"schedule,omitempty"` // По умолчанию аннуитетный
+	PrincipalDecimal  string                 `protobuf:"bytes,5,opt,name=principal_decimal,json=principalDecimal,proto3" json:"principal_decimal,omitempty"`
+	Currency          string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // По умолчанию RUB
+	AnnualRatePercent float64                `protobuf:"fixed64,7,opt,name=annual_rate_percent,json=annualRatePercent,proto3" json:"annual_rate_percent,omitempty"`
+	TermMonths        int32                  `protobuf:"varint,8,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	mi := &file_transaction_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetDirection() LoanDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return LoanDirection_LOAN_DIRECTION_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetSchedule() LoanSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return LoanSchedule_LOAN_SCHEDULE_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetPrincipalDecimal() string {
+	if x != nil {
+		return x.PrincipalDecimal
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetAnnualRatePercent() float64 {
+	if x != nil {
+		return x.AnnualRatePercent
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+type CreateLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLoanResponse) Reset() {
+	*x = CreateLoanResponse{}
+	mi := &file_transaction_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanResponse) ProtoMessage() {}
+
+func (x *CreateLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanResponse.ProtoReflect.Descriptor instead.
+func (*CreateLoanResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type ListLoansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeClosed bool                   `protobuf:"varint,2,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"` // Вместе с погашенными
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	mi := &file_transaction_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListLoansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type ListLoansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loans         []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	mi := &file_transaction_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	mi := &file_transaction_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Займ с полным графиком платежей
+type GetLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Schedule      []*LoanPayment         `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanResponse) Reset() {
+	*x = GetLoanResponse{}
+	mi := &file_transaction_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanResponse) ProtoMessage() {}
+
+func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetSchedule() []*LoanPayment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Удаление займа; привязанные погашения остаются обычными транзакциями
+type DeleteLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLoanRequest) Reset() {
+	*x = DeleteLoanRequest{}
+	mi := &file_transaction_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLoanRequest) ProtoMessage() {}
+
+func (x *DeleteLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLoanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLoanRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *DeleteLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteLoanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLoanResponse) Reset() {
+	*x = DeleteLoanResponse{}
+	mi := &file_transaction_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLoanResponse) ProtoMessage() {}
+
+func (x *DeleteLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLoanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLoanResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteLoanResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteLoanResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Привязка существующей транзакции к займу как погашения (или отвязка)
+type LinkLoanTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkLoanTransactionRequest) Reset() {
+	*x = LinkLoanTransactionRequest{}
+	mi := &file_transaction_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkLoanTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkLoanTransactionRequest) ProtoMessage() {}
+
+func (x *LinkLoanTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkLoanTransactionRequest.ProtoReflect.Descriptor instead.
+func (*LinkLoanTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *LinkLoanTransactionRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LinkLoanTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkLoanTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type LinkLoanTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loan          *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkLoanTransactionResponse) Reset() {
+	*x = LinkLoanTransactionResponse{}
+	mi := &file_transaction_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkLoanTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkLoanTransactionResponse) ProtoMessage() {}
+
+func (x *LinkLoanTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkLoanTransactionResponse.ProtoReflect.Descriptor instead.
+func (*LinkLoanTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *LinkLoanTransactionResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+var File_transaction_proto protoreflect.FileDescriptor
+
+const file_transaction_proto_rawDesc = "" +
+	"\n" +
+	"\x11transaction.proto\x12\x16finplan.transaction.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\"\x97\x04\n" +
+	"\x15AddTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12;\n" +
+	"\x04type\x18\x03 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12%\n" +
+	"\x0eamount_decimal\x18\a \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12!\n" +
+	"\fhousehold_id\x18\t \x01(\tR\vhouseholdId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\n" +
+	" \x01(\tR\taccountId\x12.\n" +
+	"\x13transfer_account_id\x18\v \x01(\tR\x11transferAccountId\x126\n" +
+	"\x17transfer_amount_decimal\x18\f \x01(\tR\x15transferAmountDecimal\x12\x17\n" +
+	"\agoal_id\x18\r \x01(\tR\x06goalId\x12\x17\n" +
+	"\aloan_id\x18\x0e \x01(\tR\x06loanId\"?\n" +
+	"\x16AddTransactionResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\x80\x06\n" +
+	"\x17ListTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12;\n" +
+	"\x04type\x18\x06 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12!\n" +
+	"\fcategory_ids\x18\a \x03(\tR\vcategoryIds\x12\"\n" +
+	"\n" +
+	"amount_min\x18\b \x01(\x01H\x00R\tamountMin\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"amount_max\x18\t \x01(\x01H\x01R\tamountMax\x88\x01\x01\x12\x16\n" +
+	"\x06search\x18\n" +
+	" \x01(\tR\x06search\x12E\n" +
+	"\asort_by\x18\v \x01(\x0e2,.finplan.transaction.v1.TransactionSortFieldR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\f \x01(\bR\tascending\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\r \x01(\tR\tpageToken\x12!\n" +
+	"\fhousehold_id\x18\x0e \x01(\tR\vhouseholdId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x0f \x01(\tR\taccountId\x12\x17\n" +
+	"\agoal_id\x18\x10 \x01(\tR\x06goalId\x12\x17\n" +
+	"\aloan_id\x18\x11 \x01(\tR\x06loanId\x12,\n" +
+	"\x12amount_min_decimal\x18\x12 \x01(\tR\x10amountMinDecimal\x12,\n" +
+	"\x12amount_max_decimal\x18\x13 \x01(\tR\x10amountMaxDecimalB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_max\"\xac\x01\n" +
+	"\x18ListTransactionsResponse\x12G\n" +
+	"\ftransactions\x18\x01 \x03(\v2#.finplan.transaction.v1.TransactionR\ftransactions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"Z\n" +
+	"\x18DeleteTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"O\n" +
+	"\x19DeleteTransactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xde\x01\n" +
+	"\x18UpdateTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12E\n" +
+	"\vtransaction\x18\x03 \x01(\v2#.finplan.transaction.v1.TransactionR\vtransaction\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"b\n" +
+	"\x19UpdateTransactionResponse\x12E\n" +
+	"\vtransaction\x18\x01 \x01(\v2#.finplan.transaction.v1.TransactionR\vtransaction\"O\n" +
+	"\x11GetBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\"\xa8\x03\n" +
+	"\x12GetBalanceResponse\x12!\n" +
+	"\fincome_total\x18\x01 \x01(\x01R\vincomeTotal\x12#\n" +
+	"\rexpense_total\x18\x02 \x01(\x01R\fexpenseTotal\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x120\n" +
+	"\x14income_total_decimal\x18\x04 \x01(\tR\x12incomeTotalDecimal\x122\n" +
+	"\x15expense_total_decimal\x18\x05 \x01(\tR\x13expenseTotalDecimal\x12'\n" +
+	"\x0fbalance_decimal\x18\x06 \x01(\tR\x0ebalanceDecimal\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12H\n" +
+	"\vby_currency\x18\b \x03(\v2'.finplan.transaction.v1.CurrencyBalanceR\n" +
+	"byCurrency\x12;\n" +
+	"\baccounts\x18\t \x03(\v2\x1f.finplan.transaction.v1.AccountR\baccounts\"-\n" +
+	"\x12GetNetWorthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xc7\x02\n" +
+	"\x13GetNetWorthResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12)\n" +
+	"\x10accounts_decimal\x18\x02 \x01(\tR\x0faccountsDecimal\x12!\n" +
+	"\flent_decimal\x18\x03 \x01(\tR\vlentDecimal\x12)\n" +
+	"\x10borrowed_decimal\x18\x04 \x01(\tR\x0fborrowedDecimal\x12*\n" +
+	"\x11net_worth_decimal\x18\x05 \x01(\tR\x0fnetWorthDecimal\x12;\n" +
+	"\baccounts\x18\x06 \x03(\v2\x1f.finplan.transaction.v1.AccountR\baccounts\x122\n" +
+	"\x05loans\x18\a \x03(\v2\x1c.finplan.transaction.v1.LoanR\x05loans\"\x87\x02\n" +
+	"\x10GetReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\tdate_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12K\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2).finplan.transaction.v1.ReportGranularityR\vgranularity\x12\x1f\n" +
+	"\vby_category\x18\x05 \x01(\bR\n" +
+	"byCategory\"o\n" +
+	"\x11GetReportResponse\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12>\n" +
+	"\abuckets\x18\x02 \x03(\v2$.finplan.transaction.v1.ReportBucketR\abuckets\"\xb0\x03\n" +
+	"\fReportBucket\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12%\n" +
+	"\x0eincome_decimal\x18\x03 \x01(\tR\rincomeDecimal\x12'\n" +
+	"\x0fexpense_decimal\x18\x04 \x01(\tR\x0eexpenseDecimal\x12\x1f\n" +
+	"\vnet_decimal\x18\x05 \x01(\tR\n" +
+	"netDecimal\x126\n" +
+	"\x17opening_balance_decimal\x18\x06 \x01(\tR\x15openingBalanceDecimal\x126\n" +
+	"\x17closing_balance_decimal\x18\a \x01(\tR\x15closingBalanceDecimal\x12E\n" +
+	"\n" +
+	"categories\x18\b \x03(\v2%.finplan.transaction.v1.CategoryTotalR\n" +
+	"categories\"\x80\x01\n" +
+	"\rCategoryTotal\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12%\n" +
+	"\x0eincome_decimal\x18\x02 \x01(\tR\rincomeDecimal\x12'\n" +
+	"\x0fexpense_decimal\x18\x03 \x01(\tR\x0eexpenseDecimal\"\xbc\x01\n" +
+	"\x0fCurrencyBalance\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x120\n" +
+	"\x14income_total_decimal\x18\x02 \x01(\tR\x12incomeTotalDecimal\x122\n" +
+	"\x15expense_total_decimal\x18\x03 \x01(\tR\x13expenseTotalDecimal\x12'\n" +
+	"\x0fbalance_decimal\x18\x04 \x01(\tR\x0ebalanceDecimal\"\x93\x05\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12;\n" +
+	"\x04type\x18\x04 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12.\n" +
+	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\x0eamount_decimal\x18\n" +
+	" \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12!\n" +
+	"\fhousehold_id\x18\f \x01(\tR\vhouseholdId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\r \x01(\tR\taccountId\x12.\n" +
+	"\x13transfer_account_id\x18\x0e \x01(\tR\x11transferAccountId\x126\n" +
+	"\x17transfer_amount_decimal\x18\x0f \x01(\tR\x15transferAmountDecimal\x12\x17\n" +
+	"\agoal_id\x18\x10 \x01(\tR\x06goalId\x12\x17\n" +
+	"\aloan_id\x18\x11 \x01(\tR\x06loanId\"\xfd\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12;\n" +
+	"\x04type\x18\x05 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12\x1a\n" +
+	"\barchived\x18\x06 \x01(\bR\barchived\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa3\x01\n" +
+	"\x15CreateCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12;\n" +
+	"\x04type\x18\x04 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\"9\n" +
+	"\x16CreateCategoryResponse\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"\x98\x01\n" +
+	"\x15ListCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\x04type\x18\x02 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12)\n" +
+	"\x10include_archived\x18\x03 \x01(\bR\x0fincludeArchived\"Z\n" +
+	"\x16ListCategoriesResponse\x12@\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2 .finplan.transaction.v1.CategoryR\n" +
+	"categories\"e\n" +
+	"\x15RenameCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"L\n" +
+	"\x16RenameCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"R\n" +
+	"\x16ArchiveCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"M\n" +
+	"\x17ArchiveCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x89\x02\n" +
+	"\x06Budget\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12#\n" +
+	"\rlimit_decimal\x18\x04 \x01(\tR\flimitDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb6\x01\n" +
+	"\fBudgetStatus\x126\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1e.finplan.transaction.v1.BudgetR\x06budget\x12#\n" +
+	"\rspent_decimal\x18\x02 \x01(\tR\fspentDecimal\x12+\n" +
+	"\x11remaining_decimal\x18\x03 \x01(\tR\x10remainingDecimal\x12\x1c\n" +
+	"\toverspent\x18\x04 \x01(\bR\toverspent\"\x8d\x01\n" +
+	"\x10SetBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12#\n" +
+	"\rlimit_decimal\x18\x03 \x01(\tR\flimitDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"K\n" +
+	"\x11SetBudgetResponse\x126\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1e.finplan.transaction.v1.BudgetR\x06budget\"_\n" +
+	"\x12ListBudgetsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\x05month\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\"\x87\x01\n" +
+	"\x13ListBudgetsResponse\x12>\n" +
+	"\abudgets\x18\x01 \x03(\v2$.finplan.transaction.v1.BudgetStatusR\abudgets\x120\n" +
+	"\x05month\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\"K\n" +
+	"\x13DeleteBudgetRequest\x12\x1b\n" +
+	"\tbudget_id\x18\x01 \x01(\tR\bbudgetId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x14DeleteBudgetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xbb\x05\n" +
+	"\x14RecurringTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12;\n" +
+	"\x04type\x18\x04 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12%\n" +
+	"\x0eamount_decimal\x18\x05 \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12I\n" +
+	"\tfrequency\x18\b \x01(\x0e2+.finplan.transaction.v1.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\t \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
+	"\x05count\x18\f \x01(\x05R\x05count\x127\n" +
+	"\tnext_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bnextDate\x12 \n" +
+	"\voccurrences\x18\x0e \x01(\x05R\voccurrences\x12\x16\n" +
+	"\x06paused\x18\x0f \x01(\bR\x06paused\x12\x1a\n" +
+	"\bfinished\x18\x10 \x01(\bR\bfinished\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x03\n" +
+	"!CreateRecurringTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12;\n" +
+	"\x04type\x18\x03 \x01(\x0e2'.finplan.transaction.v1.TransactionTypeR\x04type\x12%\n" +
+	"\x0eamount_decimal\x18\x04 \x01(\tR\ramountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12I\n" +
+	"\tfrequency\x18\a \x01(\x0e2+.finplan.transaction.v1.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\b \x01(\x05R\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x14\n" +
+	"\x05count\x18\v \x01(\x05R\x05count\"p\n" +
+	"\"CreateRecurringTransactionResponse\x12J\n" +
+	"\trecurring\x18\x01 \x01(\v2,.finplan.transaction.v1.RecurringTransactionR\trecurring\";\n" +
+	" ListRecurringTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"o\n" +
+	"!ListRecurringTransactionsResponse\x12J\n" +
+	"\trecurring\x18\x01 \x03(\v2,.finplan.transaction.v1.RecurringTransactionR\trecurring\"v\n" +
+	" PauseRecurringTransactionRequest\x12!\n" +
+	"\frecurring_id\x18\x01 \x01(\tR\vrecurringId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"o\n" +
+	"!PauseRecurringTransactionResponse\x12J\n" +
+	"\trecurring\x18\x01 \x01(\v2,.finplan.transaction.v1.RecurringTransactionR\trecurring\"\\\n" +
+	"\x1eSkipRecurringOccurrenceRequest\x12!\n" +
+	"\frecurring_id\x18\x01 \x01(\tR\vrecurringId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"m\n" +
+	"\x1fSkipRecurringOccurrenceResponse\x12J\n" +
+	"\trecurring\x18\x01 \x01(\v2,.finplan.transaction.v1.RecurringTransactionR\trecurring\"_\n" +
+	"!DeleteRecurringTransactionRequest\x12!\n" +
+	"\frecurring_id\x18\x01 \x01(\tR\vrecurringId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"X\n" +
+	"\"DeleteRecurringTransactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb4\x01\n" +
+	"\x13ImportColumnMapping\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\xda\x02\n" +
+	"\rImportOptions\x12\x1c\n" +
+	"\tdelimiter\x18\x01 \x01(\tR\tdelimiter\x12\x1f\n" +
+	"\vdate_format\x18\x02 \x01(\tR\n" +
+	"dateFormat\x12+\n" +
+	"\x11decimal_separator\x18\x03 \x01(\tR\x10decimalSeparator\x12E\n" +
+	"\acolumns\x18\x04 \x01(\v2+.finplan.transaction.v1.ImportColumnMappingR\acolumns\x12;\n" +
+	"\x1adefault_income_category_id\x18\x05 \x01(\tR\x17defaultIncomeCategoryId\x12=\n" +
+	"\x1bdefault_expense_category_id\x18\x06 \x01(\tR\x18defaultExpenseCategoryId\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\"\x87\x01\n" +
+	"\x19ImportTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\x12?\n" +
+	"\aoptions\x18\x03 \x01(\v2%.finplan.transaction.v1.ImportOptionsR\aoptions\"~\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x91\x01\n" +
+	"\x1aImportTransactionsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected\x12;\n" +
+	"\x04rows\x18\x03 \x03(\v2'.finplan.transaction.v1.ImportRowResultR\x04rows\"\xa2\x01\n" +
+	"\x19ExportTransactionsRequest\x12G\n" +
+	"\x06filter\x18\x01 \x01(\v2/.finplan.transaction.v1.ListTransactionsRequestR\x06filter\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.finplan.transaction.v1.ExportFormatR\x06format\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xc0\x01\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x129\n" +
+	"\x04role\x18\x04 \x01(\x0e2%.finplan.transaction.v1.HouseholdRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x01\n" +
+	"\x0fHouseholdMember\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\x04role\x18\x03 \x01(\x0e2%.finplan.transaction.v1.HouseholdRoleR\x04role\x127\n" +
+	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"E\n" +
+	"\x16CreateHouseholdRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"Z\n" +
+	"\x17CreateHouseholdResponse\x12?\n" +
+	"\thousehold\x18\x01 \x01(\v2!.finplan.transaction.v1.HouseholdR\thousehold\"0\n" +
+	"\x15ListHouseholdsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"[\n" +
+	"\x16ListHouseholdsResponse\x12A\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2!.finplan.transaction.v1.HouseholdR\n" +
+	"households\"Y\n" +
+	"\x1bListHouseholdMembersRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"a\n" +
+	"\x1cListHouseholdMembersResponse\x12A\n" +
+	"\amembers\x18\x01 \x03(\v2'.finplan.transaction.v1.HouseholdMemberR\amembers\"\x95\x01\n" +
+	"\x1cInviteHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\x04role\x18\x03 \x01(\x0e2%.finplan.transaction.v1.HouseholdRoleR\x04role\"\x93\x01\n" +
+	"\x1dInviteHouseholdMemberResponse\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"O\n" +
+	" AcceptHouseholdInvitationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"d\n" +
+	"!AcceptHouseholdInvitationResponse\x12?\n" +
+	"\x06member\x18\x01 \x01(\v2'.finplan.transaction.v1.HouseholdMemberR\x06member\"\xbf\x01\n" +
+	" UpdateHouseholdMemberRoleRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x0emember_user_id\x18\x03 \x01(\tR\fmemberUserId\x129\n" +
+	"\x04role\x18\x04 \x01(\x0e2%.finplan.transaction.v1.HouseholdRoleR\x04role\"d\n" +
+	"!UpdateHouseholdMemberRoleResponse\x12?\n" +
+	"\x06member\x18\x01 \x01(\v2'.finplan.transaction.v1.HouseholdMemberR\x06member\"\x80\x01\n" +
+	"\x1cRemoveHouseholdMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x0emember_user_id\x18\x03 \x01(\tR\fmemberUserId\"S\n" +
+	"\x1dRemoveHouseholdMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf2\x02\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x127\n" +
+	"\x04type\x18\x04 \x01(\x0e2#.finplan.transaction.v1.AccountTypeR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x126\n" +
+	"\x17opening_balance_decimal\x18\x06 \x01(\tR\x15openingBalanceDecimal\x12'\n" +
+	"\x0fbalance_decimal\x18\a \x01(\tR\x0ebalanceDecimal\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\x12\x1a\n" +
+	"\barchived\x18\t \x01(\bR\barchived\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd0\x01\n" +
+	"\x14CreateAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\x04type\x18\x03 \x01(\x0e2#.finplan.transaction.v1.AccountTypeR\x04type\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x126\n" +
+	"\x17opening_balance_decimal\x18\x05 \x01(\tR\x15openingBalanceDecimal\"R\n" +
+	"\x15CreateAccountResponse\x129\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1f.finplan.transaction.v1.AccountR\aaccount\"Y\n" +
+	"\x13ListAccountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"S\n" +
+	"\x14ListAccountsResponse\x12;\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1f.finplan.transaction.v1.AccountR\baccounts\"O\n" +
+	"\x15ArchiveAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
+	"\x16ArchiveAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8e\x05\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x122\n" +
+	"\x15target_amount_decimal\x18\x04 \x01(\tR\x13targetAmountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x126\n" +
+	"\bdeadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12/\n" +
+	"\x13contributed_decimal\x18\b \x01(\tR\x12contributedDecimal\x12+\n" +
+	"\x11remaining_decimal\x18\t \x01(\tR\x10remainingDecimal\x12)\n" +
+	"\x10progress_percent\x18\n" +
+	" \x01(\x01R\x0fprogressPercent\x128\n" +
+	"\x18required_monthly_decimal\x18\v \x01(\tR\x16requiredMonthlyDecimal\x12;\n" +
+	"\x1aaverage_net_income_decimal\x18\f \x01(\tR\x17averageNetIncomeDecimal\x12M\n" +
+	"\x14projected_completion\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x13projectedCompletion\x12\x1c\n" +
+	"\tcompleted\x18\x0e \x01(\bR\tcompleted\x12\x19\n" +
+	"\bon_track\x18\x0f \x01(\bR\aonTrack\"\xc8\x01\n" +
+	"\x11CreateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\x15target_amount_decimal\x18\x03 \x01(\tR\x13targetAmountDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\"F\n" +
+	"\x12CreateGoalResponse\x120\n" +
+	"\x04goal\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.GoalR\x04goal\"+\n" +
+	"\x10ListGoalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"G\n" +
+	"\x11ListGoalsResponse\x122\n" +
+	"\x05goals\x18\x01 \x03(\v2\x1c.finplan.transaction.v1.GoalR\x05goals\"B\n" +
+	"\x0eGetGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"C\n" +
+	"\x0fGetGoalResponse\x120\n" +
+	"\x04goal\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.GoalR\x04goal\"\xb4\x01\n" +
+	"\x11UpdateGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x120\n" +
+	"\x04goal\x18\x03 \x01(\v2\x1c.finplan.transaction.v1.GoalR\x04goal\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"F\n" +
+	"\x12UpdateGoalResponse\x120\n" +
+	"\x04goal\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.GoalR\x04goal\"E\n" +
+	"\x11DeleteGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\x12DeleteGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"u\n" +
+	"\x1aLinkGoalTransactionRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\tR\x06goalId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"O\n" +
+	"\x1bLinkGoalTransactionResponse\x120\n" +
+	"\x04goal\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.GoalR\x04goal\"\xd4\x05\n" +
+	"\x04Loan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\fcounterparty\x18\x03 \x01(\tR\fcounterparty\x12C\n" +
+	"\tdirection\x18\x04 \x01(\x0e2%.finplan.transaction.v1.LoanDirectionR\tdirection\x12@\n" +
+	"\bschedule\x18\x05 \x01(\x0e2$.finplan.transaction.v1.LoanScheduleR\bschedule\x12+\n" +
+	"\x11principal_decimal\x18\x06 \x01(\tR\x10principalDecimal\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12.\n" +
+	"\x13annual_rate_percent\x18\b \x01(\x01R\x11annualRatePercent\x12\x1f\n" +
+	"\vterm_months\x18\t \x01(\x05R\n" +
+	"termMonths\x129\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fpaid_decimal\x18\f \x01(\tR\vpaidDecimal\x12/\n" +
+	"\x13outstanding_decimal\x18\r \x01(\tR\x12outstandingDecimal\x124\n" +
+	"\x16total_interest_decimal\x18\x0e \x01(\tR\x14totalInterestDecimal\x12F\n" +
+	"\fnext_payment\x18\x0f \x01(\v2#.finplan.transaction.v1.LoanPaymentR\vnextPayment\x12\x16\n" +
+	"\x06closed\x18\x10 \x01(\bR\x06closed\"\xff\x01\n" +
+	"\vLoanPayment\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12.\n" +
+	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12'\n" +
+	"\x0fpayment_decimal\x18\x03 \x01(\tR\x0epaymentDecimal\x12+\n" +
+	"\x11principal_decimal\x18\x04 \x01(\tR\x10principalDecimal\x12)\n" +
+	"\x10interest_decimal\x18\x05 \x01(\tR\x0finterestDecimal\x12'\n" +
+	"\x0fbalance_decimal\x18\x06 \x01(\tR\x0ebalanceDecimal\"\xac\x03\n" +
+	"\x11CreateLoanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\fcounterparty\x18\x02 \x01(\tR\fcounterparty\x12C\n" +
+	"\tdirection\x18\x03 \x01(\x0e2%.finplan.transaction.v1.LoanDirectionR\tdirection\x12@\n" +
+	"\bschedule\x18\x04 \x01(\x0e2$.finplan.transaction.v1.LoanScheduleR\bschedule\x12+\n" +
+	"\x11principal_decimal\x18\x05 \x01(\tR\x10principalDecimal\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12.\n" +
+	"\x13annual_rate_percent\x18\a \x01(\x01R\x11annualRatePercent\x12\x1f\n" +
+	"\vterm_months\x18\b \x01(\x05R\n" +
+	"termMonths\x129\n" +
+	"\n" +
+	"start_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\"F\n" +
+	"\x12CreateLoanResponse\x120\n" +
+	"\x04loan\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.LoanR\x04loan\"R\n" +
+	"\x10ListLoansRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0einclude_closed\x18\x02 \x01(\bR\rincludeClosed\"G\n" +
+	"\x11ListLoansResponse\x122\n" +
+	"\x05loans\x18\x01 \x03(\v2\x1c.finplan.transaction.v1.LoanR\x05loans\"B\n" +
+	"\x0eGetLoanRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x84\x01\n" +
+	"\x0fGetLoanResponse\x120\n" +
+	"\x04loan\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.LoanR\x04loan\x12?\n" +
+	"\bschedule\x18\x02 \x03(\v2#.finplan.transaction.v1.LoanPaymentR\bschedule\"E\n" +
+	"\x11DeleteLoanRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\x12DeleteLoanResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"u\n" +
+	"\x1aLinkLoanTransactionRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"O\n" +
+	"\x1bLinkLoanTransactionResponse\x120\n" +
+	"\x04loan\x18\x01 \x01(\v2\x1c.finplan.transaction.v1.LoanR\x04loan*Z\n" +
+	"\x0fTransactionType\x12 \n" +
+	"\x1cTRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06INCOME\x10\x01\x12\v\n" +
+	"\aEXPENSE\x10\x02\x12\f\n" +
+	"\bTRANSFER\x10\x03*\xa9\x01\n" +
+	"\x14TransactionSortField\x12&\n" +
+	"\"TRANSACTION_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTRANSACTION_SORT_FIELD_DATE\x10\x01\x12!\n" +
+	"\x1dTRANSACTION_SORT_FIELD_AMOUNT\x10\x02\x12%\n" +
+	"!TRANSACTION_SORT_FIELD_CREATED_AT\x10\x03*\xab\x01\n" +
+	"\x11ReportGranularity\x12\"\n" +
+	"\x1eREPORT_GRANULARITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REPORT_GRANULARITY_DAY\x10\x01\x12\x1b\n" +
+	"\x17REPORT_GRANULARITY_WEEK\x10\x02\x12\x1c\n" +
+	"\x18REPORT_GRANULARITY_MONTH\x10\x03\x12\x1b\n" +
+	"\x17REPORT_GRANULARITY_YEAR\x10\x04*\xbf\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x02\x12 \n" +
+	"\x1cRECURRENCE_FREQUENCY_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_YEARLY\x10\x04*s\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x02\x12\x15\n" +
+	"\x11EXPORT_FORMAT_OFX\x10\x03*\x7f\n" +
+	"\rHouseholdRole\x12\x1e\n" +
+	"\x1aHOUSEHOLD_ROLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HOUSEHOLD_ROLE_OWNER\x10\x01\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_EDITOR\x10\x02\x12\x19\n" +
+	"\x15HOUSEHOLD_ROLE_VIEWER\x10\x03*\xa4\x01\n" +
+	"\vAccountType\x12\x1c\n" +
+	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ACCOUNT_TYPE_CASH\x10\x01\x12\x15\n" +
+	"\x11ACCOUNT_TYPE_CARD\x10\x02\x12\x18\n" +
+	"\x14ACCOUNT_TYPE_SAVINGS\x10\x03\x12\x17\n" +
+	"\x13ACCOUNT_TYPE_CREDIT\x10\x04\x12\x16\n" +
+	"\x12ACCOUNT_TYPE_OTHER\x10\x05*e\n" +
+	"\rLoanDirection\x12\x1e\n" +
+	"\x1aLOAN_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13LOAN_DIRECTION_LENT\x10\x01\x12\x1b\n" +
+	"\x17LOAN_DIRECTION_BORROWED\x10\x02*j\n" +
+	"\fLoanSchedule\x12\x1d\n" +
+	"\x19LOAN_SCHEDULE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LOAN_SCHEDULE_ANNUITY\x10\x01\x12 \n" +
+	"\x1cLOAN_SCHEDULE_DIFFERENTIATED\x10\x022\xc9(\n" +
+	"\x12TransactionService\x12o\n" +
+	"\x0eAddTransaction\x12-.finplan.transaction.v1.AddTransactionRequest\x1a..finplan.transaction.v1.AddTransactionResponse\x12u\n" +
+	"\x10ListTransactions\x12/.finplan.transaction.v1.ListTransactionsRequest\x1a0.finplan.transaction.v1.ListTransactionsResponse\x12x\n" +
+	"\x11DeleteTransaction\x120.finplan.transaction.v1.DeleteTransactionRequest\x1a1.finplan.transaction.v1.DeleteTransactionResponse\x12x\n" +
+	"\x11UpdateTransaction\x120.finplan.transaction.v1.UpdateTransactionRequest\x1a1.finplan.transaction.v1.UpdateTransactionResponse\x12c\n" +
+	"\n" +
+	"GetBalance\x12).finplan.transaction.v1.GetBalanceRequest\x1a*.finplan.transaction.v1.GetBalanceResponse\x12f\n" +
+	"\vGetNetWorth\x12*.finplan.transaction.v1.GetNetWorthRequest\x1a+.finplan.transaction.v1.GetNetWorthResponse\x12{\n" +
+	"\x12ImportTransactions\x121.finplan.transaction.v1.ImportTransactionsRequest\x1a2.finplan.transaction.v1.ImportTransactionsResponse\x12n\n" +
+	"\x12ExportTransactions\x121.finplan.transaction.v1.ExportTransactionsRequest\x1a#.finplan.transaction.v1.ExportChunk0\x01\x12`\n" +
+	"\tGetReport\x12(.finplan.transaction.v1.GetReportRequest\x1a).finplan.transaction.v1.GetReportResponse\x12o\n" +
+	"\x0eCreateCategory\x12-.finplan.transaction.v1.CreateCategoryRequest\x1a..finplan.transaction.v1.CreateCategoryResponse\x12o\n" +
+	"\x0eListCategories\x12-.finplan.transaction.v1.ListCategoriesRequest\x1a..finplan.transaction.v1.ListCategoriesResponse\x12o\n" +
+	"\x0eRenameCategory\x12-.finplan.transaction.v1.RenameCategoryRequest\x1a..finplan.transaction.v1.RenameCategoryResponse\x12r\n" +
+	"\x0fArchiveCategory\x12..finplan.transaction.v1.ArchiveCategoryRequest\x1a/.finplan.transaction.v1.ArchiveCategoryResponse\x12`\n" +
+	"\tSetBudget\x12(.finplan.transaction.v1.SetBudgetRequest\x1a).finplan.transaction.v1.SetBudgetResponse\x12f\n" +
+	"\vListBudgets\x12*.finplan.transaction.v1.ListBudgetsRequest\x1a+.finplan.transaction.v1.ListBudgetsResponse\x12i\n" +
+	"\fDeleteBudget\x12+.finplan.transaction.v1.DeleteBudgetRequest\x1a,.finplan.transaction.v1.DeleteBudgetResponse\x12\x93\x01\n" +
+	"\x1aCreateRecurringTransaction\x129.finplan.transaction.v1.CreateRecurringTransactionRequest\x1a:.finplan.transaction.v1.CreateRecurringTransactionResponse\x12\x90\x01\n" +
+	"\x19ListRecurringTransactions\x128.finplan.transaction.v1.ListRecurringTransactionsRequest\x1a9.finplan.transaction.v1.ListRecurringTransactionsResponse\x12\x90\x01\n" +
+	"\x19PauseRecurringTransaction\x128.finplan.transaction.v1.PauseRecurringTransactionRequest\x1a9.finplan.transaction.v1.PauseRecurringTransactionResponse\x12\x8a\x01\n" +
+	"\x17SkipRecurringOccurrence\x126.finplan.transaction.v1.SkipRecurringOccurrenceRequest\x1a7.finplan.transaction.v1.SkipRecurringOccurrenceResponse\x12\x93\x01\n" +
+	"\x1aDeleteRecurringTransaction\x129.finplan.transaction.v1.DeleteRecurringTransactionRequest\x1a:.finplan.transaction.v1.DeleteRecurringTransactionResponse\x12r\n" +
+	"\x0fCreateHousehold\x12..finplan.transaction.v1.CreateHouseholdRequest\x1a/.finplan.transaction.v1.CreateHouseholdResponse\x12o\n" +
+	"\x0eListHouseholds\x12-.finplan.transaction.v1.ListHouseholdsRequest\x1a..finplan.transaction.v1.ListHouseholdsResponse\x12\x81\x01\n" +
+	"\x14ListHouseholdMembers\x123.finplan.transaction.v1.ListHouseholdMembersRequest\x1a4.finplan.transaction.v1.ListHouseholdMembersResponse\x12\x84\x01\n" +
+	"\x15InviteHouseholdMember\x124.finplan.transaction.v1.InviteHouseholdMemberRequest\x1a5.finplan.transaction.v1.InviteHouseholdMemberResponse\x12\x90\x01\n" +
+	"\x19AcceptHouseholdInvitation\x128.finplan.transaction.v1.AcceptHouseholdInvitationRequest\x1a9.finplan.transaction.v1.AcceptHouseholdInvitationResponse\x12\x90\x01\n" +
+	"\x19UpdateHouseholdMemberRole\x128.finplan.transaction.v1.UpdateHouseholdMemberRoleRequest\x1a9.finplan.transaction.v1.UpdateHouseholdMemberRoleResponse\x12\x84\x01\n" +
+	"\x15RemoveHouseholdMember\x124.finplan.transaction.v1.RemoveHouseholdMemberRequest\x1a5.finplan.transaction.v1.RemoveHouseholdMemberResponse\x12l\n" +
+	"\rCreateAccount\x12,.finplan.transaction.v1.CreateAccountRequest\x1a-.finplan.transaction.v1.CreateAccountResponse\x12i\n" +
+	"\fListAccounts\x12+.finplan.transaction.v1.ListAccountsRequest\x1a,.finplan.transaction.v1.ListAccountsResponse\x12o\n" +
+	"\x0eArchiveAccount\x12-.finplan.transaction.v1.ArchiveAccountRequest\x1a..finplan.transaction.v1.ArchiveAccountResponse\x12c\n" +
+	"\n" +
+	"CreateGoal\x12).finplan.transaction.v1.CreateGoalRequest\x1a*.finplan.transaction.v1.CreateGoalResponse\x12`\n" +
+	"\tListGoals\x12(.finplan.transaction.v1.ListGoalsRequest\x1a).finplan.transaction.v1.ListGoalsResponse\x12Z\n" +
+	"\aGetGoal\x12&.finplan.transaction.v1.GetGoalRequest\x1a'.finplan.transaction.v1.GetGoalResponse\x12c\n" +
+	"\n" +
+	"UpdateGoal\x12).finplan.transaction.v1.UpdateGoalRequest\x1a*.finplan.transaction.v1.UpdateGoalResponse\x12c\n" +
+	"\n" +
+	"DeleteGoal\x12).finplan.transaction.v1.DeleteGoalRequest\x1a*.finplan.transaction.v1.DeleteGoalResponse\x12~\n" +
+	"\x13LinkGoalTransaction\x122.finplan.transaction.v1.LinkGoalTransactionRequest\x1a3.finplan.transaction.v1.LinkGoalTransactionResponse\x12\x80\x01\n" +
+	"\x15UnlinkGoalTransaction\x122.finplan.transaction.v1.LinkGoalTransactionRequest\x1a3.finplan.transaction.v1.LinkGoalTransactionResponse\x12c\n" +
+	"\n" +
+	"CreateLoan\x12).finplan.transaction.v1.CreateLoanRequest\x1a*.finplan.transaction.v1.CreateLoanResponse\x12`\n" +
+	"\tListLoans\x12(.finplan.transaction.v1.ListLoansRequest\x1a).finplan.transaction.v1.ListLoansResponse\x12Z\n" +
+	"\aGetLoan\x12&.finplan.transaction.v1.GetLoanRequest\x1a'.finplan.transaction.v1.GetLoanResponse\x12c\n" +
+	"\n" +
+	"DeleteLoan\x12).finplan.transaction.v1.DeleteLoanRequest\x1a*.finplan.transaction.v1.DeleteLoanResponse\x12~\n" +
+	"\x13LinkLoanTransaction\x122.finplan.transaction.v1.LinkLoanTransactionRequest\x1a3.finplan.transaction.v1.LinkLoanTransactionResponse\x12\x80\x01\n" +
+	"\x15UnlinkLoanTransaction\x122.finplan.transaction.v1.LinkLoanTransactionRequest\x1a3.finplan.transaction.v1.LinkLoanTransactionResponseBKZIgithub.com/khaldeezal/Finplan-structure/proto-definitions/gen/transactionb\x06proto3"
+
+var (
+	file_transaction_proto_rawDescOnce sync.Once
+	file_transaction_proto_rawDescData []byte
+)
+
+func file_transaction_proto_rawDescGZIP() []byte {
+	file_transaction_proto_rawDescOnce.Do(func() {
+		file_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_transaction_proto_rawDesc), len(file_transaction_proto_rawDesc)))
+	})
+	return file_transaction_proto_rawDescData
+}
+
+var file_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 101)
+var file_transaction_proto_goTypes = []any{
+	(TransactionType)(0),                       // 0: finplan.transaction.v1.TransactionType
+	(TransactionSortField)(0),                  // 1: finplan.transaction.v1.TransactionSortField
+	(ReportGranularity)(0),                     // 2: finplan.transaction.v1.ReportGranularity
+	(RecurrenceFrequency)(0),                   // 3: finplan.transaction.v1.RecurrenceFrequency
+	(ExportFormat)(0),                          // 4: finplan.transaction.v1.ExportFormat
+	(HouseholdRole)(0),                         // 5: finplan.transaction.v1.HouseholdRole
+	(AccountType)(0),                           // 6: finplan.transaction.v1.AccountType
+	(LoanDirection)(0),                         // 7: finplan.transaction.v1.LoanDirection
+	(LoanSchedule)(0),                          // 8: finplan.transaction.v1.LoanSchedule
+	(*AddTransactionRequest)(nil),              // 9: finplan.transaction.v1.AddTransactionRequest
+	(*AddTransactionResponse)(nil),             // 10: finplan.transaction.v1.AddTransactionResponse
+	(*ListTransactionsRequest)(nil),            // 11: finplan.transaction.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),           // 12: finplan.transaction.v1.ListTransactionsResponse
+	(*DeleteTransactionRequest)(nil),           // 13: finplan.transaction.v1.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),          // 14: finplan.transaction.v1.DeleteTransactionResponse
+	(*UpdateTransactionRequest)(nil),           // 15: finplan.transaction.v1.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),          // 16: finplan.transaction.v1.UpdateTransactionResponse
+	(*GetBalanceRequest)(nil),                  // 17: finplan.transaction.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                 // 18: finplan.transaction.v1.GetBalanceResponse
+	(*GetNetWorthRequest)(nil),                 // 19: finplan.transaction.v1.GetNetWorthRequest
+	(*GetNetWorthResponse)(nil),                // 20: finplan.transaction.v1.GetNetWorthResponse
+	(*GetReportRequest)(nil),                   // 21: finplan.transaction.v1.GetReportRequest
+	(*GetReportResponse)(nil),                  // 22: finplan.transaction.v1.GetReportResponse
+	(*ReportBucket)(nil),                       // 23: finplan.transaction.v1.ReportBucket
+	(*CategoryTotal)(nil),                      // 24: finplan.transaction.v1.CategoryTotal
+	(*CurrencyBalance)(nil),                    // 25: finplan.transaction.v1.CurrencyBalance
+	(*Transaction)(nil),                        // 26: finplan.transaction.v1.Transaction
+	(*Category)(nil),                           // 27: finplan.transaction.v1.Category
+	(*CreateCategoryRequest)(nil),              // 28: finplan.transaction.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),             // 29: finplan.transaction.v1.CreateCategoryResponse
+	(*ListCategoriesRequest)(nil),              // 30: finplan.transaction.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),             // 31: finplan.transaction.v1.ListCategoriesResponse
+	(*RenameCategoryRequest)(nil),              // 32: finplan.transaction.v1.RenameCategoryRequest
+	(*RenameCategoryResponse)(nil),             // 33: finplan.transaction.v1.RenameCategoryResponse
+	(*ArchiveCategoryRequest)(nil),             // 34: finplan.transaction.v1.ArchiveCategoryRequest
+	(*ArchiveCategoryResponse)(nil),            // 35: finplan.transaction.v1.ArchiveCategoryResponse
+	(*Budget)(nil),                             // 36: finplan.transaction.v1.Budget
+	(*BudgetStatus)(nil),                       // 37: finplan.transaction.v1.BudgetStatus
+	(*SetBudgetRequest)(nil),                   // 38: finplan.transaction.v1.SetBudgetRequest
+	(*SetBudgetResponse)(nil),                  // 39: finplan.transaction.v1.SetBudgetResponse
+	(*ListBudgetsRequest)(nil),                 // 40: finplan.transaction.v1.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),                // 41: finplan.transaction.v1.ListBudgetsResponse
+	(*DeleteBudgetRequest)(nil),                // 42: finplan.transaction.v1.DeleteBudgetRequest
+	(*DeleteBudgetResponse)(nil),               // 43: finplan.transaction.v1.DeleteBudgetResponse
+	(*RecurringTransaction)(nil),               // 44: finplan.transaction.v1.RecurringTransaction
+	(*CreateRecurringTransactionRequest)(nil),  // 45: finplan.transaction.v1.CreateRecurringTransactionRequest
+	(*CreateRecurringTransactionResponse)(nil), // 46: finplan.transaction.v1.CreateRecurringTransactionResponse
+	(*ListRecurringTransactionsRequest)(nil),   // 47: finplan.transaction.v1.ListRecurringTransactionsRequest
+	(*ListRecurringTransactionsResponse)(nil),  // 48: finplan.transaction.v1.ListRecurringTransactionsResponse
+	(*PauseRecurringTransactionRequest)(nil),   // 49: finplan.transaction.v1.PauseRecurringTransactionRequest
+	(*PauseRecurringTransactionResponse)(nil),  // 50: finplan.transaction.v1.PauseRecurringTransactionResponse
+	(*SkipRecurringOccurrenceRequest)(nil),     // 51: finplan.transaction.v1.SkipRecurringOccurrenceRequest
+	(*SkipRecurringOccurrenceResponse)(nil),    // 52: finplan.transaction.v1.SkipRecurringOccurrenceResponse
+	(*DeleteRecurringTransactionRequest)(nil),  // 53: finplan.transaction.v1.DeleteRecurringTransactionRequest
+	(*DeleteRecurringTransactionResponse)(nil), // 54: finplan.transaction.v1.DeleteRecurringTransactionResponse
+	(*ImportColumnMapping)(nil),                // 55: finplan.transaction.v1.ImportColumnMapping
+	(*ImportOptions)(nil),                      // 56: finplan.transaction.v1.ImportOptions
+	(*ImportTransactionsRequest)(nil),          // 57: finplan.transaction.v1.ImportTransactionsRequest
+	(*ImportRowResult)(nil),                    // 58: finplan.transaction.v1.ImportRowResult
+	(*ImportTransactionsResponse)(nil),         // 59: finplan.transaction.v1.ImportTransactionsResponse
+	(*ExportTransactionsRequest)(nil),          // 60: finplan.transaction.v1.ExportTransactionsRequest
+	(*ExportChunk)(nil),                        // 61: finplan.transaction.v1.ExportChunk
+	(*Household)(nil),                          // 62: finplan.transaction.v1.Household
+	(*HouseholdMember)(nil),                    // 63: finplan.transaction.v1.HouseholdMember
+	(*CreateHouseholdRequest)(nil),             // 64: finplan.transaction.v1.CreateHouseholdRequest
+	(*CreateHouseholdResponse)(nil),            // 65: finplan.transaction.v1.CreateHouseholdResponse
+	(*ListHouseholdsRequest)(nil),              // 66: finplan.transaction.v1.ListHouseholdsRequest
+	(*ListHouseholdsResponse)(nil),             // 67: finplan.transaction.v1.ListHouseholdsResponse
+	(*ListHouseholdMembersRequest)(nil),        // 68: finplan.transaction.v1.ListHouseholdMembersRequest
+	(*ListHouseholdMembersResponse)(nil),       // 69: finplan.transaction.v1.ListHouseholdMembersResponse
+	(*InviteHouseholdMemberRequest)(nil),       // 70: finplan.transaction.v1.InviteHouseholdMemberRequest
+	(*InviteHouseholdMemberResponse)(nil),      // 71: finplan.transaction.v1.InviteHouseholdMemberResponse
+	(*AcceptHouseholdInvitationRequest)(nil),   // 72: finplan.transaction.v1.AcceptHouseholdInvitationRequest
+	(*AcceptHouseholdInvitationResponse)(nil),  // 73: finplan.transaction.v1.AcceptHouseholdInvitationResponse
+	(*UpdateHouseholdMemberRoleRequest)(nil),   // 74: finplan.transaction.v1.UpdateHouseholdMemberRoleRequest
+	(*UpdateHouseholdMemberRoleResponse)(nil),  // 75: finplan.transaction.v1.UpdateHouseholdMemberRoleResponse
+	(*RemoveHouseholdMemberRequest)(nil),       // 76: finplan.transaction.v1.RemoveHouseholdMemberRequest
+	(*RemoveHouseholdMemberResponse)(nil),      // 77: finplan.transaction.v1.RemoveHouseholdMemberResponse
+	(*Account)(nil),                            // 78: finplan.transaction.v1.Account
+	(*CreateAccountRequest)(nil),               // 79: finplan.transaction.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),              // 80: finplan.transaction.v1.CreateAccountResponse
+	(*ListAccountsRequest)(nil),                // 81: finplan.transaction.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),               // 82: finplan.transaction.v1.ListAccountsResponse
+	(*ArchiveAccountRequest)(nil),              // 83: finplan.transaction.v1.ArchiveAccountRequest
+	(*ArchiveAccountResponse)(nil),             // 84: finplan.transaction.v1.ArchiveAccountResponse
+	(*Goal)(nil),                               // 85: finplan.transaction.v1.Goal
+	(*CreateGoalRequest)(nil),                  // 86: finplan.transaction.v1.CreateGoalRequest
+	(*CreateGoalResponse)(nil),                 // 87: finplan.transaction.v1.CreateGoalResponse
+	(*ListGoalsRequest)(nil),                   // 88: finplan.transaction.v1.ListGoalsRequest
+	(*ListGoalsResponse)(nil),                  // 89: finplan.transaction.v1.ListGoalsResponse
+	(*GetGoalRequest)(nil),                     // 90: finplan.transaction.v1.GetGoalRequest
+	(*GetGoalResponse)(nil),                    // 91: finplan.transaction.v1.GetGoalResponse
+	(*UpdateGoalRequest)(nil),                  // 92: finplan.transaction.v1.UpdateGoalRequest
+	(*UpdateGoalResponse)(nil),                 // 93: finplan.transaction.v1.UpdateGoalResponse
+	(*DeleteGoalRequest)(nil),                  // 94: finplan.transaction.v1.DeleteGoalRequest
+	(*DeleteGoalResponse)(nil),                 // 95: finplan.transaction.v1.DeleteGoalResponse
+	(*LinkGoalTransactionRequest)(nil),         // 96: finplan.transaction.v1.LinkGoalTransactionRequest
+	(*LinkGoalTransactionResponse)(nil),        // 97: finplan.transaction.v1.LinkGoalTransactionResponse
+	(*Loan)(nil),                               // 98: finplan.transaction.v1.Loan
+	(*LoanPayment)(nil),                        // 99: finplan.transaction.v1.LoanPayment
+	(*CreateLoanRequest)(nil),                  // 100: finplan.transaction.v1.CreateLoanRequest
+	(*CreateLoanResponse)(nil),                 // 101: finplan.transaction.v1.CreateLoanResponse
+	(*ListLoansRequest)(nil),                   // 102: finplan.transaction.v1.ListLoansRequest
+	(*ListLoansResponse)(nil),                  // 103: finplan.transaction.v1.ListLoansResponse
+	(*GetLoanRequest)(nil),                     // 104: finplan.transaction.v1.GetLoanRequest
+	(*GetLoanResponse)(nil),                    // 105: finplan.transaction.v1.GetLoanResponse
+	(*DeleteLoanRequest)(nil),                  // 106: finplan.transaction.v1.DeleteLoanRequest
+	(*DeleteLoanResponse)(nil),                 // 107: finplan.transaction.v1.DeleteLoanResponse
+	(*LinkLoanTransactionRequest)(nil),         // 108: finplan.transaction.v1.LinkLoanTransactionRequest
+	(*LinkLoanTransactionResponse)(nil),        // 109: finplan.transaction.v1.LinkLoanTransactionResponse
+	(*timestamppb.Timestamp)(nil),              // 110: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),              // 111: google.protobuf.FieldMask
+}
+var file_transaction_proto_depIdxs = []int32{
+	0,   // 0: finplan.transaction.v1.AddTransactionRequest.type:type_name -> finplan.transaction.v1.TransactionType
+	110, // 1: finplan.transaction.v1.AddTransactionRequest.date:type_name -> google.protobuf.Timestamp
+	110, // 2: finplan.transaction.v1.ListTransactionsRequest.date_from:type_name -> google.protobuf.Timestamp
+	110, // 3: finplan.transaction.v1.ListTransactionsRequest.date_to:type_name -> google.protobuf.Timestamp
+	0,   // 4: finplan.transaction.v1.ListTransactionsRequest.type:type_name -> finplan.transaction.v1.TransactionType
+	1,   // 5: finplan.transaction.v1.ListTransactionsRequest.sort_by:type_name -> finplan.transaction.v1.TransactionSortField
+	26,  // 6: finplan.transaction.v1.ListTransactionsResponse.transactions:type_name -> finplan.transaction.v1.Transaction
+	26,  // 7: finplan.transaction.v1.UpdateTransactionRequest.transaction:type_name -> finplan.transaction.v1.Transaction
+	111, // 8: finplan.transaction.v1.UpdateTransactionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26,  // 9: finplan.transaction.v1.UpdateTransactionResponse.transaction:type_name -> finplan.transaction.v1.Transaction
+	25,  // 10: finplan.transaction.v1.GetBalanceResponse.by_currency:type_name -> finplan.transaction.v1.CurrencyBalance
+	78,  // 11: finplan.transaction.v1.GetBalanceResponse.accounts:type_name -> finplan.transaction.v1.Account
+	78,  // 12: finplan.transaction.v1.GetNetWorthResponse.accounts:type_name -> finplan.transaction.v1.Account
+	98,  // 13: finplan.transaction.v1.GetNetWorthResponse.loans:type_name -> finplan.transaction.v1.Loan
+	110, // 14: finplan.transaction.v1.GetReportRequest.date_from:type_name -> google.protobuf.Timestamp
+	110, // 15: finplan.transaction.v1.GetReportRequest.date_to:type_name -> google.protobuf.Timestamp
+	2,   // 16: finplan.transaction.v1.GetReportRequest.granularity:type_name -> finplan.transaction.v1.ReportGranularity
+	23,  // 17: finplan.transaction.v1.GetReportResponse.buckets:type_name -> finplan.transaction.v1.ReportBucket
+	110, // 18: finplan.transaction.v1.ReportBucket.period_start:type_name -> google.protobuf.Timestamp
+	110, // 19: finplan.transaction.v1.ReportBucket.period_end:type_name -> google.protobuf.Timestamp
+	24,  // 20: finplan.transaction.v1.ReportBucket.categories:type_name -> finplan.transaction.v1.CategoryTotal
+	0,   // 21: finplan.transaction.v1.Transaction.type:type_name -> finplan.transaction.v1.TransactionType
+	110, // 22: finplan.transaction.v1.Transaction.date:type_name -> google.protobuf.Timestamp
+	110, // 23: finplan.transaction.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	110, // 24: finplan.transaction.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 25: finplan.transaction.v1.Category.type:type_name -> finplan.transaction.v1.TransactionType
+	110, // 26: finplan.transaction.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 27: finplan.transaction.v1.CreateCategoryRequest.type:type_name -> finplan.transaction.v1.TransactionType
+	0,   // 28: finplan.transaction.v1.ListCategoriesRequest.type:type_name -> finplan.transaction.v1.TransactionType
+	27,  // 29: finplan.transaction.v1.ListCategoriesResponse.categories:type_name -> finplan.transaction.v1.Category
+	110, // 30: finplan.transaction.v1.Budget.created_at:type_name -> google.protobuf.Timestamp
+	110, // 31: finplan.transaction.v1.Budget.updated_at:type_name -> google.protobuf.Timestamp
+	36,  // 32: finplan.transaction.v1.BudgetStatus.budget:type_name -> finplan.transaction.v1.Budget
+	36,  // 33: finplan.transaction.v1.SetBudgetResponse.budget:type_name -> finplan.transaction.v1.Budget
+	110, // 34: finplan.transaction.v1.ListBudgetsRequest.month:type_name -> google.protobuf.Timestamp
+	37,  // 35: finplan.transaction.v1.ListBudgetsResponse.budgets:type_name -> finplan.transaction.v1.BudgetStatus
+	110, // 36: finplan.transaction.v1.ListBudgetsResponse.month:type_name -> google.protobuf.Timestamp
+	0,   // 37: finplan.transaction.v1.RecurringTransaction.type:type_name -> finplan.transaction.v1.TransactionType
+	3,   // 38: finplan.transaction.v1.RecurringTransaction.frequency:type_name -> finplan.transaction.v1.RecurrenceFrequency
+	110, // 39: finplan.transaction.v1.RecurringTransaction.start_date:type_name -> google.protobuf.Timestamp
+	110, // 40: finplan.transaction.v1.RecurringTransaction.end_date:type_name -> google.protobuf.Timestamp
+	110, // 41: finplan.transaction.v1.RecurringTransaction.next_date:type_name -> google.protobuf.Timestamp
+	110, // 42: finplan.transaction.v1.RecurringTransaction.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 43: finplan.transaction.v1.CreateRecurringTransactionRequest.type:type_name -> finplan.transaction.v1.TransactionType
+	3,   // 44: finplan.transaction.v1.CreateRecurringTransactionRequest.frequency:type_name -> finplan.transaction.v1.RecurrenceFrequency
+	110, // 45: finplan.transaction.v1.CreateRecurringTransactionRequest.start_date:type_name -> google.protobuf.Timestamp
+	110, // 46: finplan.transaction.v1.CreateRecurringTransactionRequest.end_date:type_name -> google.protobuf.Timestamp
+	44,  // 47: finplan.transaction.v1.CreateRecurringTransactionResponse.recurring:type_name -> finplan.transaction.v1.RecurringTransaction
+	44,  // 48: finplan.transaction.v1.ListRecurringTransactionsResponse.recurring:type_name -> finplan.transaction.v1.RecurringTransaction
+	44,  // 49: finplan.transaction.v1.PauseRecurringTransactionResponse.recurring:type_name -> finplan.transaction.v1.RecurringTransaction
+	44,  // 50: finplan.transaction.v1.SkipRecurringOccurrenceResponse.recurring:type_name -> finplan.transaction.v1.RecurringTransaction
+	55,  // 51: finplan.transaction.v1.ImportOptions.columns:type_name -> finplan.transaction.v1.ImportColumnMapping
+	56,  // 52: finplan.transaction.v1.ImportTransactionsRequest.options:type_name -> finplan.transaction.v1.ImportOptions
+	58,  // 53: finplan.transaction.v1.ImportTransactionsResponse.rows:type_name -> finplan.transaction.v1.ImportRowResult
+	11,  // 54: finplan.transaction.v1.ExportTransactionsRequest.filter:type_name -> finplan.transaction.v1.ListTransactionsRequest
+	4,   // 55: finplan.transaction.v1.ExportTransactionsRequest.format:type_name -> finplan.transaction.v1.ExportFormat
+	5,   // 56: finplan.transaction.v1.Household.role:type_name -> finplan.transaction.v1.HouseholdRole
+	110, // 57: finplan.transaction.v1.Household.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 58: finplan.transaction.v1.HouseholdMember.role:type_name -> finplan.transaction.v1.HouseholdRole
+	110, // 59: finplan.transaction.v1.HouseholdMember.joined_at:type_name -> google.protobuf.Timestamp
+	62,  // 60: finplan.transaction.v1.CreateHouseholdResponse.household:type_name -> finplan.transaction.v1.Household
+	62,  // 61: finplan.transaction.v1.ListHouseholdsResponse.households:type_name -> finplan.transaction.v1.Household
+	63,  // 62: finplan.transaction.v1.ListHouseholdMembersResponse.members:type_name -> finplan.transaction.v1.HouseholdMember
+	5,   // 63: finplan.transaction.v1.InviteHouseholdMemberRequest.role:type_name -> finplan.transaction.v1.HouseholdRole
+	110, // 64: finplan.transaction.v1.InviteHouseholdMemberResponse.expires_at:type_name -> google.protobuf.Timestamp
+	63,  // 65: finplan.transaction.v1.AcceptHouseholdInvitationResponse.member:type_name -> finplan.transaction.v1.HouseholdMember
+	5,   // 66: finplan.transaction.v1.UpdateHouseholdMemberRoleRequest.role:type_name -> finplan.transaction.v1.HouseholdRole
+	63,  // 67: finplan.transaction.v1.UpdateHouseholdMemberRoleResponse.member:type_name -> finplan.transaction.v1.HouseholdMember
+	6,   // 68: finplan.transaction.v1.Account.type:type_name -> finplan.transaction.v1.AccountType
+	110, // 69: finplan.transaction.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 70: finplan.transaction.v1.CreateAccountRequest.type:type_name -> finplan.transaction.v1.AccountType
+	78,  // 71: finplan.transaction.v1.CreateAccountResponse.account:type_name -> finplan.transaction.v1.Account
+	78,  // 72: finplan.transaction.v1.ListAccountsResponse.accounts:type_name -> finplan.transaction.v1.Account
+	110, // 73: finplan.transaction.v1.Goal.deadline:type_name -> google.protobuf.Timestamp
+	110, // 74: finplan.transaction.v1.Goal.created_at:type_name -> google.protobuf.Timestamp
+	110, // 75: finplan.transaction.v1.Goal.projected_completion:type_name -> google.protobuf.Timestamp
+	110, // 76: finplan.transaction.v1.CreateGoalRequest.deadline:type_name -> google.protobuf.Timestamp
+	85,  // 77: finplan.transaction.v1.CreateGoalResponse.goal:type_name -> finplan.transaction.v1.Goal
+	85,  // 78: finplan.transaction.v1.ListGoalsResponse.goals:type_name -> finplan.transaction.v1.Goal
+	85,  // 79: finplan.transaction.v1.GetGoalResponse.goal:type_name -> finplan.transaction.v1.Goal
+	85,  // 80: finplan.transaction.v1.UpdateGoalRequest.goal:type_name -> finplan.transaction.v1.Goal
+	111, // 81: finplan.transaction.v1.UpdateGoalRequest.update_mask:type_name -> google.protobuf.FieldMask
+	85,  // 82: finplan.transaction.v1.UpdateGoalResponse.goal:type_name -> finplan.transaction.v1.Goal
+	85,  // 83: finplan.transaction.v1.LinkGoalTransactionResponse.goal:type_name -> finplan.transaction.v1.Goal
+	7,   // 84: finplan.transaction.v1.Loan.direction:type_name -> finplan.transaction.v1.LoanDirection
+	8,   // 85: finplan.transaction.v1.Loan.schedule:type_name -> finplan.transaction.v1.LoanSchedule
+	110, // 86: finplan.transaction.v1.Loan.start_date:type_name -> google.protobuf.Timestamp
+	110, // 87: finplan.transaction.v1.Loan.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 88: finplan.transaction.v1.Loan.next_payment:type_name -> finplan.transaction.v1.LoanPayment
+	110, // 89: finplan.transaction.v1.LoanPayment.date:type_name -> google.protobuf.Timestamp
+	7,   // 90: finplan.transaction.v1.CreateLoanRequest.direction:type_name -> finplan.transaction.v1.LoanDirection
+	8,   // 91: finplan.transaction.v1.CreateLoanRequest.schedule:type_name -> finplan.transaction.v1.LoanSchedule
+	110, // 92: finplan.transaction.v1.CreateLoanRequest.start_date:type_name -> google.protobuf.Timestamp
+	98,  // 93: finplan.transaction.v1.CreateLoanResponse.loan:type_name -> finplan.transaction.v1.Loan
+	98,  // 94: finplan.transaction.v1.ListLoansResponse.loans:type_name -> finplan.transaction.v1.Loan
+	98,  // 95: finplan.transaction.v1.GetLoanResponse.loan:type_name -> finplan.transaction.v1.Loan
+	99,  // 96: finplan.transaction.v1.GetLoanResponse.schedule:type_name -> finplan.transaction.v1.LoanPayment
+	98,  // 97: finplan.transaction.v1.LinkLoanTransactionResponse.loan:type_name -> finplan.transaction.v1.Loan
+	9,   // 98: finplan.transaction.v1.TransactionService.AddTransaction:input_type -> finplan.transaction.v1.AddTransactionRequest
+	11,  // 99: finplan.transaction.v1.TransactionService.ListTransactions:input_type -> finplan.transaction.v1.ListTransactionsRequest
+	13,  // 100: finplan.transaction.v1.TransactionService.DeleteTransaction:input_type -> finplan.transaction.v1.DeleteTransactionRequest
+	15,  // 101: finplan.transaction.v1.TransactionService.UpdateTransaction:input_type -> finplan.transaction.v1.UpdateTransactionRequest
+	17,  // 102: finplan.transaction.v1.TransactionService.GetBalance:input_type -> finplan.transaction.v1.GetBalanceRequest
+	19,  // 103: finplan.transaction.v1.TransactionService.GetNetWorth:input_type -> finplan.transaction.v1.GetNetWorthRequest
+	57,  // 104: finplan.transaction.v1.TransactionService.ImportTransactions:input_type -> finplan.transaction.v1.ImportTransactionsRequest
+	60,  // 105: finplan.transaction.v1.TransactionService.ExportTransactions:input_type -> finplan.transaction.v1.ExportTransactionsRequest
+	21,  // 106: finplan.transaction.v1.TransactionService.GetReport:input_type -> finplan.transaction.v1.GetReportRequest
+	28,  // 107: finplan.transaction.v1.TransactionService.CreateCategory:input_type -> finplan.transaction.v1.CreateCategoryRequest
+	30,  // 108: finplan.transaction.v1.TransactionService.ListCategories:input_type -> finplan.transaction.v1.ListCategoriesRequest
+	32,  // 109: finplan.transaction.v1.TransactionService.RenameCategory:input_type -> finplan.transaction.v1.RenameCategoryRequest
+	34,  // 110: finplan.transaction.v1.TransactionService.ArchiveCategory:input_type -> finplan.transaction.v1.ArchiveCategoryRequest
+	38,  // 111: finplan.transaction.v1.TransactionService.SetBudget:input_type -> finplan.transaction.v1.SetBudgetRequest
+	40,  // 112: finplan.transaction.v1.TransactionService.ListBudgets:input_type -> finplan.transaction.v1.ListBudgetsRequest
+	42,  // 113: finplan.transaction.v1.TransactionService.DeleteBudget:input_type -> finplan.transaction.v1.DeleteBudgetRequest
+	45,  // 114: finplan.transaction.v1.TransactionService.CreateRecurringTransaction:input_type -> finplan.transaction.v1.CreateRecurringTransactionRequest
+	47,  // 115: finplan.transaction.v1.TransactionService.ListRecurringTransactions:input_type -> finplan.transaction.v1.ListRecurringTransactionsRequest
+	49,  // 116: finplan.transaction.v1.TransactionService.PauseRecurringTransaction:input_type -> finplan.transaction.v1.PauseRecurringTransactionRequest
+	51,  // 117: finplan.transaction.v1.TransactionService.SkipRecurringOccurrence:input_type -> finplan.transaction.v1.SkipRecurringOccurrenceRequest
+	53,  // 118: finplan.transaction.v1.TransactionService.DeleteRecurringTransaction:input_type -> finplan.transaction.v1.DeleteRecurringTransactionRequest
+	64,  // 119: finplan.transaction.v1.TransactionService.CreateHousehold:input_type -> finplan.transaction.v1.CreateHouseholdRequest
+	66,  // 120: finplan.transaction.v1.TransactionService.ListHouseholds:input_type -> finplan.transaction.v1.ListHouseholdsRequest
+	68,  // 121: finplan.transaction.v1.TransactionService.ListHouseholdMembers:input_type -> finplan.transaction.v1.ListHouseholdMembersRequest
+	70,  // 122: finplan.transaction.v1.TransactionService.InviteHouseholdMember:input_type -> finplan.transaction.v1.InviteHouseholdMemberRequest
+	72,  // 123: finplan.transaction.v1.TransactionService.AcceptHouseholdInvitation:input_type -> finplan.transaction.v1.AcceptHouseholdInvitationRequest
+	74,  // 124: finplan.transaction.v1.TransactionService.UpdateHouseholdMemberRole:input_type -> finplan.transaction.v1.UpdateHouseholdMemberRoleRequest
+	76,  // 125: finplan.transaction.v1.TransactionService.RemoveHouseholdMember:input_type -> finplan.transaction.v1.RemoveHouseholdMemberRequest
+	79,  // 126: finplan.transaction.v1.TransactionService.CreateAccount:input_type -> finplan.transaction.v1.CreateAccountRequest
+	81,  // 127: finplan.transaction.v1.TransactionService.ListAccounts:input_type -> finplan.transaction.v1.ListAccountsRequest
+	83,  // 128: finplan.transaction.v1.TransactionService.ArchiveAccount:input_type -> finplan.transaction.v1.ArchiveAccountRequest
+	86,  // 129: finplan.transaction.v1.TransactionService.CreateGoal:input_type -> finplan.transaction.v1.CreateGoalRequest
+	88,  // 130: finplan.transaction.v1.TransactionService.ListGoals:input_type -> finplan.transaction.v1.ListGoalsRequest
+	90,  // 131: finplan.transaction.v1.TransactionService.GetGoal:input_type -> finplan.transaction.v1.GetGoalRequest
+	92,  // 132: finplan.transaction.v1.TransactionService.UpdateGoal:input_type -> finplan.transaction.v1.UpdateGoalRequest
+	94,  // 133: finplan.transaction.v1.TransactionService.DeleteGoal:input_type -> finplan.transaction.v1.DeleteGoalRequest
+	96,  // 134: finplan.transaction.v1.TransactionService.LinkGoalTransaction:input_type -> finplan.transaction.v1.LinkGoalTransactionRequest
+	96,  // 135: finplan.transaction.v1.TransactionService.UnlinkGoalTransaction:input_type -> finplan.transaction.v1.LinkGoalTransactionRequest
+	100, // 136: finplan.transaction.v1.TransactionService.CreateLoan:input_type -> finplan.transaction.v1.CreateLoanRequest
+	102, // 137: finplan.transaction.v1.TransactionService.ListLoans:input_type -> finplan.transaction.v1.ListLoansRequest
+	104, // 138: finplan.transaction.v1.TransactionService.GetLoan:input_type -> finplan.transaction.v1.GetLoanRequest
+	106, // 139: finplan.transaction.v1.TransactionService.DeleteLoan:input_type -> finplan.transaction.v1.DeleteLoanRequest
+	108, // 140: finplan.transaction.v1.TransactionService.LinkLoanTransaction:input_type -> finplan.transaction.v1.LinkLoanTransactionRequest
+	108, // 141: finplan.transaction.v1.TransactionService.UnlinkLoanTransaction:input_type -> finplan.transaction.v1.LinkLoanTransactionRequest
+	10,  // 142: finplan.transaction.v1.TransactionService.AddTransaction:output_type -> finplan.transaction.v1.AddTransactionResponse
+	12,  // 143: finplan.transaction.v1.TransactionService.ListTransactions:output_type -> finplan.transaction.v1.ListTransactionsResponse
+	14,  // 144: finplan.transaction.v1.TransactionService.DeleteTransaction:output_type -> finplan.transaction.v1.DeleteTransactionResponse
+	16,  // 145: finplan.transaction.v1.TransactionService.UpdateTransaction:output_type -> finplan.transaction.v1.UpdateTransactionResponse
+	18,  // 146: finplan.transaction.v1.TransactionService.GetBalance:output_type -> finplan.transaction.v1.GetBalanceResponse
+	20,  // 147: finplan.transaction.v1.TransactionService.GetNetWorth:output_type -> finplan.transaction.v1.GetNetWorthResponse
+	59,  // 148: finplan.transaction.v1.TransactionService.ImportTransactions:output_type -> finplan.transaction.v1.ImportTransactionsResponse
+	61,  // 149: finplan.transaction.v1.TransactionService.ExportTransactions:output_type -> finplan.transaction.v1.ExportChunk
+	22,  // 150: finplan.transaction.v1.TransactionService.GetReport:output_type -> finplan.transaction.v1.GetReportResponse
+	29,  // 151: finplan.transaction.v1.TransactionService.CreateCategory:output_type -> finplan.transaction.v1.CreateCategoryResponse
+	31,  // 152: finplan.transaction.v1.TransactionService.ListCategories:output_type -> finplan.transaction.v1.ListCategoriesResponse
+	33,  // 153: finplan.transaction.v1.TransactionService.RenameCategory:output_type -> finplan.transaction.v1.RenameCategoryResponse
+	35,  // 154: finplan.transaction.v1.TransactionService.ArchiveCategory:output_type -> finplan.transaction.v1.ArchiveCategoryResponse
+	39,  // 155: finplan.transaction.v1.TransactionService.SetBudget:output_type -> finplan.transaction.v1.SetBudgetResponse
+	41,  // 156: finplan.transaction.v1.TransactionService.ListBudgets:output_type -> finplan.transaction.v1.ListBudgetsResponse
+	43,  // 157: finplan.transaction.v1.TransactionService.DeleteBudget:output_type -> finplan.transaction.v1.DeleteBudgetResponse
+	46,  // 158: finplan.transaction.v1.TransactionService.CreateRecurringTransaction:output_type -> finplan.transaction.v1.CreateRecurringTransactionResponse
+	48,  // 159: finplan.transaction.v1.TransactionService.ListRecurringTransactions:output_type -> finplan.transaction.v1.ListRecurringTransactionsResponse
+	50,  // 160: finplan.transaction.v1.TransactionService.PauseRecurringTransaction:output_type -> finplan.transaction.v1.PauseRecurringTransactionResponse
+	52,  // 161: finplan.transaction.v1.TransactionService.SkipRecurringOccurrence:output_type -> finplan.transaction.v1.SkipRecurringOccurrenceResponse
+	54,  // 162: finplan.transaction.v1.TransactionService.DeleteRecurringTransaction:output_type -> finplan.transaction.v1.DeleteRecurringTransactionResponse
+	65,  // 163: finplan.transaction.v1.TransactionService.CreateHousehold:output_type -> finplan.transaction.v1.CreateHouseholdResponse
+	67,  // 164: finplan.transaction.v1.TransactionService.ListHouseholds:output_type -> finplan.transaction.v1.ListHouseholdsResponse
+	69,  // 165: finplan.transaction.v1.TransactionService.ListHouseholdMembers:output_type -> finplan.transaction.v1.ListHouseholdMembersResponse
+	71,  // 166: finplan.transaction.v1.TransactionService.InviteHouseholdMember:output_type -> finplan.transaction.v1.InviteHouseholdMemberResponse
+	73,  // 167: finplan.transaction.v1.TransactionService.AcceptHouseholdInvitation:output_type -> finplan.transaction.v1.AcceptHouseholdInvitationResponse
+	75,  // 168: finplan.transaction.v1.TransactionService.UpdateHouseholdMemberRole:output_type -> finplan.transaction.v1.UpdateHouseholdMemberRoleResponse
+	77,  // 169: finplan.transaction.v1.TransactionService.RemoveHouseholdMember:output_type -> finplan.transaction.v1.RemoveHouseholdMemberResponse
+	80,  // 170: finplan.transaction.v1.TransactionService.CreateAccount:output_type -> finplan.transaction.v1.CreateAccountResponse
+	82,  // 171: finplan.transaction.v1.TransactionService.ListAccounts:output_type -> finplan.transaction.v1.ListAccountsResponse
+	84,  // 172: finplan.transaction.v1.TransactionService.ArchiveAccount:output_type -> finplan.transaction.v1.ArchiveAccountResponse
+	87,  // 173: finplan.transaction.v1.TransactionService.CreateGoal:output_type -> finplan.transaction.v1.CreateGoalResponse
+	89,  // 174: finplan.transaction.v1.TransactionService.ListGoals:output_type -> finplan.transaction.v1.ListGoalsResponse
+	91,  // 175: finplan.transaction.v1.TransactionService.GetGoal:output_type -> finplan.transaction.v1.GetGoalResponse
+	93,  // 176: finplan.transaction.v1.TransactionService.UpdateGoal:output_type -> finplan.transaction.v1.UpdateGoalResponse
+	95,  // 177: finplan.transaction.v1.TransactionService.DeleteGoal:output_type -> finplan.transaction.v1.DeleteGoalResponse
+	97,  // 178: finplan.transaction.v1.TransactionService.LinkGoalTransaction:output_type -> finplan.transaction.v1.LinkGoalTransactionResponse
+	97,  // 179: finplan.transaction.v1.TransactionService.UnlinkGoalTransaction:output_type -> finplan.transaction.v1.LinkGoalTransactionResponse
+	101, // 180: finplan.transaction.v1.TransactionService.CreateLoan:output_type -> finplan.transaction.v1.CreateLoanResponse
+	103, // 181: finplan.transaction.v1.TransactionService.ListLoans:output_type -> finplan.transaction.v1.ListLoansResponse
+	105, // 182: finplan.transaction.v1.TransactionService.GetLoan:output_type -> finplan.transaction.v1.GetLoanResponse
+	107, // 183: finplan.transaction.v1.TransactionService.DeleteLoan:output_type -> finplan.transaction.v1.DeleteLoanResponse
+	109, // 184: finplan.transaction.v1.TransactionService.LinkLoanTransaction:output_type -> finplan.transaction.v1.LinkLoanTransactionResponse
+	109, // 185: finplan.transaction.v1.TransactionService.UnlinkLoanTransaction:output_type -> finplan.transaction.v1.LinkLoanTransactionResponse
+	142, // [142:186] is the sub-list for method output_type
+	98,  // [98:142] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
+}
+
+func init() { file_transaction_proto_init() }
+func file_transaction_proto_init() {
+	if File_transaction_proto != nil {
+		return
+	}
+	file_transaction_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transaction_proto_rawDesc), len(file_transaction_proto_rawDesc)),
+			NumEnums:      9,
+			NumMessages:   101,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_transaction_proto_goTypes,
+		DependencyIndexes: file_transaction_proto_depIdxs,
+		EnumInfos:         file_transaction_proto_enumTypes,
+		MessageInfos:      file_transaction_proto_msgTypes,
+	}.Build()
+	File_transaction_proto = out.File
+	file_transaction_proto_goTypes = nil
+	file_transaction_proto_depIdxs = nil
+}
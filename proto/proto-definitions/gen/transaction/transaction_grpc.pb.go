@@ -0,0 +1,1787 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: transaction.proto
+
+package transaction
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TransactionService_AddTransaction_FullMethodName             = "/finplan.transaction.v1.TransactionService/AddTransaction"
+	TransactionService_ListTransactions_FullMethodName           = "/finplan.transaction.v1.TransactionService/ListTransactions"
+	TransactionService_DeleteTransaction_FullMethodName          = "/finplan.transaction.v1.TransactionService/DeleteTransaction"
+	TransactionService_UpdateTransaction_FullMethodName          = "/finplan.transaction.v1.TransactionService/UpdateTransaction"
+	TransactionService_GetBalance_FullMethodName                 = "/finplan.transaction.v1.TransactionService/GetBalance"
+	TransactionService_GetNetWorth_FullMethodName                = "/finplan.transaction.v1.TransactionService/GetNetWorth"
+	TransactionService_ImportTransactions_FullMethodName         = "/finplan.transaction.v1.TransactionService/ImportTransactions"
+	TransactionService_ExportTransactions_FullMethodName         = "/finplan.transaction.v1.TransactionService/ExportTransactions"
+	TransactionService_GetReport_FullMethodName                  = "/finplan.transaction.v1.TransactionService/GetReport"
+	TransactionService_CreateCategory_FullMethodName             = "/finplan.transaction.v1.TransactionService/CreateCategory"
+	TransactionService_ListCategories_FullMethodName             = "/finplan.transaction.v1.TransactionService/ListCategories"
+	TransactionService_RenameCategory_FullMethodName             = "/finplan.transaction.v1.TransactionService/RenameCategory"
+	TransactionService_ArchiveCategory_FullMethodName            = "/finplan.transaction.v1.TransactionService/ArchiveCategory"
+	TransactionService_SetBudget_FullMethodName                  = "/finplan.transaction.v1.TransactionService/SetBudget"
+	TransactionService_ListBudgets_FullMethodName                = "/finplan.transaction.v1.TransactionService/ListBudgets"
+	TransactionService_DeleteBudget_FullMethodName               = "/finplan.transaction.v1.TransactionService/DeleteBudget"
+	TransactionService_CreateRecurringTransaction_FullMethodName = "/finplan.transaction.v1.TransactionService/CreateRecurringTransaction"
+	TransactionService_ListRecurringTransactions_FullMethodName  = "/finplan.transaction.v1.TransactionService/ListRecurringTransactions"
+	TransactionService_PauseRecurringTransaction_FullMethodName  = "/finplan.transaction.v1.TransactionService/PauseRecurringTransaction"
+	TransactionService_SkipRecurringOccurrence_FullMethodName    = "/finplan.transaction.v1.TransactionService/SkipRecurringOccurrence"
+	TransactionService_DeleteRecurringTransaction_FullMethodName = "/finplan.transaction.v1.TransactionService/DeleteRecurringTransaction"
+	TransactionService_CreateHousehold_FullMethodName            = "/finplan.transaction.v1.TransactionService/CreateHousehold"
+	TransactionService_ListHouseholds_FullMethodName             = "/finplan.transaction.v1.TransactionService/ListHouseholds"
+	TransactionService_ListHouseholdMembers_FullMethodName       = "/finplan.transaction.v1.TransactionService/ListHouseholdMembers"
+	TransactionService_InviteHouseholdMember_FullMethodName      = "/finplan.transaction.v1.TransactionService/InviteHouseholdMember"
+	TransactionService_AcceptHouseholdInvitation_FullMethodName  = "/finplan.transaction.v1.TransactionService/AcceptHouseholdInvitation"
+	TransactionService_UpdateHouseholdMemberRole_FullMethodName  = "/finplan.transaction.v1.TransactionService/UpdateHouseholdMemberRole"
+	TransactionService_RemoveHouseholdMember_FullMethodName      = "/finplan.transaction.v1.TransactionService/RemoveHouseholdMember"
+	TransactionService_CreateAccount_FullMethodName              = "/finplan.transaction.v1.TransactionService/CreateAccount"
+	TransactionService_ListAccounts_FullMethodName               = "/finplan.transaction.v1.TransactionService/ListAccounts"
+	TransactionService_ArchiveAccount_FullMethodName             = "/finplan.transaction.v1.TransactionService/ArchiveAccount"
+	TransactionService_CreateGoal_FullMethodName                 = "/finplan.transaction.v1.TransactionService/CreateGoal"
+	TransactionService_ListGoals_FullMethodName                  = "/finplan.transaction.v1.TransactionService/ListGoals"
+	TransactionService_GetGoal_FullMethodName                    = "/finplan.transaction.v1.TransactionService/GetGoal"
+	TransactionService_UpdateGoal_FullMethodName                 = "/finplan.transaction.v1.TransactionService/UpdateGoal"
+	TransactionService_DeleteGoal_FullMethodName                 = "/finplan.transaction.v1.TransactionService/DeleteGoal"
+	TransactionService_LinkGoalTransaction_FullMethodName        = "/finplan.transaction.v1.TransactionService/LinkGoalTransaction"
+	TransactionService_UnlinkGoalTransaction_FullMethodName      = "/finplan.transaction.v1.TransactionService/UnlinkGoalTransaction"
+	TransactionService_CreateLoan_FullMethodName                 = "/finplan.transaction.v1.TransactionService/CreateLoan"
+	TransactionService_ListLoans_FullMethodName                  = "/finplan.transaction.v1.TransactionService/ListLoans"
+	TransactionService_GetLoan_FullMethodName                    = "/finplan.transaction.v1.TransactionService/GetLoan"
+	TransactionService_DeleteLoan_FullMethodName                 = "/finplan.transaction.v1.TransactionService/DeleteLoan"
+	TransactionService_LinkLoanTransaction_FullMethodName        = "/finplan.transaction.v1.TransactionService/LinkLoanTransaction"
+	TransactionService_UnlinkLoanTransaction_FullMethodName      = "/finplan.transaction.v1.TransactionService/UnlinkLoanTransaction"
+)
+
+// TransactionServiceClient is the client API for TransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис для работы с транзакциями
+type TransactionServiceClient interface {
+	AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
+	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	// Чистая стоимость: остатки счетов плюс выданные займы минус долги
+	GetNetWorth(ctx context.Context, in *GetNetWorthRequest, opts ...grpc.CallOption) (*GetNetWorthResponse, error)
+	// Загрузка выписки CSV: корректные строки сохраняются одной транзакцией БД
+	ImportTransactions(ctx context.Context, in *ImportTransactionsRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error)
+	// Выгрузка транзакций файлом; содержимое передаётся частями, чтобы не держать историю в памяти
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// Доходы, расходы и остатки по периодам
+	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error)
+	// Категории: пользовательские и системные (по умолчанию)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*RenameCategoryResponse, error)
+	ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryResponse, error)
+	// Месячные бюджеты по категориям расходов
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error)
+	ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...grpc.CallOption) (*ListBudgetsResponse, error)
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	// Повторяющиеся транзакции: шаблоны, по которым транзакции создаются автоматически
+	CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error)
+	ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error)
+	PauseRecurringTransaction(ctx context.Context, in *PauseRecurringTransactionRequest, opts ...grpc.CallOption) (*PauseRecurringTransactionResponse, error)
+	SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceRequest, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResponse, error)
+	DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error)
+	// Общие бюджеты семьи (households): участники с ролями и приглашения.
+	// Управляет участниками только владелец
+	CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error)
+	ListHouseholds(ctx context.Context, in *ListHouseholdsRequest, opts ...grpc.CallOption) (*ListHouseholdsResponse, error)
+	ListHouseholdMembers(ctx context.Context, in *ListHouseholdMembersRequest, opts ...grpc.CallOption) (*ListHouseholdMembersResponse, error)
+	InviteHouseholdMember(ctx context.Context, in *InviteHouseholdMemberRequest, opts ...grpc.CallOption) (*InviteHouseholdMemberResponse, error)
+	AcceptHouseholdInvitation(ctx context.Context, in *AcceptHouseholdInvitationRequest, opts ...grpc.CallOption) (*AcceptHouseholdInvitationResponse, error)
+	UpdateHouseholdMemberRole(ctx context.Context, in *UpdateHouseholdMemberRoleRequest, opts ...grpc.CallOption) (*UpdateHouseholdMemberRoleResponse, error)
+	RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*RemoveHouseholdMemberResponse, error)
+	// Счета (наличные, карты, накопления). Каждая транзакция привязана к счёту
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	ArchiveAccount(ctx context.Context, in *ArchiveAccountRequest, opts ...grpc.CallOption) (*ArchiveAccountResponse, error)
+	// Цели накоплений. Взносы — транзакции и переводы, привязанные к цели
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*CreateGoalResponse, error)
+	ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*GetGoalResponse, error)
+	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*UpdateGoalResponse, error)
+	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*DeleteGoalResponse, error)
+	LinkGoalTransaction(ctx context.Context, in *LinkGoalTransactionRequest, opts ...grpc.CallOption) (*LinkGoalTransactionResponse, error)
+	UnlinkGoalTransaction(ctx context.Context, in *LinkGoalTransactionRequest, opts ...grpc.CallOption) (*LinkGoalTransactionResponse, error)
+	// Займы и долги с графиком платежей. Погашения — транзакции, привязанные к займу
+	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error)
+	DeleteLoan(ctx context.Context, in *DeleteLoanRequest, opts ...grpc.CallOption) (*DeleteLoanResponse, error)
+	LinkLoanTransaction(ctx context.Context, in *LinkLoanTransactionRequest, opts ...grpc.CallOption) (*LinkLoanTransactionResponse, error)
+	UnlinkLoanTransaction(ctx context.Context, in *LinkLoanTransactionRequest, opts ...grpc.CallOption) (*LinkLoanTransactionResponse, error)
+}
+
+type transactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionServiceClient(cc grpc.ClientConnInterface) TransactionServiceClient {
+	return &transactionServiceClient{cc}
+}
+
+func (c *transactionServiceClient) AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_AddTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetNetWorth(ctx context.Context, in *GetNetWorthRequest, opts ...grpc.CallOption) (*GetNetWorthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNetWorthResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetNetWorth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ImportTransactions(ctx context.Context, in *ImportTransactionsRequest, opts ...grpc.CallOption) (*ImportTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ImportTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_ExportTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTransactionsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *transactionServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) RenameCategory(ctx context.Context, in *RenameCategoryRequest, opts ...grpc.CallOption) (*RenameCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameCategoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_RenameCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ArchiveCategory(ctx context.Context, in *ArchiveCategoryRequest, opts ...grpc.CallOption) (*ArchiveCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveCategoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ArchiveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBudgetResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListBudgets(ctx context.Context, in *ListBudgetsRequest, opts ...grpc.CallOption) (*ListBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBudgetsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListRecurringTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) PauseRecurringTransaction(ctx context.Context, in *PauseRecurringTransactionRequest, opts ...grpc.CallOption) (*PauseRecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_PauseRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SkipRecurringOccurrence(ctx context.Context, in *SkipRecurringOccurrenceRequest, opts ...grpc.CallOption) (*SkipRecurringOccurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipRecurringOccurrenceResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SkipRecurringOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteRecurringTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateHousehold(ctx context.Context, in *CreateHouseholdRequest, opts ...grpc.CallOption) (*CreateHouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHouseholdResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListHouseholds(ctx context.Context, in *ListHouseholdsRequest, opts ...grpc.CallOption) (*ListHouseholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHouseholdsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListHouseholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListHouseholdMembers(ctx context.Context, in *ListHouseholdMembersRequest, opts ...grpc.CallOption) (*ListHouseholdMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHouseholdMembersResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListHouseholdMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) InviteHouseholdMember(ctx context.Context, in *InviteHouseholdMemberRequest, opts ...grpc.CallOption) (*InviteHouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteHouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, TransactionService_InviteHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) AcceptHouseholdInvitation(ctx context.Context, in *AcceptHouseholdInvitationRequest, opts ...grpc.CallOption) (*AcceptHouseholdInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptHouseholdInvitationResponse)
+	err := c.cc.Invoke(ctx, TransactionService_AcceptHouseholdInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateHouseholdMemberRole(ctx context.Context, in *UpdateHouseholdMemberRoleRequest, opts ...grpc.CallOption) (*UpdateHouseholdMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHouseholdMemberRoleResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateHouseholdMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) RemoveHouseholdMember(ctx context.Context, in *RemoveHouseholdMemberRequest, opts ...grpc.CallOption) (*RemoveHouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveHouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, TransactionService_RemoveHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ArchiveAccount(ctx context.Context, in *ArchiveAccountRequest, opts ...grpc.CallOption) (*ArchiveAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveAccountResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ArchiveAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*CreateGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGoalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*GetGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGoalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*UpdateGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGoalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*DeleteGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGoalResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) LinkGoalTransaction(ctx context.Context, in *LinkGoalTransactionRequest, opts ...grpc.CallOption) (*LinkGoalTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkGoalTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_LinkGoalTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UnlinkGoalTransaction(ctx context.Context, in *LinkGoalTransactionRequest, opts ...grpc.CallOption) (*LinkGoalTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkGoalTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UnlinkGoalTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLoanResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoanResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteLoan(ctx context.Context, in *DeleteLoanRequest, opts ...grpc.CallOption) (*DeleteLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLoanResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) LinkLoanTransaction(ctx context.Context, in *LinkLoanTransactionRequest, opts ...grpc.CallOption) (*LinkLoanTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkLoanTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_LinkLoanTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UnlinkLoanTransaction(ctx context.Context, in *LinkLoanTransactionRequest, opts ...grpc.CallOption) (*LinkLoanTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkLoanTransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UnlinkLoanTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionServiceServer is the server API for TransactionService service.
+// All implementations must embed UnimplementedTransactionServiceServer
+// for forward compatibility.
+//
+// Сервис для работы с транзакциями
+type TransactionServiceServer interface {
+	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	// Чистая стоимость: остатки счетов плюс выданные займы минус долги
+	GetNetWorth(context.Context, *GetNetWorthRequest) (*GetNetWorthResponse, error)
+	// Загрузка выписки CSV: корректные строки сохраняются одной транзакцией БД
+	ImportTransactions(context.Context, *ImportTransactionsRequest) (*ImportTransactionsResponse, error)
+	// Выгрузка транзакций файлом; содержимое передаётся частями, чтобы не держать историю в памяти
+	ExportTransactions(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// Доходы, расходы и остатки по периодам
+	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
+	// Категории: пользовательские и системные (по умолчанию)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	RenameCategory(context.Context, *RenameCategoryRequest) (*RenameCategoryResponse, error)
+	ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryResponse, error)
+	// Месячные бюджеты по категориям расходов
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
+	ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	// Повторяющиеся транзакции: шаблоны, по которым транзакции создаются автоматически
+	CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error)
+	ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error)
+	PauseRecurringTransaction(context.Context, *PauseRecurringTransactionRequest) (*PauseRecurringTransactionResponse, error)
+	SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceRequest) (*SkipRecurringOccurrenceResponse, error)
+	DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error)
+	// Общие бюджеты семьи (households): участники с ролями и приглашения.
+	// Управляет участниками только владелец
+	CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error)
+	ListHouseholds(context.Context, *ListHouseholdsRequest) (*ListHouseholdsResponse, error)
+	ListHouseholdMembers(context.Context, *ListHouseholdMembersRequest) (*ListHouseholdMembersResponse, error)
+	InviteHouseholdMember(context.Context, *InviteHouseholdMemberRequest) (*InviteHouseholdMemberResponse, error)
+	AcceptHouseholdInvitation(context.Context, *AcceptHouseholdInvitationRequest) (*AcceptHouseholdInvitationResponse, error)
+	UpdateHouseholdMemberRole(context.Context, *UpdateHouseholdMemberRoleRequest) (*UpdateHouseholdMemberRoleResponse, error)
+	RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*RemoveHouseholdMemberResponse, error)
+	// Счета (наличные, карты, накопления). Каждая транзакция привязана к счёту
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	ArchiveAccount(context.Context, *ArchiveAccountRequest) (*ArchiveAccountResponse, error)
+	// Цели накоплений. Взносы — транзакции и переводы, привязанные к цели
+	CreateGoal(context.Context, *CreateGoalRequest) (*CreateGoalResponse, error)
+	ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error)
+	GetGoal(context.Context, *GetGoalRequest) (*GetGoalResponse, error)
+	UpdateGoal(context.Context, *UpdateGoalRequest) (*UpdateGoalResponse, error)
+	DeleteGoal(context.Context, *DeleteGoalRequest) (*DeleteGoalResponse, error)
+	LinkGoalTransaction(context.Context, *LinkGoalTransactionRequest) (*LinkGoalTransactionResponse, error)
+	UnlinkGoalTransaction(context.Context, *LinkGoalTransactionRequest) (*LinkGoalTransactionResponse, error)
+	// Займы и долги с графиком платежей. Погашения — транзакции, привязанные к займу
+	CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error)
+	DeleteLoan(context.Context, *DeleteLoanRequest) (*DeleteLoanResponse, error)
+	LinkLoanTransaction(context.Context, *LinkLoanTransactionRequest) (*LinkLoanTransactionResponse, error)
+	UnlinkLoanTransaction(context.Context, *LinkLoanTransactionRequest) (*LinkLoanTransactionResponse, error)
+	mustEmbedUnimplementedTransactionServiceServer()
+}
+
+// UnimplementedTransactionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransactionServiceServer struct{}
+
+func (UnimplementedTransactionServiceServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetNetWorth(context.Context, *GetNetWorthRequest) (*GetNetWorthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetWorth not implemented")
+}
+func (UnimplementedTransactionServiceServer) ImportTransactions(context.Context, *ImportTransactionsRequest) (*ImportTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) ExportTransactions(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedTransactionServiceServer) RenameCategory(context.Context, *RenameCategoryRequest) (*RenameCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCategory not implemented")
+}
+func (UnimplementedTransactionServiceServer) ArchiveCategory(context.Context, *ArchiveCategoryRequest) (*ArchiveCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCategory not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListBudgets(context.Context, *ListBudgetsRequest) (*ListBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBudgets not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) PauseRecurringTransaction(context.Context, *PauseRecurringTransactionRequest) (*PauseRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) SkipRecurringOccurrence(context.Context, *SkipRecurringOccurrenceRequest) (*SkipRecurringOccurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipRecurringOccurrence not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateHousehold(context.Context, *CreateHouseholdRequest) (*CreateHouseholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHousehold not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListHouseholds(context.Context, *ListHouseholdsRequest) (*ListHouseholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHouseholds not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListHouseholdMembers(context.Context, *ListHouseholdMembersRequest) (*ListHouseholdMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHouseholdMembers not implemented")
+}
+func (UnimplementedTransactionServiceServer) InviteHouseholdMember(context.Context, *InviteHouseholdMemberRequest) (*InviteHouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteHouseholdMember not implemented")
+}
+func (UnimplementedTransactionServiceServer) AcceptHouseholdInvitation(context.Context, *AcceptHouseholdInvitationRequest) (*AcceptHouseholdInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptHouseholdInvitation not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateHouseholdMemberRole(context.Context, *UpdateHouseholdMemberRoleRequest) (*UpdateHouseholdMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHouseholdMemberRole not implemented")
+}
+func (UnimplementedTransactionServiceServer) RemoveHouseholdMember(context.Context, *RemoveHouseholdMemberRequest) (*RemoveHouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHouseholdMember not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedTransactionServiceServer) ArchiveAccount(context.Context, *ArchiveAccountRequest) (*ArchiveAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveAccount not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateGoal(context.Context, *CreateGoalRequest) (*CreateGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetGoal(context.Context, *GetGoalRequest) (*GetGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoal not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateGoal(context.Context, *UpdateGoalRequest) (*UpdateGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoal not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*DeleteGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedTransactionServiceServer) LinkGoalTransaction(context.Context, *LinkGoalTransactionRequest) (*LinkGoalTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkGoalTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) UnlinkGoalTransaction(context.Context, *LinkGoalTransactionRequest) (*LinkGoalTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkGoalTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteLoan(context.Context, *DeleteLoanRequest) (*DeleteLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLoan not implemented")
+}
+func (UnimplementedTransactionServiceServer) LinkLoanTransaction(context.Context, *LinkLoanTransactionRequest) (*LinkLoanTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkLoanTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) UnlinkLoanTransaction(context.Context, *LinkLoanTransactionRequest) (*LinkLoanTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkLoanTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
+func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionServiceServer will
+// result in compilation errors.
+type UnsafeTransactionServiceServer interface {
+	mustEmbedUnimplementedTransactionServiceServer()
+}
+
+func RegisterTransactionServiceServer(s grpc.ServiceRegistrar, srv TransactionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransactionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransactionService_ServiceDesc, srv)
+}
+
+func _TransactionService_AddTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).AddTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_AddTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).AddTransaction(ctx, req.(*AddTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetNetWorth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetWorthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetNetWorth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetNetWorth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetNetWorth(ctx, req.(*GetNetWorthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ImportTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ImportTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ImportTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ImportTransactions(ctx, req.(*ImportTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).ExportTransactions(m, &grpc.GenericServerStream[ExportTransactionsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _TransactionService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_RenameCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RenameCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RenameCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RenameCategory(ctx, req.(*RenameCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ArchiveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ArchiveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ArchiveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ArchiveCategory(ctx, req.(*ArchiveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListBudgets(ctx, req.(*ListBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateRecurringTransaction(ctx, req.(*CreateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListRecurringTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListRecurringTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListRecurringTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListRecurringTransactions(ctx, req.(*ListRecurringTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_PauseRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PauseRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_PauseRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PauseRecurringTransaction(ctx, req.(*PauseRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SkipRecurringOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipRecurringOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SkipRecurringOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SkipRecurringOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SkipRecurringOccurrence(ctx, req.(*SkipRecurringOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteRecurringTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteRecurringTransaction(ctx, req.(*DeleteRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHouseholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateHousehold(ctx, req.(*CreateHouseholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListHouseholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHouseholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListHouseholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListHouseholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListHouseholds(ctx, req.(*ListHouseholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListHouseholdMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHouseholdMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListHouseholdMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListHouseholdMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListHouseholdMembers(ctx, req.(*ListHouseholdMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_InviteHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).InviteHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_InviteHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).InviteHouseholdMember(ctx, req.(*InviteHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_AcceptHouseholdInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptHouseholdInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).AcceptHouseholdInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_AcceptHouseholdInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).AcceptHouseholdInvitation(ctx, req.(*AcceptHouseholdInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateHouseholdMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHouseholdMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateHouseholdMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateHouseholdMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateHouseholdMemberRole(ctx, req.(*UpdateHouseholdMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_RemoveHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHouseholdMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RemoveHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RemoveHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RemoveHouseholdMember(ctx, req.(*RemoveHouseholdMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ArchiveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ArchiveAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ArchiveAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ArchiveAccount(ctx, req.(*ArchiveAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListGoals(ctx, req.(*ListGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetGoal(ctx, req.(*GetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateGoal(ctx, req.(*UpdateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteGoal(ctx, req.(*DeleteGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_LinkGoalTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkGoalTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).LinkGoalTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_LinkGoalTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).LinkGoalTransaction(ctx, req.(*LinkGoalTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UnlinkGoalTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkGoalTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlinkGoalTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UnlinkGoalTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlinkGoalTransaction(ctx, req.(*LinkGoalTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_CreateLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateLoan(ctx, req.(*CreateLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteLoan(ctx, req.(*DeleteLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_LinkLoanTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkLoanTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).LinkLoanTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_LinkLoanTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).LinkLoanTransaction(ctx, req.(*LinkLoanTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UnlinkLoanTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkLoanTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlinkLoanTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UnlinkLoanTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlinkLoanTransaction(ctx, req.(*LinkLoanTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finplan.transaction.v1.TransactionService",
+	HandlerType: (*TransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTransaction",
+			Handler:    _TransactionService_AddTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _TransactionService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _TransactionService_DeleteTransaction_Handler,
+		},
+		{
+			MethodName: "UpdateTransaction",
+			Handler:    _TransactionService_UpdateTransaction_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _TransactionService_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetNetWorth",
+			Handler:    _TransactionService_GetNetWorth_Handler,
+		},
+		{
+			MethodName: "ImportTransactions",
+			Handler:    _TransactionService_ImportTransactions_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _TransactionService_GetReport_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _TransactionService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _TransactionService_ListCategories_Handler,
+		},
+		{
+			MethodName: "RenameCategory",
+			Handler:    _TransactionService_RenameCategory_Handler,
+		},
+		{
+			MethodName: "ArchiveCategory",
+			Handler:    _TransactionService_ArchiveCategory_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _TransactionService_SetBudget_Handler,
+		},
+		{
+			MethodName: "ListBudgets",
+			Handler:    _TransactionService_ListBudgets_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _TransactionService_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "CreateRecurringTransaction",
+			Handler:    _TransactionService_CreateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ListRecurringTransactions",
+			Handler:    _TransactionService_ListRecurringTransactions_Handler,
+		},
+		{
+			MethodName: "PauseRecurringTransaction",
+			Handler:    _TransactionService_PauseRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "SkipRecurringOccurrence",
+			Handler:    _TransactionService_SkipRecurringOccurrence_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringTransaction",
+			Handler:    _TransactionService_DeleteRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "CreateHousehold",
+			Handler:    _TransactionService_CreateHousehold_Handler,
+		},
+		{
+			MethodName: "ListHouseholds",
+			Handler:    _TransactionService_ListHouseholds_Handler,
+		},
+		{
+			MethodName: "ListHouseholdMembers",
+			Handler:    _TransactionService_ListHouseholdMembers_Handler,
+		},
+		{
+			MethodName: "InviteHouseholdMember",
+			Handler:    _TransactionService_InviteHouseholdMember_Handler,
+		},
+		{
+			MethodName: "AcceptHouseholdInvitation",
+			Handler:    _TransactionService_AcceptHouseholdInvitation_Handler,
+		},
+		{
+			MethodName: "UpdateHouseholdMemberRole",
+			Handler:    _TransactionService_UpdateHouseholdMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveHouseholdMember",
+			Handler:    _TransactionService_RemoveHouseholdMember_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _TransactionService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _TransactionService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ArchiveAccount",
+			Handler:    _TransactionService_ArchiveAccount_Handler,
+		},
+		{
+			MethodName: "CreateGoal",
+			Handler:    _TransactionService_CreateGoal_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _TransactionService_ListGoals_Handler,
+		},
+		{
+			MethodName: "GetGoal",
+			Handler:    _TransactionService_GetGoal_Handler,
+		},
+		{
+			MethodName: "UpdateGoal",
+			Handler:    _TransactionService_UpdateGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _TransactionService_DeleteGoal_Handler,
+		},
+		{
+			MethodName: "LinkGoalTransaction",
+			Handler:    _TransactionService_LinkGoalTransaction_Handler,
+		},
+		{
+			MethodName: "UnlinkGoalTransaction",
+			Handler:    _TransactionService_UnlinkGoalTransaction_Handler,
+		},
+		{
+			MethodName: "CreateLoan",
+			Handler:    _TransactionService_CreateLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _TransactionService_ListLoans_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _TransactionService_GetLoan_Handler,
+		},
+		{
+			MethodName: "DeleteLoan",
+			Handler:    _TransactionService_DeleteLoan_Handler,
+		},
+		{
+			MethodName: "LinkLoanTransaction",
+			Handler:    _TransactionService_LinkLoanTransaction_Handler,
+		},
+		{
+			MethodName: "UnlinkLoanTransaction",
+			Handler:    _TransactionService_UnlinkLoanTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTransactions",
+			Handler:       _TransactionService_ExportTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "transaction.proto",
+}
@@ -1,6 +1,6 @@
 module github.com/khaldeezal/Finplan-structure/services/api-gateway
 
-go 1.22
+go 1.24.3
 
 require (
 	github.com/gin-gonic/gin v1.10.1
@@ -9,7 +9,9 @@ require (
 	github.com/khaldeezal/Finplan-proto v0.1.2
 	github.com/stretchr/testify v1.10.0
 	go.uber.org/zap v1.27.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a
 	google.golang.org/grpc v1.72.2
+	google.golang.org/protobuf v1.36.6
 )
 
 require (
@@ -40,7 +42,7 @@ require (
 	golang.org/x/net v0.35.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
-	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/khaldeezal/Finplan-proto => ../../proto
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+)
+
+// Типы счетов в теле запроса
+var accountTypes = map[string]transactionpb.AccountType{
+	"cash":    transactionpb.AccountType_ACCOUNT_TYPE_CASH,
+	"card":    transactionpb.AccountType_ACCOUNT_TYPE_CARD,
+	"savings": transactionpb.AccountType_ACCOUNT_TYPE_SAVINGS,
+	"credit":  transactionpb.AccountType_ACCOUNT_TYPE_CREDIT,
+	"other":   transactionpb.AccountType_ACCOUNT_TYPE_OTHER,
+}
+
+// Создание счёта: {"name": "Карта", "type": "card", "currency": "RUB", "opening_balance": "1500.00"}
+func (h *TransactionHandler) CreateAccount(c *gin.Context) {
+	var body struct {
+		UserID         string `json:"user_id"`
+		Name           string `json:"name" binding:"required"`
+		Type           string `json:"type"`
+		Currency       string `json:"currency"`
+		OpeningBalance string `json:"opening_balance"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.CreateAccountRequest{
+		UserId:                userID,
+		Name:                  body.Name,
+		Currency:              body.Currency,
+		OpeningBalanceDecimal: body.OpeningBalance,
+	}
+	if body.Type != "" {
+		t, ok := accountTypes[strings.ToLower(body.Type)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("type").Error()})
+			return
+		}
+		req.Type = t
+	}
+
+	resp, err := h.Client.CreateAccount(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Счета пользователя с остатками; ?include_archived=true — вместе с архивными
+func (h *TransactionHandler) ListAccounts(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ListAccountsRequest{
+		UserId:          userID,
+		IncludeArchived: c.Query("include_archived") == "true",
+	}
+
+	resp, err := h.Client.ListAccounts(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list accounts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Архивация счёта
+func (h *TransactionHandler) ArchiveAccount(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ArchiveAccountRequest{
+		AccountId: c.Param("id"),
+		UserId:    userID,
+	}
+
+	resp, err := h.Client.ArchiveAccount(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Административное API. Маршруты закрыты JWTMiddleware с нужными правами;
+// auth-service по переданному access-токену повторно проверяет права по роли из базы
+type AdminHandler struct {
+	client authpb.AuthServiceClient
+	logger *zap.Logger
+}
+
+func NewAdminHandler(conn *grpc.ClientConn, logger *zap.Logger) *AdminHandler {
+	return &AdminHandler{
+		client: authpb.NewAuthServiceClient(conn),
+		logger: logger,
+	}
+}
+
+// Записывает обращение в журнал аудита auth-service (middleware.Audit)
+func (h *AdminHandler) RecordAccess(ctx context.Context, action, targetUserID, clientIP string) error {
+	_, err := h.client.RecordAuditEvent(ctx, &authpb.RecordAuditEventRequest{
+		Action:       action,
+		TargetUserId: targetUserID,
+		ClientIp:     clientIP,
+	})
+	if err != nil {
+		h.logger.Error("audit record failed", zap.String("action", action), zap.Error(err))
+	}
+	return err
+}
+
+// Список пользователей: q — подстрока email или имени, role, limit, offset
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit query parameter"})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offset query parameter"})
+		return
+	}
+	resp, err := h.client.ListUsers(c.Request.Context(), &authpb.ListUsersRequest{
+		Query:  c.Query("q"),
+		Role:   c.Query("role"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		h.fail(c, "list users failed", err)
+		return
+	}
+	users := make([]gin.H, 0, len(resp.Users))
+	for _, u := range resp.Users {
+		users = append(users, gin.H{
+			"id":             u.Id,
+			"email":          u.Email,
+			"name":           u.Name,
+			"role":           u.Role,
+			"email_verified": u.EmailVerified,
+			"disabled":       u.Disabled,
+			"created_at":     u.CreatedAt.AsTime().UTC().Format(time.RFC3339),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"users": users, "total": resp.Total})
+}
+
+// Блокировка аккаунта: вход и обновление токенов запрещаются, сессии завершаются
+func (h *AdminHandler) DisableUser(c *gin.Context) {
+	h.setDisabled(c, true)
+}
+
+// Снятие блокировки аккаунта
+func (h *AdminHandler) EnableUser(c *gin.Context) {
+	h.setDisabled(c, false)
+}
+
+func (h *AdminHandler) setDisabled(c *gin.Context, disabled bool) {
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	resp, err := h.client.SetUserDisabled(ctx, &authpb.SetUserDisabledRequest{UserId: c.Param("id"), Disabled: disabled})
+	if err != nil {
+		h.fail(c, "set user disabled failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"changed": resp.Changed})
+}
+
+// Снятие блокировки входа после серии неудачных попыток
+func (h *AdminHandler) UnlockUser(c *gin.Context) {
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	resp, err := h.client.UnlockAccount(ctx, &authpb.UnlockAccountRequest{UserId: c.Param("id")})
+	if err != nil {
+		h.fail(c, "unlock account failed", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"unlocked": resp.Unlocked})
+}
+
+// Назначение роли: {"role": "user"|"support"|"admin"}
+func (h *AdminHandler) SetUserRole(c *gin.Context) {
+	var req struct {
+		Role string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	if _, err := h.client.SetUserRole(ctx, &authpb.SetUserRoleRequest{UserId: c.Param("id"), Role: req.Role}); err != nil {
+		h.fail(c, "set user role failed", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Журнал аудита: actor_id, target_user_id, limit и before (RFC 3339) — курсор
+// из created_at последней записи предыдущей страницы
+func (h *AdminHandler) ListAuditEvents(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit query parameter"})
+		return
+	}
+	req := &authpb.ListAuditEventsRequest{
+		ActorId:      c.Query("actor_id"),
+		TargetUserId: c.Query("target_user_id"),
+		Limit:        int32(limit),
+	}
+	if v := c.Query("before"); v != "" {
+		before, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery("before").Error()})
+			return
+		}
+		req.Before = timestamppb.New(before)
+	}
+	resp, err := h.client.ListAuditEvents(c.Request.Context(), req)
+	if err != nil {
+		h.fail(c, "list audit events failed", err)
+		return
+	}
+	events := make([]gin.H, 0, len(resp.Events))
+	for _, e := range resp.Events {
+		events = append(events, gin.H{
+			"id":             e.Id,
+			"actor_id":       e.ActorId,
+			"actor_role":     e.ActorRole,
+			"action":         e.Action,
+			"target_user_id": e.TargetUserId,
+			"client_ip":      e.ClientIp,
+			"details":        e.Details,
+			"created_at":     e.CreatedAt.AsTime().UTC().Format(time.RFC3339Nano),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+func (h *AdminHandler) fail(c *gin.Context, msg string, err error) {
+	h.logger.Error(msg, zap.Error(err))
+	c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+}
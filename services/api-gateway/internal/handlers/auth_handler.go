@@ -3,16 +3,36 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// Метаданные с IP клиента для auth-service
+const ClientIPMetadataKey = "x-client-ip"
+
 type AuthHandler struct {
-	client authpb.AuthServiceClient
-	logger *zap.Logger
+	client  authpb.AuthServiceClient
+	logger  *zap.Logger
+	revoked RevocationRecorder
+}
+
+// Локальный список отозванных токенов, который нужно обновить сразу после выхода
+type RevocationRecorder interface {
+	Add(jti string, expiresAt time.Time)
+}
+
+// Подключает локальный кеш отозванных токенов: после выхода токен
+// перестаёт приниматься этим шлюзом, не дожидаясь синхронизации
+func (h *AuthHandler) WithRevocationCache(r RevocationRecorder) *AuthHandler {
+	h.revoked = r
+	return h
 }
 
 func NewAuthHandler(conn *grpc.ClientConn, logger *zap.Logger) *AuthHandler {
@@ -46,7 +66,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"token": resp.AccessToken})
+	c.JSON(http.StatusOK, tokensJSON(resp))
 }
 
 // Логин
@@ -65,13 +85,361 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Email:    req.Email,
 		Password: req.Password,
 	}
-	resp, err := h.client.Login(context.Background(), grpcReq)
+	// IP клиента нужен auth-service для ограничения попыток входа
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	resp, err := h.client.Login(ctx, grpcReq)
 	if err != nil {
 		h.logger.Error("login failed", zap.Error(err))
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		if d, ok := retryAfter(err); ok {
+			c.Header("Retry-After", strconv.Itoa(int(d.Seconds())))
+		}
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	// Включён второй фактор: токены выдаются после /auth/mfa/verify
+	if resp.MfaRequired {
+		c.JSON(http.StatusOK, gin.H{
+			"mfa_required": true,
+			"mfa_token":    resp.MfaToken,
+			"expires_at":   resp.ExpiresAt,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, tokensJSON(resp))
+}
+
+// Второй шаг входа: код из приложения-аутентификатора или код восстановления
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req struct {
+		MfaToken string `json:"mfa_token" binding:"required"`
+		Code     string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad mfa verify request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	resp, err := h.client.VerifyMFA(ctx, &authpb.VerifyMFARequest{MfaToken: req.MfaToken, Code: req.Code})
+	if err != nil {
+		h.logger.Error("mfa verify failed", zap.Error(err))
+		if d, ok := retryAfter(err); ok {
+			c.Header("Retry-After", strconv.Itoa(int(d.Seconds())))
+		}
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, tokensJSON(resp))
+}
+
+// Подключение второго фактора: секрет и otpauth-ссылка для QR-кода.
+// Фактор включается только после подтверждения кодом
+func (h *AuthHandler) EnrollMFA(c *gin.Context) {
+	resp, err := h.client.EnrollMFA(c.Request.Context(), &authpb.EnrollMFARequest{AccessToken: c.GetString("access_token")})
+	if err != nil {
+		h.logger.Error("mfa enroll failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"secret": resp.Secret, "otpauth_uri": resp.OtpauthUri})
+}
+
+// Подтверждение второго фактора. Коды восстановления показываются один раз
+func (h *AuthHandler) ConfirmMFA(c *gin.Context) {
+	var req struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad mfa confirm request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ConfirmMFA(c.Request.Context(), &authpb.ConfirmMFARequest{
+		AccessToken: c.GetString("access_token"),
+		Code:        req.Code,
+	})
+	if err != nil {
+		h.logger.Error("mfa confirm failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": resp.RecoveryCodes})
+}
+
+// Отключение второго фактора, требует действующий код
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	var req struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad mfa disable request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.client.DisableMFA(c.Request.Context(), &authpb.DisableMFARequest{
+		AccessToken: c.GetString("access_token"),
+		Code:        req.Code,
+	})
+	if err != nil {
+		h.logger.Error("mfa disable failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Обновление пары токенов по refresh-токену
+func (h *AuthHandler) RefreshToken(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad refresh request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.RefreshToken(c.Request.Context(), &authpb.RefreshTokenRequest{RefreshToken: req.RefreshToken})
+	if err != nil {
+		h.logger.Error("refresh failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, tokensJSON(resp))
+}
+
+// Выход. Маршрут закрыт JWTMiddleware: отзывается токен из заголовка Authorization,
+// а refresh_token из тела (необязательный) отзывает всю цепочку токенов сессии
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Warn("bad logout request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	_, err := h.client.Logout(c.Request.Context(), &authpb.LogoutRequest{
+		AccessToken:  c.GetString("access_token"),
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		h.logger.Error("logout failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	if jti := c.GetString("jti"); h.revoked != nil && jti != "" {
+		h.revoked.Add(jti, c.GetTime("token_exp"))
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Открытый ключ в формате JWK (RFC 7517)
+type jwkJSON struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JWKS: открытые ключи, которыми подписываются access-токены
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	resp, err := h.client.GetJWKS(c.Request.Context(), &authpb.GetJWKSRequest{})
+	if err != nil {
+		h.logger.Error("get jwks failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	keys := make([]jwkJSON, 0, len(resp.GetKeys()))
+	for _, k := range resp.GetKeys() {
+		keys = append(keys, jwkJSON{Kty: k.Kty, Kid: k.Kid, Alg: k.Alg, Use: k.Use, Crv: k.Crv, X: k.X, N: k.N, E: k.E})
+	}
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
+
+// Подтверждение email. Токен берётся из query-параметра token (переход по ссылке
+// из письма, GET) или из тела запроса {"token": ...}
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" && c.Request.Method == http.MethodPost {
+		var req struct {
+			Token string `json:"token" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Warn("bad verify-email request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		token = req.Token
+	}
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	if _, err := h.client.VerifyEmail(c.Request.Context(), &authpb.VerifyEmailRequest{Token: token}); err != nil {
+		h.logger.Error("verify email failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"verified": true})
+}
+
+// Запрос письма для сброса пароля. Ответ одинаковый для любого email
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad forgot-password request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := h.client.RequestPasswordReset(c.Request.Context(), &authpb.RequestPasswordResetRequest{Email: req.Email}); err != nil {
+		h.logger.Error("forgot password failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// Новый пароль по токену из письма. После сброса все сессии пользователя завершены
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req struct {
+		Token       string `json:"token" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad reset-password request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.client.ResetPassword(c.Request.Context(), &authpb.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		h.logger.Error("reset password failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Смена пароля по текущему. Маршрут закрыт JWTMiddleware; остальные сессии
+// завершаются, а клиент получает новую пару токенов вместо текущей
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req struct {
+		OldPassword string `json:"old_password" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad change-password request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"token": resp.AccessToken})
+
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	resp, err := h.client.ChangePassword(ctx, &authpb.ChangePasswordRequest{
+		AccessToken: c.GetString("access_token"),
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		h.logger.Error("change password failed", zap.Error(err))
+		if d, ok := retryAfter(err); ok {
+			c.Header("Retry-After", strconv.Itoa(int(d.Seconds())))
+		}
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	// Текущий access-токен отозван auth-service
+	if jti := c.GetString("jti"); h.revoked != nil && jti != "" {
+		h.revoked.Add(jti, c.GetTime("token_exp"))
+	}
+	c.JSON(http.StatusOK, tokensJSON(resp))
+}
+
+// Смена email: ссылка подтверждения уходит на новый адрес, до перехода
+// по ней действует прежний. Маршрут закрыт JWTMiddleware
+func (h *AuthHandler) ChangeEmail(c *gin.Context) {
+	var req struct {
+		Password string `json:"password" binding:"required"`
+		NewEmail string `json:"new_email" binding:"required,email"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.Warn("bad change-email request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx := metadata.AppendToOutgoingContext(c.Request.Context(), ClientIPMetadataKey, c.ClientIP())
+	_, err := h.client.ChangeEmail(ctx, &authpb.ChangeEmailRequest{
+		AccessToken: c.GetString("access_token"),
+		Password:    req.Password,
+		NewEmail:    req.NewEmail,
+	})
+	if err != nil {
+		h.logger.Error("change email failed", zap.Error(err))
+		if d, ok := retryAfter(err); ok {
+			c.Header("Retry-After", strconv.Itoa(int(d.Seconds())))
+		}
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+// Подтверждение нового email. Токен из query-параметра token (ссылка из письма, GET)
+// или из тела запроса {"token": ...}
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" && c.Request.Method == http.MethodPost {
+		var req struct {
+			Token string `json:"token" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.logger.Warn("bad confirm-email-change request", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		token = req.Token
+	}
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token is required"})
+		return
+	}
+
+	resp, err := h.client.ConfirmEmailChange(c.Request.Context(), &authpb.ConfirmEmailChangeRequest{Token: token})
+	if err != nil {
+		h.logger.Error("confirm email change failed", zap.Error(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"email": resp.Email})
+}
+
+// Ответ с токенами; поле token оставлено для старых клиентов
+func tokensJSON(resp *authpb.AuthResponse) gin.H {
+	return gin.H{
+		"token":         resp.AccessToken,
+		"access_token":  resp.AccessToken,
+		"refresh_token": resp.RefreshToken,
+		"expires_at":    resp.ExpiresAt,
+	}
 }
 
 // Проверка токена
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Установка месячного лимита по категории расходов; существующий лимит заменяется
+func (h *TransactionHandler) SetBudget(c *gin.Context) {
+	var body struct {
+		UserID     string      `json:"user_id"`
+		CategoryID string      `json:"category_id" binding:"required"`
+		Limit      json.Number `json:"limit" binding:"required"`
+		Currency   string      `json:"currency"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	// Лимит передаём строкой как есть, чтобы не терять точность на float64
+	req := &transactionpb.SetBudgetRequest{
+		UserId:       userID,
+		CategoryId:   body.CategoryID,
+		LimitDecimal: body.Limit.String(),
+		Currency:     body.Currency,
+	}
+
+	resp, err := h.Client.SetBudget(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Бюджеты пользователя с тратами за месяц (month=YYYY-MM, по умолчанию текущий)
+func (h *TransactionHandler) ListBudgets(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ListBudgetsRequest{UserId: userID}
+	if v := c.Query("month"); v != "" {
+		month, err := time.Parse("2006-01", v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery("month").Error()})
+			return
+		}
+		req.Month = timestamppb.New(month)
+	}
+
+	resp, err := h.Client.ListBudgets(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list budgets"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Удаление бюджета по id
+func (h *TransactionHandler) DeleteBudget(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.DeleteBudgetRequest{
+		BudgetId: c.Param("id"),
+		UserId:   userID,
+	}
+
+	resp, err := h.Client.DeleteBudget(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete budget"})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+)
+
+// Создание пользовательской категории
+func (h *TransactionHandler) CreateCategory(c *gin.Context) {
+	var req transactionpb.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	userID, ok := callerID(c, req.UserId)
+	if !ok {
+		return
+	}
+	req.UserId = userID
+	resp, err := h.Client.CreateCategory(c.Request.Context(), &req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create category"})
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Список категорий пользователя вместе с системными
+func (h *TransactionHandler) ListCategories(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	txType := transactionpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	if t := c.Query("type"); t != "" {
+		v, ok := transactionpb.TransactionType_value[t]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid type query parameter"})
+			return
+		}
+		txType = transactionpb.TransactionType(v)
+	}
+
+	req := &transactionpb.ListCategoriesRequest{
+		UserId:          userID,
+		Type:            txType,
+		IncludeArchived: c.Query("include_archived") == "true",
+	}
+
+	resp, err := h.Client.ListCategories(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list categories"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Переименование категории по id
+func (h *TransactionHandler) RenameCategory(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id"`
+		Name   string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.RenameCategoryRequest{
+		CategoryId: c.Param("id"),
+		UserId:     userID,
+		Name:       body.Name,
+	}
+
+	resp, err := h.Client.RenameCategory(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to rename category"})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Архивация категории по id
+func (h *TransactionHandler) ArchiveCategory(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ArchiveCategoryRequest{
+		CategoryId: c.Param("id"),
+		UserId:     userID,
+	}
+
+	resp, err := h.Client.ArchiveCategory(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to archive category"})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
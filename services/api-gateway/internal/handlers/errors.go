@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Переводит gRPC-статус ошибки в HTTP-код ответа
+func httpStatusFromGRPC(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Время ожидания из google.rpc.RetryInfo, округлённое вверх до секунды,
+// для заголовка Retry-After
+func retryAfter(err error) (time.Duration, bool) {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			secs := math.Ceil(info.GetRetryDelay().AsDuration().Seconds())
+			return time.Duration(max(secs, 1)) * time.Second, true
+		}
+	}
+	return 0, false
+}
+
+var errNothingToUpdate = errors.New("nothing to update")
+
+func errUnknownField(field string) error {
+	return fmt.Errorf("field %q cannot be updated", field)
+}
+
+func errInvalidField(field string) error {
+	return fmt.Errorf("invalid value for field %q", field)
+}
+
+func errInvalidQuery(param string) error {
+	return fmt.Errorf("invalid %s query parameter", param)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+)
+
+// Форматы выгрузки в query-параметре format; имя формата служит и расширением файла
+var exportFormats = map[string]struct {
+	format      transactionpb.ExportFormat
+	contentType string
+}{
+	"csv":  {transactionpb.ExportFormat_EXPORT_FORMAT_CSV, "text/csv; charset=utf-8"},
+	"json": {transactionpb.ExportFormat_EXPORT_FORMAT_JSON, "application/json; charset=utf-8"},
+	"ofx":  {transactionpb.ExportFormat_EXPORT_FORMAT_OFX, "application/x-ofx"},
+}
+
+// Выгрузка транзакций файлом: format (csv|json|ofx, по умолчанию csv)
+// и те же фильтры и сортировка, что у списка
+func (h *TransactionHandler) ExportTransactions(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+	name := c.DefaultQuery("format", "csv")
+	format, ok := exportFormats[name]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery("format").Error()})
+		return
+	}
+
+	filter := &transactionpb.ListTransactionsRequest{UserId: userID}
+	if err := applyListFilters(c, filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stream, err := h.Client.ExportTransactions(c.Request.Context(), &transactionpb.ExportTransactionsRequest{
+		Filter: filter,
+		Format: format.format,
+	})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	// Ошибки проверки фильтра приходят до первой части файла,
+	// поэтому заголовки ответа пишем только после неё
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.Header("Content-Type", format.contentType)
+	c.Header("Content-Disposition", `attachment; filename="transactions.`+name+`"`)
+	c.Status(http.StatusOK)
+	for err == nil {
+		if _, werr := c.Writer.Write(chunk.GetData()); werr != nil {
+			return
+		}
+		c.Writer.Flush()
+		chunk, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) {
+		// Заголовки уже отправлены: обрываем соединение, чтобы клиент
+		// не принял неполный файл за целый
+		_ = c.Error(err)
+		if conn, _, herr := c.Writer.Hijack(); herr == nil {
+			conn.Close()
+		}
+	}
+}
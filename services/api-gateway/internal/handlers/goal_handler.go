@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Создание цели: {"name": "Отпуск", "target_amount": "150000", "currency": "RUB", "deadline": "2026-06-01"}
+func (h *TransactionHandler) CreateGoal(c *gin.Context) {
+	var body struct {
+		UserID       string `json:"user_id"`
+		Name         string `json:"name" binding:"required"`
+		TargetAmount string `json:"target_amount" binding:"required"`
+		Currency     string `json:"currency"`
+		Deadline     string `json:"deadline"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.CreateGoalRequest{
+		UserId:              userID,
+		Name:                body.Name,
+		TargetAmountDecimal: body.TargetAmount,
+		Currency:            body.Currency,
+	}
+	if body.Deadline != "" {
+		d, err := time.Parse("2006-01-02", body.Deadline)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("deadline").Error()})
+			return
+		}
+		req.Deadline = timestamppb.New(d)
+	}
+
+	resp, err := h.Client.CreateGoal(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Цели пользователя с прогрессом и прогнозом
+func (h *TransactionHandler) ListGoals(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.ListGoals(c.Request.Context(), &transactionpb.ListGoalsRequest{UserId: userID})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list goals"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Цель с прогрессом и прогнозом
+func (h *TransactionHandler) GetGoal(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.GetGoalRequest{
+		GoalId: c.Param("id"),
+		UserId: userID,
+	}
+
+	resp, err := h.Client.GetGoal(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Частичное обновление цели; "deadline": null снимает срок
+func (h *TransactionHandler) UpdateGoal(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	var body map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	goal, paths, err := goalPatchFromJSON(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &transactionpb.UpdateGoalRequest{
+		GoalId:     c.Param("id"),
+		UserId:     userID,
+		Goal:       goal,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+	}
+
+	resp, err := h.Client.UpdateGoal(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Разбирает тело PATCH-запроса цели в значения полей и маску
+func goalPatchFromJSON(body map[string]json.RawMessage) (*transactionpb.Goal, []string, error) {
+	goal := &transactionpb.Goal{}
+	paths := make([]string, 0, len(body))
+	for field, raw := range body {
+		var err error
+		path := field
+		switch field {
+		case "name":
+			err = json.Unmarshal(raw, &goal.Name)
+		case "currency":
+			err = json.Unmarshal(raw, &goal.Currency)
+		case "target_amount":
+			err = json.Unmarshal(raw, &goal.TargetAmountDecimal)
+			path = "target_amount_decimal"
+		case "deadline":
+			var s *string
+			if err = json.Unmarshal(raw, &s); err == nil && s != nil {
+				var d time.Time
+				d, err = time.Parse("2006-01-02", *s)
+				goal.Deadline = timestamppb.New(d)
+			}
+		default:
+			return nil, nil, errUnknownField(field)
+		}
+		if err != nil {
+			return nil, nil, errInvalidField(field)
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil, nil, errNothingToUpdate
+	}
+	return goal, paths, nil
+}
+
+// Удаление цели; её взносы остаются обычными транзакциями
+func (h *TransactionHandler) DeleteGoal(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.DeleteGoalRequest{
+		GoalId: c.Param("id"),
+		UserId: userID,
+	}
+
+	resp, err := h.Client.DeleteGoal(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Засчитывает существующую транзакцию или перевод взносом в цель
+func (h *TransactionHandler) LinkGoalTransaction(c *gin.Context) {
+	h.linkGoalTransaction(c, h.Client.LinkGoalTransaction)
+}
+
+// Отвязывает транзакцию от цели
+func (h *TransactionHandler) UnlinkGoalTransaction(c *gin.Context) {
+	h.linkGoalTransaction(c, h.Client.UnlinkGoalTransaction)
+}
+
+func (h *TransactionHandler) linkGoalTransaction(c *gin.Context, call func(ctx context.Context, in *transactionpb.LinkGoalTransactionRequest, opts ...grpc.CallOption) (*transactionpb.LinkGoalTransactionResponse, error)) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.LinkGoalTransactionRequest{
+		GoalId:        c.Param("id"),
+		UserId:        userID,
+		TransactionId: c.Param("transaction_id"),
+	}
+
+	resp, err := call(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+)
+
+// Роли участников, которые можно выдать приглашением или сменой роли
+var householdRoles = map[string]transactionpb.HouseholdRole{
+	"editor": transactionpb.HouseholdRole_HOUSEHOLD_ROLE_EDITOR,
+	"viewer": transactionpb.HouseholdRole_HOUSEHOLD_ROLE_VIEWER,
+}
+
+// Создание общего бюджета; вызывающий становится владельцем
+func (h *TransactionHandler) CreateHousehold(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id"`
+		Name   string `json:"name" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.CreateHousehold(c.Request.Context(), &transactionpb.CreateHouseholdRequest{UserId: userID, Name: body.Name})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Общие бюджеты, в которых участвует пользователь
+func (h *TransactionHandler) ListHouseholds(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.ListHouseholds(c.Request.Context(), &transactionpb.ListHouseholdsRequest{UserId: userID})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list households"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Участники общего бюджета
+func (h *TransactionHandler) ListHouseholdMembers(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ListHouseholdMembersRequest{
+		HouseholdId: c.Param("id"),
+		UserId:      userID,
+	}
+
+	resp, err := h.Client.ListHouseholdMembers(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Приглашение в общий бюджет: {"role": "editor"|"viewer"}. Код в ответе показывается один раз
+func (h *TransactionHandler) InviteHouseholdMember(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	role, ok := householdRoles[strings.ToLower(body.Role)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("role").Error()})
+		return
+	}
+
+	req := &transactionpb.InviteHouseholdMemberRequest{
+		HouseholdId: c.Param("id"),
+		UserId:      userID,
+		Role:        role,
+	}
+
+	resp, err := h.Client.InviteHouseholdMember(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Вступление в общий бюджет по коду приглашения: {"code": "..."}
+func (h *TransactionHandler) AcceptHouseholdInvitation(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id"`
+		Code   string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.AcceptHouseholdInvitation(c.Request.Context(), &transactionpb.AcceptHouseholdInvitationRequest{UserId: userID, Code: body.Code})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Смена роли участника владельцем: {"role": "editor"|"viewer"}
+func (h *TransactionHandler) UpdateHouseholdMemberRole(c *gin.Context) {
+	var body struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	role, ok := householdRoles[strings.ToLower(body.Role)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("role").Error()})
+		return
+	}
+
+	req := &transactionpb.UpdateHouseholdMemberRoleRequest{
+		HouseholdId:  c.Param("id"),
+		UserId:       userID,
+		MemberUserId: c.Param("user_id"),
+		Role:         role,
+	}
+
+	resp, err := h.Client.UpdateHouseholdMemberRole(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Исключение участника владельцем или выход из бюджета (свой user_id в пути)
+func (h *TransactionHandler) RemoveHouseholdMember(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.RemoveHouseholdMemberRequest{
+		HouseholdId:  c.Param("id"),
+		UserId:       userID,
+		MemberUserId: c.Param("user_id"),
+	}
+
+	resp, err := h.Client.RemoveHouseholdMember(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
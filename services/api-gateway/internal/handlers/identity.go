@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Пользователь из access-токена (его ставит JWTMiddleware). Все операции выполняются
+// от его имени; user_id из запроса, если клиент его ещё передаёт, должен совпадать с ним
+func callerID(c *gin.Context, claimed string) (string, bool) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthenticated"})
+		return "", false
+	}
+	if claimed != "" && claimed != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user_id does not match token"})
+		return "", false
+	}
+	return userID, true
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+)
+
+// Предельный размер файла выписки; должен помещаться в одно gRPC-сообщение (4 МБ)
+const maxImportFileSize = 2 << 20
+
+// Импорт выписки: multipart/form-data с файлом в поле file и настройками в полях формы:
+// delimiter, date_format, decimal_separator, *_column (названия колонок),
+// income_category_id, expense_category_id, currency
+func (h *TransactionHandler) ImportTransactions(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	// Запас на остальные поля формы
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize+64<<10)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file form field is required"})
+		return
+	}
+	if fileHeader.Size > maxImportFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read file"})
+		return
+	}
+
+	req := &transactionpb.ImportTransactionsRequest{
+		UserId: userID,
+		Csv:    data,
+		Options: &transactionpb.ImportOptions{
+			Delimiter:                c.PostForm("delimiter"),
+			DateFormat:               c.PostForm("date_format"),
+			DecimalSeparator:         c.PostForm("decimal_separator"),
+			DefaultIncomeCategoryId:  c.PostForm("income_category_id"),
+			DefaultExpenseCategoryId: c.PostForm("expense_category_id"),
+			Currency:                 c.PostForm("currency"),
+			Columns: &transactionpb.ImportColumnMapping{
+				Date:        c.PostForm("date_column"),
+				Amount:      c.PostForm("amount_column"),
+				Description: c.PostForm("description_column"),
+				Type:        c.PostForm("type_column"),
+				CategoryId:  c.PostForm("category_column"),
+				Currency:    c.PostForm("currency_column"),
+			},
+		},
+	}
+
+	resp, err := h.Client.ImportTransactions(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
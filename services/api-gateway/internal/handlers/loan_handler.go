@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Направления и графики займов в теле запроса
+var (
+	loanDirections = map[string]transactionpb.LoanDirection{
+		"lent":     transactionpb.LoanDirection_LOAN_DIRECTION_LENT,
+		"borrowed": transactionpb.LoanDirection_LOAN_DIRECTION_BORROWED,
+	}
+	loanSchedules = map[string]transactionpb.LoanSchedule{
+		"annuity":        transactionpb.LoanSchedule_LOAN_SCHEDULE_ANNUITY,
+		"differentiated": transactionpb.LoanSchedule_LOAN_SCHEDULE_DIFFERENTIATED,
+	}
+)
+
+// Создание займа: {"counterparty": "Банк", "direction": "borrowed", "schedule": "annuity",
+// "principal": "120000", "annual_rate": 12.5, "term_months": 36, "start_date": "2025-01-10"}
+func (h *TransactionHandler) CreateLoan(c *gin.Context) {
+	var body struct {
+		UserID       string  `json:"user_id"`
+		Counterparty string  `json:"counterparty" binding:"required"`
+		Direction    string  `json:"direction" binding:"required"`
+		Schedule     string  `json:"schedule"`
+		Principal    string  `json:"principal" binding:"required"`
+		Currency     string  `json:"currency"`
+		AnnualRate   float64 `json:"annual_rate"`
+		TermMonths   int32   `json:"term_months" binding:"required"`
+		StartDate    string  `json:"start_date" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	direction, ok := loanDirections[strings.ToLower(body.Direction)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("direction").Error()})
+		return
+	}
+	start, err := time.Parse("2006-01-02", body.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("start_date").Error()})
+		return
+	}
+
+	req := &transactionpb.CreateLoanRequest{
+		UserId:            userID,
+		Counterparty:      body.Counterparty,
+		Direction:         direction,
+		PrincipalDecimal:  body.Principal,
+		Currency:          body.Currency,
+		AnnualRatePercent: body.AnnualRate,
+		TermMonths:        body.TermMonths,
+		StartDate:         timestamppb.New(start),
+	}
+	if body.Schedule != "" {
+		s, ok := loanSchedules[strings.ToLower(body.Schedule)]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("schedule").Error()})
+			return
+		}
+		req.Schedule = s
+	}
+
+	resp, err := h.Client.CreateLoan(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Займы пользователя с остатками; ?include_closed=true — вместе с погашенными
+func (h *TransactionHandler) ListLoans(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.ListLoansRequest{
+		UserId:        userID,
+		IncludeClosed: c.Query("include_closed") == "true",
+	}
+
+	resp, err := h.Client.ListLoans(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list loans"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Займ с графиком платежей
+func (h *TransactionHandler) GetLoan(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.GetLoanRequest{
+		LoanId: c.Param("id"),
+		UserId: userID,
+	}
+
+	resp, err := h.Client.GetLoan(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Удаление займа; погашения остаются обычными транзакциями
+func (h *TransactionHandler) DeleteLoan(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.DeleteLoanRequest{
+		LoanId: c.Param("id"),
+		UserId: userID,
+	}
+
+	resp, err := h.Client.DeleteLoan(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Засчитывает существующую транзакцию погашением займа
+func (h *TransactionHandler) LinkLoanTransaction(c *gin.Context) {
+	h.linkLoanTransaction(c, h.Client.LinkLoanTransaction)
+}
+
+// Отвязывает погашение от займа
+func (h *TransactionHandler) UnlinkLoanTransaction(c *gin.Context) {
+	h.linkLoanTransaction(c, h.Client.UnlinkLoanTransaction)
+}
+
+func (h *TransactionHandler) linkLoanTransaction(c *gin.Context, call func(ctx context.Context, in *transactionpb.LinkLoanTransactionRequest, opts ...grpc.CallOption) (*transactionpb.LinkLoanTransactionResponse, error)) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.LinkLoanTransactionRequest{
+		LoanId:        c.Param("id"),
+		UserId:        userID,
+		TransactionId: c.Param("transaction_id"),
+	}
+
+	resp, err := call(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
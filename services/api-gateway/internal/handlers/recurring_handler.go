@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Периодичность в теле запроса
+var recurrenceFrequencies = map[string]transactionpb.RecurrenceFrequency{
+	"daily":   transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY,
+	"weekly":  transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY,
+	"monthly": transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY,
+	"yearly":  transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY,
+}
+
+// Создание шаблона повторяющейся транзакции
+func (h *TransactionHandler) CreateRecurringTransaction(c *gin.Context) {
+	var body struct {
+		UserID      string      `json:"user_id"`
+		CategoryID  string      `json:"category_id" binding:"required"`
+		Type        string      `json:"type" binding:"required"`
+		Amount      json.Number `json:"amount" binding:"required"`
+		Currency    string      `json:"currency"`
+		Description string      `json:"description"`
+		Frequency   string      `json:"frequency" binding:"required"`
+		Interval    int32       `json:"interval"`
+		StartDate   string      `json:"start_date" binding:"required"`
+		EndDate     string      `json:"end_date"`
+		Count       int32       `json:"count"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	userID, ok := callerID(c, body.UserID)
+	if !ok {
+		return
+	}
+
+	txType, ok := transactionpb.TransactionType_value[body.Type]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("type").Error()})
+		return
+	}
+	frequency, ok := recurrenceFrequencies[strings.ToLower(body.Frequency)]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("frequency").Error()})
+		return
+	}
+	start, err := time.Parse("2006-01-02", body.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("start_date").Error()})
+		return
+	}
+
+	req := &transactionpb.CreateRecurringTransactionRequest{
+		UserId:        userID,
+		CategoryId:    body.CategoryID,
+		Type:          transactionpb.TransactionType(txType),
+		AmountDecimal: body.Amount.String(),
+		Currency:      body.Currency,
+		Description:   body.Description,
+		Frequency:     frequency,
+		Interval:      body.Interval,
+		StartDate:     timestamppb.New(start),
+		Count:         body.Count,
+	}
+	if body.EndDate != "" {
+		end, err := time.Parse("2006-01-02", body.EndDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidField("end_date").Error()})
+			return
+		}
+		req.EndDate = timestamppb.New(end)
+	}
+
+	resp, err := h.Client.CreateRecurringTransaction(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// Список шаблонов пользователя
+func (h *TransactionHandler) ListRecurringTransactions(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.ListRecurringTransactions(c.Request.Context(), &transactionpb.ListRecurringTransactionsRequest{UserId: userID})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list recurring transactions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Пауза шаблона
+func (h *TransactionHandler) PauseRecurringTransaction(c *gin.Context) {
+	h.setRecurringPaused(c, true)
+}
+
+// Возобновление шаблона
+func (h *TransactionHandler) ResumeRecurringTransaction(c *gin.Context) {
+	h.setRecurringPaused(c, false)
+}
+
+func (h *TransactionHandler) setRecurringPaused(c *gin.Context, paused bool) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.PauseRecurringTransactionRequest{
+		RecurringId: c.Param("id"),
+		UserId:      userID,
+		Paused:      paused,
+	}
+
+	resp, err := h.Client.PauseRecurringTransaction(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Пропуск ближайшего повторения
+func (h *TransactionHandler) SkipRecurringOccurrence(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.SkipRecurringOccurrenceRequest{
+		RecurringId: c.Param("id"),
+		UserId:      userID,
+	}
+
+	resp, err := h.Client.SkipRecurringOccurrence(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Удаление шаблона; созданные по нему транзакции остаются
+func (h *TransactionHandler) DeleteRecurringTransaction(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.DeleteRecurringTransactionRequest{
+		RecurringId: c.Param("id"),
+		UserId:      userID,
+	}
+
+	resp, err := h.Client.DeleteRecurringTransaction(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete recurring transaction"})
+		return
+	}
+	if !resp.GetSuccess() {
+		c.JSON(http.StatusNotFound, gin.H{"error": resp.GetMessage()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Шаг отчёта в query-параметре granularity
+var reportGranularities = map[string]transactionpb.ReportGranularity{
+	"day":   transactionpb.ReportGranularity_REPORT_GRANULARITY_DAY,
+	"week":  transactionpb.ReportGranularity_REPORT_GRANULARITY_WEEK,
+	"month": transactionpb.ReportGranularity_REPORT_GRANULARITY_MONTH,
+	"year":  transactionpb.ReportGranularity_REPORT_GRANULARITY_YEAR,
+}
+
+// Отчёт по периодам: date_from, date_to (YYYY-MM-DD, обязательные),
+// granularity (day|week|month|year, по умолчанию month), by_category (true|false)
+func (h *TransactionHandler) GetReport(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	req := &transactionpb.GetReportRequest{UserId: userID}
+	for param, dst := range map[string]**timestamppb.Timestamp{"date_from": &req.DateFrom, "date_to": &req.DateTo} {
+		d, err := time.Parse("2006-01-02", c.Query(param))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery(param).Error()})
+			return
+		}
+		*dst = timestamppb.New(d)
+	}
+	if v := c.Query("granularity"); v != "" {
+		g, ok := reportGranularities[v]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery("granularity").Error()})
+			return
+		}
+		req.Granularity = g
+	}
+	if v := c.Query("by_category"); v != "" {
+		byCategory, err := strconv.ParseBool(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": errInvalidQuery("by_category").Error()})
+			return
+		}
+		req.ByCategory = byCategory
+	}
+
+	resp, err := h.Client.GetReport(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to build report"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
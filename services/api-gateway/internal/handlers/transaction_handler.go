@@ -1,11 +1,16 @@
 package handlers
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TransactionHandler struct {
@@ -15,16 +20,15 @@ type TransactionHandler struct {
 // Удаление транзакции по id
 func (h *TransactionHandler) DeleteTransaction(c *gin.Context) {
 	transactionID := c.Param("id")
-	userID := c.Query("user_id")
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
 
 	if transactionID == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "transaction_id path parameter is required"})
 		return
 	}
-	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id query parameter is required"})
-		return
-	}
 
 	req := &transactionpb.DeleteTransactionRequest{
 		TransactionId: transactionID,
@@ -33,7 +37,7 @@ func (h *TransactionHandler) DeleteTransaction(c *gin.Context) {
 
 	_, err := h.Client.DeleteTransaction(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete transaction"})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
 		return
 	}
 
@@ -51,22 +55,124 @@ func (h *TransactionHandler) AddTransaction(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
 		return
 	}
+	userID, ok := callerID(c, req.UserId)
+	if !ok {
+		return
+	}
+	req.UserId = userID
 	resp, err := h.Client.AddTransaction(c.Request.Context(), &req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to add transaction"})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
 		return
 	}
 	c.JSON(http.StatusCreated, resp) // Всё, resp сериализуется как есть
 }
 
+// Частичное обновление транзакции: меняются только поля, переданные в теле запроса
+func (h *TransactionHandler) UpdateTransaction(c *gin.Context) {
+	transactionID := c.Param("id")
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	var body map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		return
+	}
+
+	tx, paths, err := transactionPatchFromJSON(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &transactionpb.UpdateTransactionRequest{
+		TransactionId: transactionID,
+		UserId:        userID,
+		Transaction:   tx,
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: paths},
+	}
+
+	resp, err := h.Client.UpdateTransaction(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Разбирает тело PATCH-запроса в значения полей и маску изменяемых полей
+func transactionPatchFromJSON(body map[string]json.RawMessage) (*transactionpb.Transaction, []string, error) {
+	tx := &transactionpb.Transaction{}
+	paths := make([]string, 0, len(body))
+	for field, raw := range body {
+		var err error
+		switch field {
+		case "category_id":
+			err = json.Unmarshal(raw, &tx.CategoryId)
+		case "account_id":
+			err = json.Unmarshal(raw, &tx.AccountId)
+		case "description":
+			err = json.Unmarshal(raw, &tx.Description)
+		case "currency":
+			err = json.Unmarshal(raw, &tx.Currency)
+		case "amount":
+			// Сумму передаём строкой как есть, чтобы не терять точность на float64
+			var n json.Number
+			if err = json.Unmarshal(raw, &n); err == nil {
+				tx.AmountDecimal = n.String()
+				tx.Amount, err = n.Float64()
+			}
+		case "type":
+			var s string
+			if err = json.Unmarshal(raw, &s); err == nil {
+				v, ok := transactionpb.TransactionType_value[s]
+				if !ok {
+					return nil, nil, errInvalidField(field)
+				}
+				tx.Type = transactionpb.TransactionType(v)
+			}
+		case "date":
+			var s string
+			if err = json.Unmarshal(raw, &s); err == nil {
+				var d time.Time
+				d, err = time.Parse("2006-01-02", s)
+				tx.Date = timestamppb.New(d)
+			}
+		default:
+			return nil, nil, errUnknownField(field)
+		}
+		if err != nil {
+			return nil, nil, errInvalidField(field)
+		}
+		paths = append(paths, field)
+	}
+	if len(paths) == 0 {
+		return nil, nil, errNothingToUpdate
+	}
+	return tx, paths, nil
+}
+
 // Список транзакций
 func (h *TransactionHandler) ListTransactions(c *gin.Context) {
-	userID := c.Query("user_id")
-	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id query parameter is required"})
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
 		return
 	}
+	h.listTransactions(c, userID)
+}
 
+// Транзакции пользователя из пути (/admin/users/:id/transactions) для поддержки
+// и администраторов. Права проверяет JWTMiddleware, чтение чужих данных
+// разрешает transaction-service по роли из метаданных
+func (h *TransactionHandler) ListUserTransactions(c *gin.Context) {
+	h.listTransactions(c, c.Param("id"))
+}
+
+func (h *TransactionHandler) listTransactions(c *gin.Context, userID string) {
 	limitStr := c.DefaultQuery("limit", "20")
 	offsetStr := c.DefaultQuery("offset", "0")
 
@@ -87,31 +193,123 @@ func (h *TransactionHandler) ListTransactions(c *gin.Context) {
 		Limit:  int32(limit),
 		Offset: int32(offset),
 	}
+	if err := applyListFilters(c, req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
 	resp, err := h.Client.ListTransactions(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list transactions"})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to list transactions"})
 		return
 	}
 
 	c.JSON(http.StatusOK, resp)
 }
 
-// Получение баланса пользователя по user_id из пути
+// Поля сортировки списка транзакций в query-параметре sort
+var listSortFields = map[string]transactionpb.TransactionSortField{
+	"date":       transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_DATE,
+	"amount":     transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_AMOUNT,
+	"created_at": transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_CREATED_AT,
+}
+
+// Переносит фильтры и сортировку из query-параметров в запрос списка:
+// date_from, date_to (YYYY-MM-DD), type, category_id (можно несколько),
+// amount_min, amount_max, q (подстрока описания), sort, order (asc|desc)
+// и курсор page_token из next_page_token предыдущего ответа.
+// household_id переключает выборку на общий бюджет: записи всех его участников
+func applyListFilters(c *gin.Context, req *transactionpb.ListTransactionsRequest) error {
+	req.PageToken = c.Query("page_token")
+	req.HouseholdId = c.Query("household_id")
+	req.AccountId = c.Query("account_id")
+	req.GoalId = c.Query("goal_id")
+	req.LoanId = c.Query("loan_id")
+	for param, dst := range map[string]**timestamppb.Timestamp{"date_from": &req.DateFrom, "date_to": &req.DateTo} {
+		if v := c.Query(param); v != "" {
+			d, err := time.Parse("2006-01-02", v)
+			if err != nil {
+				return errInvalidQuery(param)
+			}
+			*dst = timestamppb.New(d)
+		}
+	}
+	if v := c.Query("type"); v != "" {
+		t, ok := transactionpb.TransactionType_value[v]
+		if !ok {
+			return errInvalidQuery("type")
+		}
+		req.Type = transactionpb.TransactionType(t)
+	}
+	req.CategoryIds = c.QueryArray("category_id")
+	amounts := map[string]struct {
+		value   **float64
+		decimal *string
+	}{
+		"amount_min": {&req.AmountMin, &req.AmountMinDecimal},
+		"amount_max": {&req.AmountMax, &req.AmountMaxDecimal},
+	}
+	for param, dst := range amounts {
+		if v := c.Query(param); v != "" {
+			amount, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return errInvalidQuery(param)
+			}
+			// Точное значение уходит строкой, double — для старых версий сервиса
+			*dst.value = &amount
+			*dst.decimal = v
+		}
+	}
+	req.Search = c.Query("q")
+	if v := c.Query("sort"); v != "" {
+		field, ok := listSortFields[v]
+		if !ok {
+			return errInvalidQuery("sort")
+		}
+		req.SortBy = field
+	}
+	switch c.DefaultQuery("order", "desc") {
+	case "asc":
+		req.Ascending = true
+	case "desc":
+		req.Ascending = false
+	default:
+		return errInvalidQuery("order")
+	}
+	return nil
+}
+
+// Баланс пользователя из access-токена; с household_id — баланс общего бюджета
 func (h *TransactionHandler) GetBalance(c *gin.Context) {
-	userID := c.Query("user_id")
-	if userID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "user_id query parameter is required"})
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
 		return
 	}
 
 	req := &transactionpb.GetBalanceRequest{
-		UserId: userID,
+		UserId:      userID,
+		HouseholdId: c.Query("household_id"),
 	}
 
 	resp, err := h.Client.GetBalance(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get balance"})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to get balance"})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Чистая стоимость: остатки счетов плюс выданные займы минус долги, в валюте профиля
+func (h *TransactionHandler) GetNetWorth(c *gin.Context) {
+	userID, ok := callerID(c, c.Query("user_id"))
+	if !ok {
+		return
+	}
+
+	resp, err := h.Client.GetNetWorth(c.Request.Context(), &transactionpb.GetNetWorthRequest{UserId: userID})
+	if err != nil {
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": "failed to get net worth"})
 		return
 	}
 
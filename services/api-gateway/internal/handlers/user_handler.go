@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
 )
 
 type UserHandler struct {
@@ -18,14 +19,26 @@ func NewUserHandler(userClient userpb.UserServiceClient, logger *zap.Logger) *Us
 	}
 }
 
+// id из пути должен совпадать с пользователем из токена; /users/me — профиль текущего пользователя
+func profileID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+	if id == "me" {
+		id = ""
+	}
+	return callerID(c, id)
+}
+
 // Посмотреть профиль
 func (h *UserHandler) GetUserProfile(c *gin.Context) {
-	id := c.Param("id")
+	id, ok := profileID(c)
+	if !ok {
+		return
+	}
 	req := &userpb.GetUserProfileRequest{UserId: id}
 	resp, err := h.userClient.GetUserProfile(c.Request.Context(), req)
 	if err != nil {
 		h.logger.Error("Ошибка получения профиля пользователя", zap.Error(err))
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
 		return
 	}
 	c.JSON(200, resp)
@@ -33,7 +46,10 @@ func (h *UserHandler) GetUserProfile(c *gin.Context) {
 
 // Обновить профиль
 func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
-	id := c.Param("id")
+	id, ok := profileID(c)
+	if !ok {
+		return
+	}
 	var req userpb.UpdateUserProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.Error("Ошибка биндера", zap.Error(err))
@@ -44,7 +60,7 @@ func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
 	resp, err := h.userClient.UpdateUserProfile(c.Request.Context(), &req)
 	if err != nil {
 		h.logger.Error("Ошибка обновления профиля пользователя", zap.Error(err))
-		c.JSON(500, gin.H{"error": err.Error()})
+		c.JSON(httpStatusFromGRPC(err), gin.H{"error": status.Convert(err).Message()})
 		return
 	}
 	c.JSON(200, resp)
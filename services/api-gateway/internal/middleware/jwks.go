@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"go.uber.org/zap"
+)
+
+// Алгоритмы подписи, которые принимает шлюз
+var supportedAlgorithms = []string{"EdDSA", "RS256"}
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// Не чаще этого JWKS перезагружается из-за незнакомого kid,
+// чтобы поддельные токены не превращались в поток запросов к auth-service
+const jwksRefreshCooldown = 10 * time.Second
+
+// Открытый ключ для проверки подписи
+type PublicKey struct {
+	ID        string
+	Algorithm string
+	Key       crypto.PublicKey
+}
+
+// Поиск открытого ключа по kid из заголовка токена
+type KeyResolver interface {
+	Key(ctx context.Context, kid string) (*PublicKey, error)
+}
+
+// Локальная копия JWKS auth-service. Обновляется периодически и при встрече
+// незнакомого kid — так токены, подписанные только что выпущенным ключом, принимаются сразу
+type JWKSCache struct {
+	client authpb.AuthServiceClient
+	logger *zap.Logger
+
+	mu       sync.RWMutex
+	keys     map[string]*PublicKey
+	lastSync time.Time
+
+	refreshMu sync.Mutex // Одна внеплановая загрузка за раз
+}
+
+var _ KeyResolver = (*JWKSCache)(nil)
+
+func NewJWKSCache(client authpb.AuthServiceClient, logger *zap.Logger) *JWKSCache {
+	return &JWKSCache{
+		client: client,
+		logger: logger,
+		keys:   make(map[string]*PublicKey),
+	}
+}
+
+func (c *JWKSCache) Key(ctx context.Context, kid string) (*PublicKey, error) {
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+	c.mu.RLock()
+	fresh := time.Since(c.lastSync) < jwksRefreshCooldown
+	c.mu.RUnlock()
+	if fresh {
+		return nil, ErrUnknownKey
+	}
+	if err := c.Sync(ctx); err != nil {
+		c.logger.Warn("jwks refresh failed", zap.Error(err))
+		return nil, ErrUnknownKey
+	}
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+func (c *JWKSCache) lookup(kid string) (*PublicKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+// Загружает актуальный набор ключей; ключи, пропавшие из JWKS, больше не принимаются
+func (c *JWKSCache) Sync(ctx context.Context) error {
+	resp, err := c.client.GetJWKS(ctx, &authpb.GetJWKSRequest{})
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]*PublicKey, len(resp.GetKeys()))
+	for _, jwk := range resp.GetKeys() {
+		key, err := ParseJWK(jwk)
+		if err != nil {
+			c.logger.Warn("skipping invalid jwk", zap.String("kid", jwk.GetKid()), zap.Error(err))
+			continue
+		}
+		keys[key.ID] = key
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys = keys
+	c.lastSync = time.Now()
+	return nil
+}
+
+// Обновляет ключи каждые interval до отмены ctx. При недоступности auth-service
+// продолжает работать с последним полученным набором
+func (c *JWKSCache) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Sync(ctx); err != nil {
+				c.logger.Warn("jwks sync failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Разбирает открытый ключ из JWK: OKP/Ed25519 для EdDSA и RSA для RS256
+func ParseJWK(jwk *authpb.JWK) (*PublicKey, error) {
+	if jwk.GetKid() == "" {
+		return nil, errors.New("jwk without kid")
+	}
+	key := &PublicKey{ID: jwk.GetKid(), Algorithm: jwk.GetAlg()}
+	switch {
+	case jwk.GetKty() == "OKP" && jwk.GetCrv() == "Ed25519" && jwk.GetAlg() == "EdDSA":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.GetX())
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		key.Key = ed25519.PublicKey(x)
+	case jwk.GetKty() == "RSA" && jwk.GetAlg() == "RS256":
+		n, errN := base64.RawURLEncoding.DecodeString(jwk.GetN())
+		e, errE := base64.RawURLEncoding.DecodeString(jwk.GetE())
+		if errN != nil || errE != nil || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid rsa key")
+		}
+		key.Key = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	default:
+		return nil, fmt.Errorf("unsupported key type %q with alg %q", jwk.GetKty(), jwk.GetAlg())
+	}
+	return key, nil
+}
@@ -1,15 +1,27 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
 )
 
-// Возвращает gin.HandlerFunc для проверки JWT токена
-func JWTMiddleware(secret string) gin.HandlerFunc {
+// Ключ метаданных gRPC, в котором бэкенды получают пользователя из access-токена
+const UserIDMetadataKey = "x-user-id"
+
+// Ключ метаданных gRPC с самим access-токеном ("Bearer <token>"). Бэкенды проверяют
+// его сами и берут пользователя и роль из подписанных claims
+const AuthorizationMetadataKey = "authorization"
+
+// Возвращает gin.HandlerFunc для проверки JWT токена.
+// Подпись проверяется открытым ключом из JWKS по kid; токены с общим секретом (HS*) не принимаются.
+// Если задан revoked, токены без jti и отозванные токены не принимаются.
+// Если заданы required, у роли из токена должны быть все эти права, иначе 403
+func JWTMiddleware(keys KeyResolver, revoked RevocationChecker, required ...Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -24,20 +36,59 @@ func JWTMiddleware(secret string) gin.HandlerFunc {
 		tokenStr := parts[1]
 
 		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-			return []byte(secret), nil
-		})
+			kid, _ := token.Header["kid"].(string)
+			key, err := keys.Key(c.Request.Context(), kid)
+			if err != nil {
+				return nil, err
+			}
+			if token.Method.Alg() != key.Algorithm {
+				return nil, errors.New("unexpected signing method")
+			}
+			return key.Key, nil
+		}, jwt.WithValidMethods(supportedAlgorithms))
 		if err != nil || !token.Valid {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
 			return
 		}
 
 		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok || claims["user_id"] == nil {
+		userID, _ := claims["user_id"].(string)
+		if !ok || userID == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "user_id missing in token"})
 			return
 		}
 
-		c.Set("user_id", claims["user_id"])
+		if revoked != nil {
+			jti, _ := claims["jti"].(string)
+			if jti == "" || revoked.IsRevoked(jti) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token has been revoked"})
+				return
+			}
+			c.Set("jti", jti)
+		}
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			c.Set("token_exp", exp.Time)
+		}
+
+		// Токены, выданные до появления ролей, роли не содержат
+		role, _ := claims["role"].(string)
+		if role == "" {
+			role = RoleUser
+		}
+		for _, perm := range required {
+			if !HasPermission(role, perm) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+				return
+			}
+		}
+
+		c.Set("user_id", userID)
+		c.Set("role", role)
+		c.Set("access_token", tokenStr)
+		// Все gRPC-вызовы с контекстом запроса несут сам токен и пользователя из него.
+		// Роль бэкенды берут только из токена
+		c.Request = c.Request.WithContext(metadata.AppendToOutgoingContext(c.Request.Context(),
+			AuthorizationMetadataKey, "Bearer "+tokenStr, UserIDMetadataKey, userID))
 		c.Next()
 	}
 }
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Роли пользователей; совпадают с ролями auth-service
+const (
+	RoleUser    = "user"
+	RoleSupport = "support"
+	RoleAdmin   = "admin"
+)
+
+// Право на группу маршрутов
+type Permission string
+
+const (
+	PermUsersRead           Permission = "users:read"
+	PermUsersManage         Permission = "users:manage"
+	PermTransactionsReadAny Permission = "transactions:read_any"
+	PermAuditRead           Permission = "audit:read"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleSupport: {PermUsersRead, PermTransactionsReadAny},
+	RoleAdmin:   {PermUsersRead, PermUsersManage, PermTransactionsReadAny, PermAuditRead},
+}
+
+// Есть ли у роли право. Шлюз проверяет роль из токена; auth-service дополнительно
+// сверяет её с базой, так что снятая роль перестаёт действовать сразу
+func HasPermission(role string, perm Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// Журнал обращений к административному API
+type AuditRecorder interface {
+	RecordAccess(ctx context.Context, action, targetUserID, clientIP string) error
+}
+
+// Записывает обращение в журнал до выполнения запроса. Если записать не удалось,
+// запрос не выполняется: доступ к чужим данным без следа в журнале недопустим.
+// Ставится после JWTMiddleware
+func Audit(recorder AuditRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		action := c.Request.Method + " " + c.FullPath()
+		if err := recorder.RecordAccess(c.Request.Context(), action, c.Param("id"), c.ClientIP()); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "insufficient permissions"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "audit log unavailable"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -2,56 +2,177 @@ package router
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
 	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/middleware"
 )
 
-// gin-роутер, навешивает маршруты для авторизации.
+// gin-роутер со всеми маршрутами шлюза. Обработчики берутся из методов
+// структур handlers; adminHandler заодно пишет журнал аудита
 func NewRouter(
-// Для авторизации
-	authRegisterHandler gin.HandlerFunc,
-	authLoginHandler gin.HandlerFunc,
-	authVerifyHandler gin.HandlerFunc,
-// Транзакций
-	transactionAddHandler gin.HandlerFunc,
-	transactionListHandler gin.HandlerFunc,
-	transactionDeleteHandler gin.HandlerFunc,
-	transactionGetBalanceHandler gin.HandlerFunc,
-// Юзеров
-	userGetProfileHandler gin.HandlerFunc,
-	userUpdateProfileHandler gin.HandlerFunc,
-	jwtSecret string,
+	authHandler *handlers.AuthHandler,
+	transactionHandler *handlers.TransactionHandler,
+	userHandler *handlers.UserHandler,
+	adminHandler *handlers.AdminHandler,
+	jwtKeys middleware.KeyResolver,
+	revoked middleware.RevocationChecker,
 ) *gin.Engine {
 	r := gin.Default()
 
+	// Открытые ключи для проверки access-токенов
+	r.GET("/.well-known/jwks.json", authHandler.JWKS)
+
 	api := r.Group("/api/v1")
 
 	// Маршруты для авторизации (без middleware)
 	auth := api.Group("/auth")
 	{
 		// Регистрация
-		auth.POST("/register", authRegisterHandler)
+		auth.POST("/register", authHandler.Register)
 		// Вход
-		auth.POST("/login", authLoginHandler)
+		auth.POST("/login", authHandler.Login)
 		// Подтверждение
-		auth.POST("/verify", authVerifyHandler)
+		auth.POST("/verify", authHandler.VerifyToken)
+		// Обновление пары токенов
+		auth.POST("/refresh", authHandler.RefreshToken)
+		// Выход: отзыв текущего access-токена и refresh-токенов сессии
+		auth.POST("/logout", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.Logout)
+		// Подтверждение email: ссылка из письма (GET) или токен в теле (POST)
+		auth.GET("/verify-email", authHandler.VerifyEmail)
+		auth.POST("/verify-email", authHandler.VerifyEmail)
+		// Письмо со ссылкой для сброса пароля
+		auth.POST("/forgot-password", authHandler.ForgotPassword)
+		// Новый пароль по токену из письма
+		auth.POST("/reset-password", authHandler.ResetPassword)
+		// Второй шаг входа по mfa_token из ответа /login
+		auth.POST("/mfa/verify", authHandler.VerifyMFA)
+		// Подключение, подтверждение и отключение второго фактора
+		auth.POST("/mfa/enroll", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.EnrollMFA)
+		auth.POST("/mfa/confirm", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.ConfirmMFA)
+		auth.POST("/mfa/disable", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.DisableMFA)
+		// Смена пароля и email с вводом текущего пароля
+		auth.POST("/change-password", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.ChangePassword)
+		auth.POST("/change-email", middleware.JWTMiddleware(jwtKeys, revoked), authHandler.ChangeEmail)
+		// Подтверждение нового email: ссылка из письма (GET) или токен в теле (POST)
+		auth.GET("/confirm-email-change", authHandler.ConfirmEmailChange)
+		auth.POST("/confirm-email-change", authHandler.ConfirmEmailChange)
 	}
 
 	// Маршруты для транзакций (с middleware)
 	transactions := api.Group("/transactions")
-	transactions.Use(middleware.JWTMiddleware(jwtSecret))
+	transactions.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		transactions.POST("", transactionHandler.AddTransaction)
+		transactions.GET("", transactionHandler.ListTransactions)
+		transactions.DELETE("/:id", transactionHandler.DeleteTransaction)
+		transactions.PATCH("/:id", transactionHandler.UpdateTransaction)
+		transactions.GET("/balance", transactionHandler.GetBalance)
+		transactions.GET("/net-worth", transactionHandler.GetNetWorth) // Счета и непогашенные займы
+		transactions.GET("/report", transactionHandler.GetReport)
+		transactions.POST("/import", transactionHandler.ImportTransactions) // CSV-выписка в multipart/form-data
+		transactions.GET("/export", transactionHandler.ExportTransactions)  // Файл csv|json|ofx
+	}
+
+	// Маршруты для категорий (с middleware)
+	categories := api.Group("/categories")
+	categories.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		categories.POST("", transactionHandler.CreateCategory)
+		categories.GET("", transactionHandler.ListCategories)
+		categories.PATCH("/:id", transactionHandler.RenameCategory)
+		categories.DELETE("/:id", transactionHandler.ArchiveCategory) // Архивация, а не удаление
+	}
+
+	// Маршруты для бюджетов (с middleware)
+	budgets := api.Group("/budgets")
+	budgets.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		budgets.POST("", transactionHandler.SetBudget)
+		budgets.GET("", transactionHandler.ListBudgets)
+		budgets.DELETE("/:id", transactionHandler.DeleteBudget)
+	}
+
+	// Маршруты для повторяющихся транзакций (с middleware)
+	recurring := api.Group("/recurring")
+	recurring.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		recurring.POST("", transactionHandler.CreateRecurringTransaction)
+		recurring.GET("", transactionHandler.ListRecurringTransactions)
+		recurring.POST("/:id/pause", transactionHandler.PauseRecurringTransaction)
+		recurring.POST("/:id/resume", transactionHandler.ResumeRecurringTransaction)
+		recurring.POST("/:id/skip", transactionHandler.SkipRecurringOccurrence) // Пропустить ближайшее повторение
+		recurring.DELETE("/:id", transactionHandler.DeleteRecurringTransaction)
+	}
+
+	// Маршруты для общих бюджетов (с middleware).
+	// Ленты и баланс общего бюджета — /transactions и /transactions/balance с ?household_id=
+	households := api.Group("/households")
+	households.Use(middleware.JWTMiddleware(jwtKeys, revoked))
 	{
-		transactions.POST("", transactionAddHandler)
-		transactions.GET("", transactionListHandler)
-		transactions.DELETE("/:id", transactionDeleteHandler)
-		transactions.GET("/balance", transactionGetBalanceHandler)
+		households.POST("", transactionHandler.CreateHousehold)
+		households.GET("", transactionHandler.ListHouseholds)
+		households.POST("/join", transactionHandler.AcceptHouseholdInvitation) // Вступление по коду приглашения
+		households.GET("/:id/members", transactionHandler.ListHouseholdMembers)
+		households.POST("/:id/invitations", transactionHandler.InviteHouseholdMember)
+		households.PUT("/:id/members/:user_id", transactionHandler.UpdateHouseholdMemberRole)
+		households.DELETE("/:id/members/:user_id", transactionHandler.RemoveHouseholdMember) // Свой user_id — выход из бюджета
+	}
+
+	// Маршруты для счетов (с middleware).
+	// Операции по счёту — /transactions?account_id=, переводы — POST /transactions с type TRANSFER
+	accounts := api.Group("/accounts")
+	accounts.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		accounts.POST("", transactionHandler.CreateAccount)
+		accounts.GET("", transactionHandler.ListAccounts)          // Счета с текущими остатками
+		accounts.DELETE("/:id", transactionHandler.ArchiveAccount) // Архивация; история операций сохраняется
+	}
+
+	// Маршруты для целей накоплений (с middleware).
+	// Взнос — POST /transactions с goal_id, взносы цели — /transactions?goal_id=
+	goals := api.Group("/goals")
+	goals.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		goals.POST("", transactionHandler.CreateGoal)
+		goals.GET("", transactionHandler.ListGoals)
+		goals.GET("/:id", transactionHandler.GetGoal) // Прогресс и прогноз по среднему чистому доходу
+		goals.PATCH("/:id", transactionHandler.UpdateGoal)
+		goals.DELETE("/:id", transactionHandler.DeleteGoal)
+		goals.PUT("/:id/transactions/:transaction_id", transactionHandler.LinkGoalTransaction) // Засчитать существующую транзакцию
+		goals.DELETE("/:id/transactions/:transaction_id", transactionHandler.UnlinkGoalTransaction)
+	}
+
+	// Маршруты для займов и долгов (с middleware).
+	// Погашение — POST /transactions с loan_id, погашения займа — /transactions?loan_id=
+	loans := api.Group("/loans")
+	loans.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		loans.POST("", transactionHandler.CreateLoan)
+		loans.GET("", transactionHandler.ListLoans)
+		loans.GET("/:id", transactionHandler.GetLoan) // Остаток и график платежей
+		loans.DELETE("/:id", transactionHandler.DeleteLoan)
+		loans.PUT("/:id/transactions/:transaction_id", transactionHandler.LinkLoanTransaction) // Засчитать существующую транзакцию погашением
+		loans.DELETE("/:id/transactions/:transaction_id", transactionHandler.UnlinkLoanTransaction)
 	}
 
 	// Маршруты для юзеров (с middleware)
 	users := api.Group("/users")
-	users.Use(middleware.JWTMiddleware(jwtSecret))
+	users.Use(middleware.JWTMiddleware(jwtKeys, revoked))
+	{
+		users.GET("/:id", userHandler.GetUserProfile)    // Профиль пользователя
+		users.PUT("/:id", userHandler.UpdateUserProfile) // Обновить профиль
+	}
+
+	// Административные маршруты: у каждого свои права, каждое обращение пишется в журнал аудита
+	admin := api.Group("/admin")
 	{
-		users.GET("/:id", userGetProfileHandler)    // Профиль пользователя
-		users.PUT("/:id", userUpdateProfileHandler) // Обновить профиль
+		admin.GET("/users", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermUsersRead), middleware.Audit(adminHandler), adminHandler.ListUsers)
+		admin.POST("/users/:id/disable", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermUsersManage), middleware.Audit(adminHandler), adminHandler.DisableUser)
+		admin.POST("/users/:id/enable", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermUsersManage), middleware.Audit(adminHandler), adminHandler.EnableUser)
+		admin.POST("/users/:id/unlock", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermUsersManage), middleware.Audit(adminHandler), adminHandler.UnlockUser)
+		admin.PUT("/users/:id/role", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermUsersManage), middleware.Audit(adminHandler), adminHandler.SetUserRole)
+		// Только чтение транзакций любого пользователя
+		admin.GET("/users/:id/transactions", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermTransactionsReadAny), middleware.Audit(adminHandler), transactionHandler.ListUserTransactions)
+		admin.GET("/audit", middleware.JWTMiddleware(jwtKeys, revoked, middleware.PermAuditRead), middleware.Audit(adminHandler), adminHandler.ListAuditEvents)
 	}
 
 	return r
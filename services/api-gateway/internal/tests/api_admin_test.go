@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/middleware"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func generateTestJWTWithRole(userID, role string) string {
+	return signTestJWT(jwt.MapClaims{
+		"user_id": userID,
+		"role":    role,
+		"exp":     time.Now().Add(time.Minute).Unix(),
+	})
+}
+
+func TestJWTMiddleware_RequiresPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/read", middleware.JWTMiddleware(testKeys, nil, middleware.PermUsersRead), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString("role"))
+	})
+	r.GET("/manage", middleware.JWTMiddleware(testKeys, nil, middleware.PermUsersManage), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	cases := []struct {
+		path, token string
+		want        int
+	}{
+		{"/read", generateTestJWT("user-1", time.Minute), http.StatusForbidden}, // Токен без роли — обычный пользователь
+		{"/read", generateTestJWTWithRole("user-1", "user"), http.StatusForbidden},
+		{"/read", generateTestJWTWithRole("support-1", "support"), http.StatusOK},
+		{"/manage", generateTestJWTWithRole("support-1", "support"), http.StatusForbidden},
+		{"/manage", generateTestJWTWithRole("admin-1", "admin"), http.StatusOK},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		require.Equal(t, tc.want, w.Code, tc.path)
+	}
+}
+
+func setupAdminRouter(h *handlers.AdminHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/users", middleware.JWTMiddleware(testKeys, nil, middleware.PermUsersRead), middleware.Audit(h), h.ListUsers)
+	r.POST("/admin/users/:id/disable", middleware.JWTMiddleware(testKeys, nil, middleware.PermUsersManage), middleware.Audit(h), h.DisableUser)
+	r.POST("/admin/users/:id/unlock", middleware.JWTMiddleware(testKeys, nil, middleware.PermUsersManage), middleware.Audit(h), h.UnlockUser)
+	return r
+}
+
+func TestAdminHandler_ListUsers_Audited(t *testing.T) {
+	token := generateTestJWTWithRole("support-1", "support")
+	var audited *authpb.RecordAuditEventRequest
+	srv := &mockAuthServer{
+		RecordAuditFn: func(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			require.Equal(t, []string{"support-1"}, md.Get(middleware.UserIDMetadataKey))
+			require.Equal(t, []string{"Bearer " + token}, md.Get(middleware.AuthorizationMetadataKey))
+			audited = req
+			return &authpb.RecordAuditEventResponse{}, nil
+		},
+		ListUsersFn: func(ctx context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
+			require.NotNil(t, audited, "access must be audited before the call")
+			md, _ := metadata.FromIncomingContext(ctx)
+			require.Equal(t, []string{"Bearer " + token}, md.Get(middleware.AuthorizationMetadataKey))
+			require.Equal(t, "anna", req.Query)
+			require.EqualValues(t, 10, req.Limit)
+			return &authpb.ListUsersResponse{Total: 1, Users: []*authpb.UserInfo{
+				{Id: "user-1", Email: "anna@example.com", Role: "user", CreatedAt: timestamppb.Now()},
+			}}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAdminRouter(handlers.NewAdminHandler(conn, zap.NewNop()))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users?q=anna&limit=10", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "GET /admin/users", audited.Action)
+	var body struct {
+		Users []map[string]any `json:"users"`
+		Total int              `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, 1, body.Total)
+	require.Equal(t, "anna@example.com", body.Users[0]["email"])
+}
+
+func TestAdminHandler_AuditFailureBlocksRequest(t *testing.T) {
+	srv := &mockAuthServer{
+		RecordAuditFn: func(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+			require.Equal(t, "user-2", req.TargetUserId)
+			return nil, errors.New("database is down")
+		},
+		SetUserDisabledFn: func(ctx context.Context, req *authpb.SetUserDisabledRequest) (*authpb.SetUserDisabledResponse, error) {
+			t.Fatal("request must not run without audit record")
+			return nil, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAdminRouter(handlers.NewAdminHandler(conn, zap.NewNop()))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/user-2/disable", nil)
+	req.Header.Set("Authorization", "Bearer "+generateTestJWTWithRole("admin-1", "admin"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestAdminHandler_RoleRevokedInDatabase(t *testing.T) {
+	// Роль в токене ещё admin, но auth-service её уже снял
+	srv := &mockAuthServer{
+		RecordAuditFn: func(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAdminRouter(handlers.NewAdminHandler(conn, zap.NewNop()))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+	req.Header.Set("Authorization", "Bearer "+generateTestJWTWithRole("admin-1", "admin"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestAdminHandler_UnlockUser(t *testing.T) {
+	token := generateTestJWTWithRole("admin-1", "admin")
+	srv := &mockAuthServer{
+		RecordAuditFn: func(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+			require.Equal(t, "POST /admin/users/:id/unlock", req.Action)
+			return &authpb.RecordAuditEventResponse{}, nil
+		},
+		UnlockAccountFn: func(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			require.Equal(t, []string{"Bearer " + token}, md.Get(middleware.AuthorizationMetadataKey))
+			require.Equal(t, "user-2", req.UserId)
+			return &authpb.UnlockAccountResponse{Unlocked: true}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAdminRouter(handlers.NewAdminHandler(conn, zap.NewNop()))
+
+	// Поддержке маршрут закрыт ещё в шлюзе
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/user-2/unlock", nil)
+	req.Header.Set("Authorization", "Bearer "+generateTestJWTWithRole("support-1", "support"))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/admin/users/user-2/unlock", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"unlocked":true}`, w.Body.String())
+}
@@ -2,20 +2,35 @@ package tests
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
 
 	"bytes"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/middleware"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 const bufSize = 1024 * 1024
@@ -25,6 +40,95 @@ type mockAuthServer struct {
 	RegisterFn func(context.Context, *authpb.RegisterRequest) (*authpb.AuthResponse, error)
 	LoginFn    func(context.Context, *authpb.LoginRequest) (*authpb.AuthResponse, error)
 	VerifyFn   func(context.Context, *authpb.VerifyTokenRequest) (*authpb.VerifyTokenResponse, error)
+	RefreshFn  func(context.Context, *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error)
+	LogoutFn   func(context.Context, *authpb.LogoutRequest) (*authpb.LogoutResponse, error)
+	RevokedFn  func(context.Context, *authpb.ListRevokedTokensRequest) (*authpb.ListRevokedTokensResponse, error)
+	JWKSFn     func(context.Context, *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error)
+
+	VerifyEmailFn   func(context.Context, *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error)
+	ResetRequestFn  func(context.Context, *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error)
+	ResetPasswordFn func(context.Context, *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error)
+
+	VerifyMFAFn  func(context.Context, *authpb.VerifyMFARequest) (*authpb.AuthResponse, error)
+	EnrollMFAFn  func(context.Context, *authpb.EnrollMFARequest) (*authpb.EnrollMFAResponse, error)
+	ConfirmMFAFn func(context.Context, *authpb.ConfirmMFARequest) (*authpb.ConfirmMFAResponse, error)
+
+	ChangePasswordFn     func(context.Context, *authpb.ChangePasswordRequest) (*authpb.AuthResponse, error)
+	ChangeEmailFn        func(context.Context, *authpb.ChangeEmailRequest) (*authpb.ChangeEmailResponse, error)
+	ConfirmEmailChangeFn func(context.Context, *authpb.ConfirmEmailChangeRequest) (*authpb.ConfirmEmailChangeResponse, error)
+
+	ListUsersFn       func(context.Context, *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error)
+	SetUserDisabledFn func(context.Context, *authpb.SetUserDisabledRequest) (*authpb.SetUserDisabledResponse, error)
+	RecordAuditFn     func(context.Context, *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error)
+	UnlockAccountFn   func(context.Context, *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error)
+}
+
+func (m *mockAuthServer) ListUsers(ctx context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
+	return m.ListUsersFn(ctx, req)
+}
+
+func (m *mockAuthServer) SetUserDisabled(ctx context.Context, req *authpb.SetUserDisabledRequest) (*authpb.SetUserDisabledResponse, error) {
+	return m.SetUserDisabledFn(ctx, req)
+}
+
+func (m *mockAuthServer) RecordAuditEvent(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+	return m.RecordAuditFn(ctx, req)
+}
+
+func (m *mockAuthServer) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	return m.UnlockAccountFn(ctx, req)
+}
+
+func (m *mockAuthServer) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.AuthResponse, error) {
+	return m.ChangePasswordFn(ctx, req)
+}
+
+func (m *mockAuthServer) ChangeEmail(ctx context.Context, req *authpb.ChangeEmailRequest) (*authpb.ChangeEmailResponse, error) {
+	return m.ChangeEmailFn(ctx, req)
+}
+
+func (m *mockAuthServer) ConfirmEmailChange(ctx context.Context, req *authpb.ConfirmEmailChangeRequest) (*authpb.ConfirmEmailChangeResponse, error) {
+	return m.ConfirmEmailChangeFn(ctx, req)
+}
+
+func (m *mockAuthServer) VerifyMFA(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.AuthResponse, error) {
+	return m.VerifyMFAFn(ctx, req)
+}
+
+func (m *mockAuthServer) EnrollMFA(ctx context.Context, req *authpb.EnrollMFARequest) (*authpb.EnrollMFAResponse, error) {
+	return m.EnrollMFAFn(ctx, req)
+}
+
+func (m *mockAuthServer) ConfirmMFA(ctx context.Context, req *authpb.ConfirmMFARequest) (*authpb.ConfirmMFAResponse, error) {
+	return m.ConfirmMFAFn(ctx, req)
+}
+
+func (m *mockAuthServer) VerifyEmail(ctx context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	return m.VerifyEmailFn(ctx, req)
+}
+
+func (m *mockAuthServer) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	return m.ResetRequestFn(ctx, req)
+}
+
+func (m *mockAuthServer) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	return m.ResetPasswordFn(ctx, req)
+}
+
+func (m *mockAuthServer) GetJWKS(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+	return m.JWKSFn(ctx, req)
+}
+
+func (m *mockAuthServer) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+	return m.LogoutFn(ctx, req)
+}
+
+func (m *mockAuthServer) ListRevokedTokens(ctx context.Context, req *authpb.ListRevokedTokensRequest) (*authpb.ListRevokedTokensResponse, error) {
+	return m.RevokedFn(ctx, req)
+}
+
+func (m *mockAuthServer) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error) {
+	return m.RefreshFn(ctx, req)
 }
 
 func (m *mockAuthServer) Register(ctx context.Context, req *authpb.RegisterRequest) (*authpb.AuthResponse, error) {
@@ -65,6 +169,13 @@ func setupAuthRouter(h *handlers.AuthHandler) *gin.Engine {
 	r.POST("/register", h.Register)
 	r.POST("/login", h.Login)
 	r.POST("/verify", h.VerifyToken)
+	r.POST("/refresh", h.RefreshToken)
+	r.GET("/verify-email", h.VerifyEmail)
+	r.POST("/verify-email", h.VerifyEmail)
+	r.POST("/forgot-password", h.ForgotPassword)
+	r.POST("/reset-password", h.ResetPassword)
+	r.POST("/mfa/verify", h.VerifyMFA)
+	r.GET("/confirm-email-change", h.ConfirmEmailChange)
 	return r
 }
 
@@ -153,3 +264,421 @@ func TestAuthHandler_Register_BadRequest(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, resp.Code)
 }
 
+func TestAuthHandler_Refresh_Success(t *testing.T) {
+	srv := &mockAuthServer{
+		RefreshFn: func(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error) {
+			require.Equal(t, "old-refresh", req.RefreshToken)
+			return &authpb.AuthResponse{AccessToken: "new-access", RefreshToken: "new-refresh", ExpiresAt: "2025-01-01T00:15:00Z"}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+	req, _ := http.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(`{"refresh_token":"old-refresh"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"refresh_token":"new-refresh"`)
+	require.Contains(t, resp.Body.String(), `"expires_at":"2025-01-01T00:15:00Z"`)
+}
+
+func TestAuthHandler_Refresh_Reused(t *testing.T) {
+	srv := &mockAuthServer{
+		RefreshFn: func(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+	req, _ := http.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(`{"refresh_token":"used"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestAuthHandler_Logout_RevokesLocally(t *testing.T) {
+	token := generateTestJWTWithID("user42", "jti-1", time.Hour)
+	srv := &mockAuthServer{
+		LogoutFn: func(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+			require.Equal(t, token, req.AccessToken)
+			require.Equal(t, "refresh-1", req.RefreshToken)
+			return &authpb.LogoutResponse{Success: true}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	cache := middleware.NewRevocationCache(authpb.NewAuthServiceClient(conn), zap.NewNop())
+	h := handlers.NewAuthHandler(conn, zap.NewNop()).WithRevocationCache(cache)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/logout", middleware.JWTMiddleware(testKeys, cache), h.Logout)
+
+	logout := func() int {
+		req, _ := http.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(`{"refresh_token":"refresh-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp.Code
+	}
+	require.Equal(t, http.StatusNoContent, logout())
+	require.True(t, cache.IsRevoked("jti-1"))
+	// Тот же токен больше не принимается
+	require.Equal(t, http.StatusUnauthorized, logout())
+}
+
+func TestRevocationCache_Sync(t *testing.T) {
+	serverTime := time.Now()
+	var calls []*authpb.ListRevokedTokensRequest
+	srv := &mockAuthServer{
+		RevokedFn: func(ctx context.Context, req *authpb.ListRevokedTokensRequest) (*authpb.ListRevokedTokensResponse, error) {
+			calls = append(calls, req)
+			return &authpb.ListRevokedTokensResponse{
+				Tokens: []*authpb.RevokedToken{
+					{Jti: "jti-live", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))},
+					{Jti: "jti-expired", ExpiresAt: timestamppb.New(time.Now().Add(-time.Second))},
+				},
+				ServerTime: timestamppb.New(serverTime),
+			}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	cache := middleware.NewRevocationCache(authpb.NewAuthServiceClient(conn), zap.NewNop())
+	require.NoError(t, cache.Sync(context.Background()))
+	require.True(t, cache.IsRevoked("jti-live"))
+	require.False(t, cache.IsRevoked("jti-expired"))
+
+	// Следующая синхронизация запрашивает только новые отзывы
+	require.NoError(t, cache.Sync(context.Background()))
+	require.Nil(t, calls[0].Since)
+	require.NotNil(t, calls[1].Since)
+	require.True(t, calls[1].Since.AsTime().Before(serverTime))
+}
+
+func testJWKS(t *testing.T) (*authpb.GetJWKSResponse, *rsa.PrivateKey) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	b64 := base64.RawURLEncoding.EncodeToString
+	return &authpb.GetJWKSResponse{Keys: []*authpb.JWK{
+		{Kty: "OKP", Kid: testKID, Alg: "EdDSA", Use: "sig", Crv: "Ed25519", X: b64(testSigningKey.Public().(ed25519.PublicKey))},
+		{Kty: "RSA", Kid: "rsa-key", Alg: "RS256", Use: "sig", N: b64(rsaKey.N.Bytes()), E: b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{Kty: "oct", Kid: "hmac-key", Alg: "HS256"}, // Симметричные ключи не принимаются
+	}}, rsaKey
+}
+
+func TestJWKSCache_LoadsKeysOnDemand(t *testing.T) {
+	jwks, rsaKey := testJWKS(t)
+	calls := 0
+	srv := &mockAuthServer{
+		JWKSFn: func(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+			calls++
+			return jwks, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	cache := middleware.NewJWKSCache(authpb.NewAuthServiceClient(conn), zap.NewNop())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/protected", middleware.JWTMiddleware(cache, nil), func(c *gin.Context) { c.Status(http.StatusOK) })
+	call := func(token string) int {
+		req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	// Первый токен с незнакомым kid загружает JWKS
+	require.Equal(t, http.StatusOK, call(generateTestJWT("user42", time.Hour)))
+	rs := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"user_id": "user42", "exp": time.Now().Add(time.Hour).Unix()})
+	rs.Header["kid"] = "rsa-key"
+	rsToken, err := rs.SignedString(rsaKey)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, call(rsToken))
+	require.Equal(t, 1, calls)
+
+	// Неизвестный kid сразу после загрузки не вызывает повторный запрос
+	_, err = cache.Key(context.Background(), "hmac-key")
+	require.ErrorIs(t, err, middleware.ErrUnknownKey)
+	require.Equal(t, 1, calls)
+}
+
+func TestAuthHandler_JWKS(t *testing.T) {
+	jwks, _ := testJWKS(t)
+	srv := &mockAuthServer{
+		JWKSFn: func(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+			return jwks, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	h := handlers.NewAuthHandler(conn, zap.NewNop())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/.well-known/jwks.json", h.JWKS)
+
+	req, _ := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var body struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	require.Len(t, body.Keys, 3)
+	require.Equal(t, "Ed25519", body.Keys[0]["crv"])
+	require.NotContains(t, body.Keys[0], "n")
+	require.Equal(t, "AQAB", body.Keys[1]["e"])
+}
+
+func TestAuthHandler_VerifyEmail(t *testing.T) {
+	srv := &mockAuthServer{
+		VerifyEmailFn: func(ctx context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+			if req.Token != "good-token" {
+				return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+			}
+			return &authpb.VerifyEmailResponse{Success: true}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+
+	// Переход по ссылке из письма
+	req, _ := http.NewRequest(http.MethodGet, "/verify-email?token=good-token", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	req, _ = http.NewRequest(http.MethodPost, "/verify-email", bytes.NewBufferString(`{"token":"bad-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestAuthHandler_PasswordReset(t *testing.T) {
+	srv := &mockAuthServer{
+		ResetRequestFn: func(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+			require.Equal(t, "user@example.com", req.Email)
+			return &authpb.RequestPasswordResetResponse{}, nil
+		},
+		ResetPasswordFn: func(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+			require.Equal(t, "reset-token", req.Token)
+			require.Equal(t, "new-password", req.NewPassword)
+			return &authpb.ResetPasswordResponse{Success: true}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+
+	req, _ := http.NewRequest(http.MethodPost, "/forgot-password", bytes.NewBufferString(`{"email":"user@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusAccepted, resp.Code)
+
+	req, _ = http.NewRequest(http.MethodPost, "/reset-password", bytes.NewBufferString(`{"token":"reset-token","new_password":"new-password"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusNoContent, resp.Code)
+
+	req, _ = http.NewRequest(http.MethodPost, "/reset-password", bytes.NewBufferString(`{"token":"reset-token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestAuthHandler_Login_Locked(t *testing.T) {
+	var gotIP []string
+	srv := &mockAuthServer{
+		LoginFn: func(ctx context.Context, req *authpb.LoginRequest) (*authpb.AuthResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			gotIP = md.Get(handlers.ClientIPMetadataKey)
+			st, err := status.New(codes.ResourceExhausted, "too many failed login attempts").
+				WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(89500 * time.Millisecond)})
+			require.NoError(t, err)
+			return nil, st.Err()
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+
+	req, _ := http.NewRequest(http.MethodPost, "/login", bytes.NewBufferString(`{"email":"user@example.com","password":"password"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = "203.0.113.7:51000"
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusTooManyRequests, resp.Code)
+	require.Equal(t, "90", resp.Header().Get("Retry-After"))
+	require.Equal(t, []string{"203.0.113.7"}, gotIP)
+}
+
+func TestAuthHandler_Login_MFA(t *testing.T) {
+	var gotIP []string
+	srv := &mockAuthServer{
+		LoginFn: func(ctx context.Context, req *authpb.LoginRequest) (*authpb.AuthResponse, error) {
+			return &authpb.AuthResponse{MfaRequired: true, MfaToken: "mfa-token"}, nil
+		},
+		VerifyMFAFn: func(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.AuthResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			gotIP = md.Get(handlers.ClientIPMetadataKey)
+			if req.MfaToken != "mfa-token" || req.Code != "123456" {
+				return nil, status.Error(codes.Unauthenticated, "invalid code")
+			}
+			return &authpb.AuthResponse{AccessToken: "access", RefreshToken: "refresh"}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = "203.0.113.7:51000"
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	// Пароль верный, но токенов ещё нет
+	resp := post("/login", `{"email":"user@example.com","password":"password"}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	require.Equal(t, true, body["mfa_required"])
+	require.Equal(t, "mfa-token", body["mfa_token"])
+	require.NotContains(t, body, "access_token")
+
+	resp = post("/mfa/verify", `{"mfa_token":"mfa-token","code":"000000"}`)
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = post("/mfa/verify", `{"mfa_token":"mfa-token","code":"123456"}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"access_token":"access"`)
+	require.Equal(t, []string{"203.0.113.7"}, gotIP)
+}
+
+func TestAuthHandler_EnrollAndConfirmMFA(t *testing.T) {
+	token := generateTestJWTWithID("user42", "jti-1", time.Hour)
+	srv := &mockAuthServer{
+		EnrollMFAFn: func(ctx context.Context, req *authpb.EnrollMFARequest) (*authpb.EnrollMFAResponse, error) {
+			require.Equal(t, token, req.AccessToken)
+			return &authpb.EnrollMFAResponse{Secret: "JBSWY3DPEHPK3PXP", OtpauthUri: "otpauth://totp/Finplan:user"}, nil
+		},
+		ConfirmMFAFn: func(ctx context.Context, req *authpb.ConfirmMFARequest) (*authpb.ConfirmMFAResponse, error) {
+			require.Equal(t, token, req.AccessToken)
+			require.Equal(t, "123456", req.Code)
+			return &authpb.ConfirmMFAResponse{RecoveryCodes: []string{"abcd-efgh"}}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	h := handlers.NewAuthHandler(conn, zap.NewNop())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/mfa/enroll", middleware.JWTMiddleware(testKeys, nil), h.EnrollMFA)
+	router.POST("/mfa/confirm", middleware.JWTMiddleware(testKeys, nil), h.ConfirmMFA)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := post("/mfa/enroll", "")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"otpauth_uri":"otpauth://totp/Finplan:user"`)
+
+	resp = post("/mfa/confirm", `{"code":"123456"}`)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.JSONEq(t, `{"recovery_codes":["abcd-efgh"]}`, resp.Body.String())
+}
+
+func TestAuthHandler_ChangePassword_RevokesLocally(t *testing.T) {
+	token := generateTestJWTWithID("user42", "jti-1", time.Hour)
+	srv := &mockAuthServer{
+		ChangePasswordFn: func(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.AuthResponse, error) {
+			require.Equal(t, token, req.AccessToken)
+			if req.OldPassword != "old-password" {
+				return nil, status.Error(codes.PermissionDenied, "invalid password")
+			}
+			return &authpb.AuthResponse{AccessToken: "new-access", RefreshToken: "new-refresh"}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+
+	cache := middleware.NewRevocationCache(authpb.NewAuthServiceClient(conn), zap.NewNop())
+	h := handlers.NewAuthHandler(conn, zap.NewNop()).WithRevocationCache(cache)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/change-password", middleware.JWTMiddleware(testKeys, cache), h.ChangePassword)
+
+	change := func(oldPassword string) *httptest.ResponseRecorder {
+		body := `{"old_password":"` + oldPassword + `","new_password":"new-password"}`
+		req, _ := http.NewRequest(http.MethodPost, "/change-password", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		return resp
+	}
+
+	require.Equal(t, http.StatusForbidden, change("wrong").Code)
+	require.False(t, cache.IsRevoked("jti-1"))
+
+	resp := change("old-password")
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"access_token":"new-access"`)
+	// Прежний токен больше не принимается
+	require.True(t, cache.IsRevoked("jti-1"))
+	require.Equal(t, http.StatusUnauthorized, change("old-password").Code)
+}
+
+func TestAuthHandler_ConfirmEmailChange(t *testing.T) {
+	srv := &mockAuthServer{
+		ConfirmEmailChangeFn: func(ctx context.Context, req *authpb.ConfirmEmailChangeRequest) (*authpb.ConfirmEmailChangeResponse, error) {
+			if req.Token != "good" {
+				return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+			}
+			return &authpb.ConfirmEmailChangeResponse{Email: "new@example.com"}, nil
+		},
+	}
+	conn, cleanup := startAuthTestServer(t, srv)
+	defer cleanup()
+	router := setupAuthRouter(handlers.NewAuthHandler(conn, zap.NewNop()))
+
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/confirm-email-change?token=good", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.JSONEq(t, `{"email":"new@example.com"}`, resp.Body.String())
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/confirm-email-change?token=bad", nil))
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
@@ -0,0 +1,869 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/middleware"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mockTransactionServer struct {
+	transactionpb.UnimplementedTransactionServiceServer
+	AddFn             func(context.Context, *transactionpb.AddTransactionRequest) (*transactionpb.AddTransactionResponse, error)
+	DeleteFn          func(context.Context, *transactionpb.DeleteTransactionRequest) (*transactionpb.DeleteTransactionResponse, error)
+	UpdateFn          func(context.Context, *transactionpb.UpdateTransactionRequest) (*transactionpb.UpdateTransactionResponse, error)
+	ListFn            func(context.Context, *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error)
+	BalanceFn         func(context.Context, *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error)
+	ReportFn          func(context.Context, *transactionpb.GetReportRequest) (*transactionpb.GetReportResponse, error)
+	SetBudgetFn       func(context.Context, *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error)
+	ListBudgetsFn     func(context.Context, *transactionpb.ListBudgetsRequest) (*transactionpb.ListBudgetsResponse, error)
+	CreateRecurringFn func(context.Context, *transactionpb.CreateRecurringTransactionRequest) (*transactionpb.CreateRecurringTransactionResponse, error)
+	PauseRecurringFn  func(context.Context, *transactionpb.PauseRecurringTransactionRequest) (*transactionpb.PauseRecurringTransactionResponse, error)
+	ImportFn          func(context.Context, *transactionpb.ImportTransactionsRequest) (*transactionpb.ImportTransactionsResponse, error)
+	ExportFn          func(*transactionpb.ExportTransactionsRequest, transactionpb.TransactionService_ExportTransactionsServer) error
+	InviteFn          func(context.Context, *transactionpb.InviteHouseholdMemberRequest) (*transactionpb.InviteHouseholdMemberResponse, error)
+	CreateAccountFn   func(context.Context, *transactionpb.CreateAccountRequest) (*transactionpb.CreateAccountResponse, error)
+	CreateGoalFn      func(context.Context, *transactionpb.CreateGoalRequest) (*transactionpb.CreateGoalResponse, error)
+	UpdateGoalFn      func(context.Context, *transactionpb.UpdateGoalRequest) (*transactionpb.UpdateGoalResponse, error)
+	CreateLoanFn      func(context.Context, *transactionpb.CreateLoanRequest) (*transactionpb.CreateLoanResponse, error)
+	GetNetWorthFn     func(context.Context, *transactionpb.GetNetWorthRequest) (*transactionpb.GetNetWorthResponse, error)
+}
+
+func (m *mockTransactionServer) CreateLoan(ctx context.Context, req *transactionpb.CreateLoanRequest) (*transactionpb.CreateLoanResponse, error) {
+	return m.CreateLoanFn(ctx, req)
+}
+
+func (m *mockTransactionServer) GetNetWorth(ctx context.Context, req *transactionpb.GetNetWorthRequest) (*transactionpb.GetNetWorthResponse, error) {
+	return m.GetNetWorthFn(ctx, req)
+}
+
+func (m *mockTransactionServer) CreateGoal(ctx context.Context, req *transactionpb.CreateGoalRequest) (*transactionpb.CreateGoalResponse, error) {
+	return m.CreateGoalFn(ctx, req)
+}
+
+func (m *mockTransactionServer) UpdateGoal(ctx context.Context, req *transactionpb.UpdateGoalRequest) (*transactionpb.UpdateGoalResponse, error) {
+	return m.UpdateGoalFn(ctx, req)
+}
+
+func (m *mockTransactionServer) CreateAccount(ctx context.Context, req *transactionpb.CreateAccountRequest) (*transactionpb.CreateAccountResponse, error) {
+	return m.CreateAccountFn(ctx, req)
+}
+
+func (m *mockTransactionServer) InviteHouseholdMember(ctx context.Context, req *transactionpb.InviteHouseholdMemberRequest) (*transactionpb.InviteHouseholdMemberResponse, error) {
+	return m.InviteFn(ctx, req)
+}
+
+func (m *mockTransactionServer) ExportTransactions(req *transactionpb.ExportTransactionsRequest, stream transactionpb.TransactionService_ExportTransactionsServer) error {
+	return m.ExportFn(req, stream)
+}
+
+func (m *mockTransactionServer) ImportTransactions(ctx context.Context, req *transactionpb.ImportTransactionsRequest) (*transactionpb.ImportTransactionsResponse, error) {
+	return m.ImportFn(ctx, req)
+}
+
+func (m *mockTransactionServer) CreateRecurringTransaction(ctx context.Context, req *transactionpb.CreateRecurringTransactionRequest) (*transactionpb.CreateRecurringTransactionResponse, error) {
+	return m.CreateRecurringFn(ctx, req)
+}
+
+func (m *mockTransactionServer) PauseRecurringTransaction(ctx context.Context, req *transactionpb.PauseRecurringTransactionRequest) (*transactionpb.PauseRecurringTransactionResponse, error) {
+	return m.PauseRecurringFn(ctx, req)
+}
+
+func (m *mockTransactionServer) SetBudget(ctx context.Context, req *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error) {
+	return m.SetBudgetFn(ctx, req)
+}
+
+func (m *mockTransactionServer) ListBudgets(ctx context.Context, req *transactionpb.ListBudgetsRequest) (*transactionpb.ListBudgetsResponse, error) {
+	return m.ListBudgetsFn(ctx, req)
+}
+
+func (m *mockTransactionServer) GetReport(ctx context.Context, req *transactionpb.GetReportRequest) (*transactionpb.GetReportResponse, error) {
+	return m.ReportFn(ctx, req)
+}
+
+func (m *mockTransactionServer) GetBalance(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+	return m.BalanceFn(ctx, req)
+}
+
+func (m *mockTransactionServer) ListTransactions(ctx context.Context, req *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error) {
+	return m.ListFn(ctx, req)
+}
+
+func (m *mockTransactionServer) AddTransaction(ctx context.Context, req *transactionpb.AddTransactionRequest) (*transactionpb.AddTransactionResponse, error) {
+	return m.AddFn(ctx, req)
+}
+
+func (m *mockTransactionServer) DeleteTransaction(ctx context.Context, req *transactionpb.DeleteTransactionRequest) (*transactionpb.DeleteTransactionResponse, error) {
+	return m.DeleteFn(ctx, req)
+}
+
+func (m *mockTransactionServer) UpdateTransaction(ctx context.Context, req *transactionpb.UpdateTransactionRequest) (*transactionpb.UpdateTransactionResponse, error) {
+	return m.UpdateFn(ctx, req)
+}
+
+func startTransactionTestServer(t *testing.T, srv *mockTransactionServer) (transactionpb.TransactionServiceClient, func()) {
+	listener := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	transactionpb.RegisterTransactionServiceServer(server, srv)
+	go server.Serve(listener)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	cleanup := func() {
+		conn.Close()
+		server.Stop()
+	}
+	return transactionpb.NewTransactionServiceClient(conn), cleanup
+}
+
+// Заменяет JWTMiddleware: запрос как будто пришёл с токеном пользователя userID
+func authenticatedAs(userID string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Next()
+	}
+}
+
+func setupTransactionRouter(h *handlers.TransactionHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(authenticatedAs("user-1"))
+	r.GET("/transactions", h.ListTransactions)
+	r.POST("/transactions", h.AddTransaction)
+	r.PATCH("/transactions/:id", h.UpdateTransaction)
+	r.DELETE("/transactions/:id", h.DeleteTransaction)
+	r.GET("/transactions/balance", h.GetBalance)
+	r.GET("/transactions/report", h.GetReport)
+	r.POST("/transactions/import", h.ImportTransactions)
+	r.GET("/transactions/export", h.ExportTransactions)
+	r.POST("/budgets", h.SetBudget)
+	r.GET("/budgets", h.ListBudgets)
+	r.POST("/recurring", h.CreateRecurringTransaction)
+	r.POST("/recurring/:id/pause", h.PauseRecurringTransaction)
+	r.POST("/recurring/:id/resume", h.ResumeRecurringTransaction)
+	r.POST("/households/:id/invitations", h.InviteHouseholdMember)
+	r.POST("/accounts", h.CreateAccount)
+	r.POST("/goals", h.CreateGoal)
+	r.PATCH("/goals/:id", h.UpdateGoal)
+	r.POST("/loans", h.CreateLoan)
+	r.GET("/transactions/net-worth", h.GetNetWorth)
+	return r
+}
+
+func TestTransactionHandler_Add_InvalidArgument(t *testing.T) {
+	srv := &mockTransactionServer{
+		AddFn: func(ctx context.Context, req *transactionpb.AddTransactionRequest) (*transactionpb.AddTransactionResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	req, _ := http.NewRequest(http.MethodPost, "/transactions", bytes.NewBufferString(`{"amount":-5}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Contains(t, resp.Body.String(), "amount must be positive")
+}
+
+func TestTransactionHandler_Update_Success(t *testing.T) {
+	srv := &mockTransactionServer{
+		UpdateFn: func(ctx context.Context, req *transactionpb.UpdateTransactionRequest) (*transactionpb.UpdateTransactionResponse, error) {
+			require.Equal(t, "tx-1", req.TransactionId)
+			require.Equal(t, "user-1", req.UserId)
+			require.ElementsMatch(t, []string{"description", "amount"}, req.UpdateMask.Paths)
+			require.Equal(t, "Продукты", req.Transaction.Description)
+			require.Equal(t, 150.5, req.Transaction.Amount)
+			require.Equal(t, "150.50", req.Transaction.AmountDecimal)
+			return &transactionpb.UpdateTransactionResponse{Transaction: &transactionpb.Transaction{Id: "tx-1", Description: "Продукты"}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	body := `{"description":"Продукты","amount":150.50}`
+	req, _ := http.NewRequest(http.MethodPatch, "/transactions/tx-1?user_id=user-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), "Продукты")
+}
+
+func TestTransactionHandler_Update_UnknownField(t *testing.T) {
+	router := setupTransactionRouter(handlers.NewTransactionHandler(nil))
+
+	body := `{"user_id":"someone-else"}`
+	req, _ := http.NewRequest(http.MethodPatch, "/transactions/tx-1?user_id=user-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestTransactionHandler_Update_NotFound(t *testing.T) {
+	srv := &mockTransactionServer{
+		UpdateFn: func(ctx context.Context, req *transactionpb.UpdateTransactionRequest) (*transactionpb.UpdateTransactionResponse, error) {
+			return nil, status.Error(codes.NotFound, "транзакция не найдена")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	req, _ := http.NewRequest(http.MethodPatch, "/transactions/tx-x?user_id=user-1", bytes.NewBufferString(`{"description":"x"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestTransactionHandler_Delete_NotFound(t *testing.T) {
+	srv := &mockTransactionServer{
+		DeleteFn: func(ctx context.Context, req *transactionpb.DeleteTransactionRequest) (*transactionpb.DeleteTransactionResponse, error) {
+			require.Equal(t, "tx-x", req.TransactionId)
+			return nil, status.Error(codes.NotFound, "транзакция не найдена")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	req, _ := http.NewRequest(http.MethodDelete, "/transactions/tx-x", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestTransactionHandler_List_Filters(t *testing.T) {
+	srv := &mockTransactionServer{
+		ListFn: func(ctx context.Context, req *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, "2025-01-01", req.DateFrom.AsTime().Format("2006-01-02"))
+			require.Nil(t, req.DateTo)
+			require.Equal(t, transactionpb.TransactionType_EXPENSE, req.Type)
+			require.Equal(t, []string{"cat-1", "cat-2"}, req.CategoryIds)
+			require.Equal(t, 100.1, req.GetAmountMin())
+			require.Equal(t, "100.10", req.AmountMinDecimal)
+			require.Nil(t, req.AmountMax)
+			require.Empty(t, req.AmountMaxDecimal)
+			require.Equal(t, "кофе", req.Search)
+			require.Equal(t, transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_AMOUNT, req.SortBy)
+			require.True(t, req.Ascending)
+			require.Equal(t, "abc", req.PageToken)
+			return &transactionpb.ListTransactionsResponse{TotalCount: 42, NextPageToken: "def"}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	url := "/transactions?user_id=user-1&date_from=2025-01-01&type=EXPENSE&category_id=cat-1&category_id=cat-2&amount_min=100.10&q=%D0%BA%D0%BE%D1%84%D0%B5&sort=amount&order=asc&page_token=abc"
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), `"total_count":42`)
+	require.Contains(t, resp.Body.String(), `"next_page_token":"def"`)
+}
+
+func TestTransactionHandler_List_InvalidSort(t *testing.T) {
+	router := setupTransactionRouter(handlers.NewTransactionHandler(nil))
+
+	req, _ := http.NewRequest(http.MethodGet, "/transactions?user_id=user-1&sort=name", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestTransactionHandler_GetBalance_ByCurrency(t *testing.T) {
+	srv := &mockTransactionServer{
+		BalanceFn: func(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			return &transactionpb.GetBalanceResponse{
+				BalanceDecimal: "1015.00",
+				Currency:       "RUB",
+				ByCurrency: []*transactionpb.CurrencyBalance{
+					{Currency: "RUB", BalanceDecimal: "90.00"},
+					{Currency: "USD", BalanceDecimal: "10.00"},
+				},
+			}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/balance?user_id=user-1", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"currency":"RUB"`)
+	require.Contains(t, w.Body.String(), `"currency":"USD"`)
+	require.Contains(t, w.Body.String(), `"balance_decimal":"1015.00"`)
+}
+
+func TestTransactionHandler_GetBalance_ProfileUnavailable(t *testing.T) {
+	srv := &mockTransactionServer{
+		BalanceFn: func(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+			return nil, status.Error(codes.Unavailable, "профиль пользователя недоступен")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/balance?user_id=user-1", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestTransactionHandler_GetReport_Success(t *testing.T) {
+	srv := &mockTransactionServer{
+		ReportFn: func(ctx context.Context, req *transactionpb.GetReportRequest) (*transactionpb.GetReportResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, "2025-01-01", req.DateFrom.AsTime().Format("2006-01-02"))
+			require.Equal(t, "2025-03-31", req.DateTo.AsTime().Format("2006-01-02"))
+			require.Equal(t, transactionpb.ReportGranularity_REPORT_GRANULARITY_WEEK, req.Granularity)
+			require.True(t, req.ByCategory)
+			return &transactionpb.GetReportResponse{
+				Currency: "RUB",
+				Buckets:  []*transactionpb.ReportBucket{{NetDecimal: "100.00", ClosingBalanceDecimal: "100.00"}},
+			}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/report?user_id=user-1&date_from=2025-01-01&date_to=2025-03-31&granularity=week&by_category=true", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"closing_balance_decimal":"100.00"`)
+}
+
+func TestTransactionHandler_GetReport_InvalidGranularity(t *testing.T) {
+	client, cleanup := startTransactionTestServer(t, &mockTransactionServer{})
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/report?user_id=user-1&date_from=2025-01-01&date_to=2025-03-31&granularity=hour", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "granularity")
+}
+
+func TestTransactionHandler_SetBudget_ExactLimit(t *testing.T) {
+	srv := &mockTransactionServer{
+		SetBudgetFn: func(ctx context.Context, req *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error) {
+			require.Equal(t, "cat-1", req.CategoryId)
+			require.Equal(t, "15000.10", req.LimitDecimal)
+			return &transactionpb.SetBudgetResponse{Budget: &transactionpb.Budget{Id: "b-1", LimitDecimal: "15000.10"}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	body := []byte(`{"user_id":"user-1","category_id":"cat-1","limit":15000.10}`)
+	req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"id":"b-1"`)
+}
+
+func TestTransactionHandler_SetBudget_CategoryMismatch(t *testing.T) {
+	srv := &mockTransactionServer{
+		SetBudgetFn: func(ctx context.Context, req *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "category type does not match transaction type")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	body := []byte(`{"user_id":"user-1","category_id":"salary","limit":"100"}`)
+	req, _ := http.NewRequest(http.MethodPost, "/budgets", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTransactionHandler_ListBudgets_Month(t *testing.T) {
+	srv := &mockTransactionServer{
+		ListBudgetsFn: func(ctx context.Context, req *transactionpb.ListBudgetsRequest) (*transactionpb.ListBudgetsResponse, error) {
+			require.Equal(t, "2025-03-01", req.Month.AsTime().Format("2006-01-02"))
+			return &transactionpb.ListBudgetsResponse{Budgets: []*transactionpb.BudgetStatus{{SpentDecimal: "17000.00", RemainingDecimal: "-7000.00", Overspent: true}}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/budgets?user_id=user-1&month=2025-03", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"overspent":true`)
+}
+
+func TestTransactionHandler_CreateRecurring_Success(t *testing.T) {
+	srv := &mockTransactionServer{
+		CreateRecurringFn: func(ctx context.Context, req *transactionpb.CreateRecurringTransactionRequest) (*transactionpb.CreateRecurringTransactionResponse, error) {
+			require.Equal(t, transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY, req.Frequency)
+			require.Equal(t, transactionpb.TransactionType_EXPENSE, req.Type)
+			require.Equal(t, "50000", req.AmountDecimal)
+			require.Equal(t, "2025-01-31", req.StartDate.AsTime().Format("2006-01-02"))
+			require.Nil(t, req.EndDate)
+			require.EqualValues(t, 12, req.Count)
+			return &transactionpb.CreateRecurringTransactionResponse{Recurring: &transactionpb.RecurringTransaction{Id: "rec-1"}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	body := []byte(`{"user_id":"user-1","category_id":"rent","type":"EXPENSE","amount":50000,"frequency":"monthly","start_date":"2025-01-31","count":12}`)
+	req, _ := http.NewRequest(http.MethodPost, "/recurring", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), `"id":"rec-1"`)
+}
+
+func TestTransactionHandler_CreateRecurring_InvalidFrequency(t *testing.T) {
+	client, cleanup := startTransactionTestServer(t, &mockTransactionServer{})
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	body := []byte(`{"user_id":"user-1","category_id":"rent","type":"EXPENSE","amount":1,"frequency":"hourly","start_date":"2025-01-31"}`)
+	req, _ := http.NewRequest(http.MethodPost, "/recurring", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "frequency")
+}
+
+func TestTransactionHandler_PauseAndResumeRecurring(t *testing.T) {
+	var calls []bool
+	srv := &mockTransactionServer{
+		PauseRecurringFn: func(ctx context.Context, req *transactionpb.PauseRecurringTransactionRequest) (*transactionpb.PauseRecurringTransactionResponse, error) {
+			require.Equal(t, "rec-1", req.RecurringId)
+			calls = append(calls, req.Paused)
+			return &transactionpb.PauseRecurringTransactionResponse{Recurring: &transactionpb.RecurringTransaction{Id: "rec-1", Paused: req.Paused}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	for _, action := range []string{"pause", "resume"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/recurring/rec-1/"+action+"?user_id=user-1", nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	require.Equal(t, []bool{true, false}, calls)
+}
+
+func TestTransactionHandler_Import_Success(t *testing.T) {
+	csvData := "Дата;Сумма\n05.03.2024;-100,50\n"
+	srv := &mockTransactionServer{
+		ImportFn: func(ctx context.Context, req *transactionpb.ImportTransactionsRequest) (*transactionpb.ImportTransactionsResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, csvData, string(req.Csv))
+			require.Equal(t, ";", req.Options.Delimiter)
+			require.Equal(t, ",", req.Options.DecimalSeparator)
+			require.Equal(t, "DD.MM.YYYY", req.Options.DateFormat)
+			require.Equal(t, "Дата", req.Options.Columns.Date)
+			require.Equal(t, "cat-out", req.Options.DefaultExpenseCategoryId)
+			return &transactionpb.ImportTransactionsResponse{
+				Accepted: 1,
+				Rows:     []*transactionpb.ImportRowResult{{Line: 2, Accepted: true, TransactionId: "tx-1"}},
+			}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "statement.csv")
+	require.NoError(t, err)
+	_, _ = part.Write([]byte(csvData))
+	for field, value := range map[string]string{
+		"delimiter":           ";",
+		"decimal_separator":   ",",
+		"date_format":         "DD.MM.YYYY",
+		"date_column":         "Дата",
+		"amount_column":       "Сумма",
+		"expense_category_id": "cat-out",
+	} {
+		require.NoError(t, form.WriteField(field, value))
+	}
+	require.NoError(t, form.Close())
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/transactions/import?user_id=user-1", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"transaction_id":"tx-1"`)
+}
+
+func TestTransactionHandler_Import_MissingFile(t *testing.T) {
+	client, cleanup := startTransactionTestServer(t, &mockTransactionServer{})
+	defer cleanup()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	require.NoError(t, form.WriteField("delimiter", ";"))
+	require.NoError(t, form.Close())
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/transactions/import?user_id=user-1", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTransactionHandler_Import_InvalidFile(t *testing.T) {
+	srv := &mockTransactionServer{
+		ImportFn: func(ctx context.Context, req *transactionpb.ImportTransactionsRequest) (*transactionpb.ImportTransactionsResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, `invalid import file: column "amount" not found`)
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "statement.csv")
+	require.NoError(t, err)
+	_, _ = part.Write([]byte("date\n2024-03-05\n"))
+	require.NoError(t, form.Close())
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/transactions/import?user_id=user-1", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "amount")
+}
+
+func TestTransactionHandler_Export_Success(t *testing.T) {
+	srv := &mockTransactionServer{
+		ExportFn: func(req *transactionpb.ExportTransactionsRequest, stream transactionpb.TransactionService_ExportTransactionsServer) error {
+			require.Equal(t, transactionpb.ExportFormat_EXPORT_FORMAT_OFX, req.Format)
+			require.Equal(t, "user-1", req.Filter.UserId)
+			require.Equal(t, transactionpb.TransactionType_EXPENSE, req.Filter.Type)
+			require.Equal(t, "2025-01-01", req.Filter.DateFrom.AsTime().Format("2006-01-02"))
+			for _, part := range []string{"<OFX>", "</OFX>"} {
+				if err := stream.Send(&transactionpb.ExportChunk{Data: []byte(part)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/export?user_id=user-1&format=ofx&type=EXPENSE&date_from=2025-01-01", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "application/x-ofx", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Header().Get("Content-Disposition"), "transactions.ofx")
+	require.Equal(t, "<OFX></OFX>", w.Body.String())
+}
+
+func TestTransactionHandler_Export_InvalidFilter(t *testing.T) {
+	srv := &mockTransactionServer{
+		ExportFn: func(req *transactionpb.ExportTransactionsRequest, stream transactionpb.TransactionService_ExportTransactionsServer) error {
+			return status.Error(codes.InvalidArgument, "date_from must not be after date_to")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/export?user_id=user-1&date_from=2025-02-01&date_to=2025-01-01", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "date_from")
+}
+
+func TestTransactionHandler_Export_UnknownFormat(t *testing.T) {
+	client, cleanup := startTransactionTestServer(t, &mockTransactionServer{})
+	defer cleanup()
+
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/export?user_id=user-1&format=xls", nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTransactionHandler_RejectsForeignUserID(t *testing.T) {
+	srv := &mockTransactionServer{
+		BalanceFn: func(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+			t.Fatal("backend must not be called")
+			return nil, nil
+		},
+		SetBudgetFn: func(ctx context.Context, req *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error) {
+			t.Fatal("backend must not be called")
+			return nil, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/balance?user_id=user-2", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusForbidden, resp.Code)
+
+	req, _ = http.NewRequest(http.MethodPost, "/budgets", bytes.NewBufferString(`{"user_id":"user-2","category_id":"cat-1","limit":"100"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestTransactionHandler_UserFromToken(t *testing.T) {
+	srv := &mockTransactionServer{
+		BalanceFn: func(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			require.Equal(t, []string{"user42"}, md.Get(middleware.UserIDMetadataKey))
+			require.Equal(t, "user42", req.UserId)
+			return &transactionpb.GetBalanceResponse{}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	h := handlers.NewTransactionHandler(client)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/transactions/balance", middleware.JWTMiddleware(testKeys, nil), h.GetBalance)
+
+	// user_id в запросе больше не нужен: пользователь берётся из токена
+	req, _ := http.NewRequest(http.MethodGet, "/transactions/balance", nil)
+	req.Header.Set("Authorization", "Bearer "+generateTestJWT("user42", time.Hour))
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestTransactionHandler_HouseholdInviteAndBalance(t *testing.T) {
+	srv := &mockTransactionServer{
+		InviteFn: func(ctx context.Context, req *transactionpb.InviteHouseholdMemberRequest) (*transactionpb.InviteHouseholdMemberResponse, error) {
+			require.Equal(t, "hh-1", req.HouseholdId)
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, transactionpb.HouseholdRole_HOUSEHOLD_ROLE_VIEWER, req.Role)
+			return &transactionpb.InviteHouseholdMemberResponse{InvitationId: "inv-1", Code: "code-1"}, nil
+		},
+		BalanceFn: func(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
+			require.Equal(t, "hh-1", req.HouseholdId)
+			return nil, status.Error(codes.PermissionDenied, "нет доступа к общему бюджету")
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/households/hh-1/invitations", bytes.NewBufferString(`{"role":"Viewer"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), "code-1")
+
+	// Владельца приглашением не назначить
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/households/hh-1/invitations", bytes.NewBufferString(`{"role":"owner"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/transactions/balance?household_id=hh-1", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestTransactionHandler_CreateAccountAndListByAccount(t *testing.T) {
+	srv := &mockTransactionServer{
+		CreateAccountFn: func(ctx context.Context, req *transactionpb.CreateAccountRequest) (*transactionpb.CreateAccountResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, transactionpb.AccountType_ACCOUNT_TYPE_SAVINGS, req.Type)
+			require.Equal(t, "1500.50", req.OpeningBalanceDecimal)
+			return &transactionpb.CreateAccountResponse{Account: &transactionpb.Account{Id: "acc-1", Name: req.Name, BalanceDecimal: "1500.50"}}, nil
+		},
+		ListFn: func(ctx context.Context, req *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error) {
+			require.Equal(t, "acc-1", req.AccountId)
+			require.Equal(t, transactionpb.TransactionType_TRANSFER, req.Type)
+			return &transactionpb.ListTransactionsResponse{}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/accounts", bytes.NewBufferString(`{"name":"Вклад","type":"Savings","opening_balance":"1500.50"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), "acc-1")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/accounts", bytes.NewBufferString(`{"name":"Вклад","type":"brokerage"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/transactions?account_id=acc-1&type=TRANSFER", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTransactionHandler_CreateAndUpdateGoal(t *testing.T) {
+	srv := &mockTransactionServer{
+		CreateGoalFn: func(ctx context.Context, req *transactionpb.CreateGoalRequest) (*transactionpb.CreateGoalResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, "150000", req.TargetAmountDecimal)
+			require.Equal(t, "2026-06-01", req.Deadline.AsTime().Format("2006-01-02"))
+			return &transactionpb.CreateGoalResponse{Goal: &transactionpb.Goal{Id: "goal-1", Name: req.Name, RequiredMonthlyDecimal: "18750.00"}}, nil
+		},
+		UpdateGoalFn: func(ctx context.Context, req *transactionpb.UpdateGoalRequest) (*transactionpb.UpdateGoalResponse, error) {
+			require.Equal(t, "goal-1", req.GoalId)
+			require.ElementsMatch(t, []string{"target_amount_decimal", "deadline"}, req.UpdateMask.Paths)
+			require.Equal(t, "200000", req.Goal.TargetAmountDecimal)
+			require.Nil(t, req.Goal.Deadline)
+			return &transactionpb.UpdateGoalResponse{Goal: &transactionpb.Goal{Id: "goal-1"}}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/goals", bytes.NewBufferString(`{"name":"Отпуск","target_amount":"150000","deadline":"2026-06-01"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), "18750.00")
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPost, "/goals", bytes.NewBufferString(`{"name":"Отпуск","target_amount":"150000","deadline":"к лету"}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// null снимает срок
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodPatch, "/goals/goal-1", bytes.NewBufferString(`{"target_amount":"200000","deadline":null}`))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestTransactionHandler_CreateLoanAndNetWorth(t *testing.T) {
+	srv := &mockTransactionServer{
+		CreateLoanFn: func(ctx context.Context, req *transactionpb.CreateLoanRequest) (*transactionpb.CreateLoanResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			require.Equal(t, transactionpb.LoanDirection_LOAN_DIRECTION_BORROWED, req.Direction)
+			require.Equal(t, transactionpb.LoanSchedule_LOAN_SCHEDULE_DIFFERENTIATED, req.Schedule)
+			require.Equal(t, 12.5, req.AnnualRatePercent)
+			require.Equal(t, int32(36), req.TermMonths)
+			return &transactionpb.CreateLoanResponse{Loan: &transactionpb.Loan{Id: "loan-1", OutstandingDecimal: req.PrincipalDecimal}}, nil
+		},
+		GetNetWorthFn: func(ctx context.Context, req *transactionpb.GetNetWorthRequest) (*transactionpb.GetNetWorthResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			return &transactionpb.GetNetWorthResponse{Currency: "RUB", NetWorthDecimal: "-41000.00"}, nil
+		},
+	}
+	client, cleanup := startTransactionTestServer(t, srv)
+	defer cleanup()
+	router := setupTransactionRouter(handlers.NewTransactionHandler(client))
+
+	w := httptest.NewRecorder()
+	body := `{"counterparty":"Банк","direction":"Borrowed","schedule":"differentiated","principal":"120000","annual_rate":12.5,"term_months":36,"start_date":"2025-01-10"}`
+	req, _ := http.NewRequest(http.MethodPost, "/loans", bytes.NewBufferString(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), "loan-1")
+
+	w = httptest.NewRecorder()
+	body = `{"counterparty":"Банк","direction":"sideways","principal":"120000","term_months":36,"start_date":"2025-01-10"}`
+	req, _ = http.NewRequest(http.MethodPost, "/loans", bytes.NewBufferString(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest(http.MethodGet, "/transactions/net-worth", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "-41000.00")
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mockUserServer struct {
+	userpb.UnimplementedUserServiceServer
+	GetFn func(context.Context, *userpb.GetUserProfileRequest) (*userpb.GetUserProfileResponse, error)
+}
+
+func (m *mockUserServer) GetUserProfile(ctx context.Context, req *userpb.GetUserProfileRequest) (*userpb.GetUserProfileResponse, error) {
+	return m.GetFn(ctx, req)
+}
+
+func startUserTestServer(t *testing.T, srv *mockUserServer) (userpb.UserServiceClient, func()) {
+	listener := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	userpb.RegisterUserServiceServer(server, srv)
+	go server.Serve(listener)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	cleanup := func() {
+		conn.Close()
+		server.Stop()
+	}
+	return userpb.NewUserServiceClient(conn), cleanup
+}
+
+func TestUserHandler_GetProfile_OwnOnly(t *testing.T) {
+	srv := &mockUserServer{
+		GetFn: func(ctx context.Context, req *userpb.GetUserProfileRequest) (*userpb.GetUserProfileResponse, error) {
+			require.Equal(t, "user-1", req.UserId)
+			return &userpb.GetUserProfileResponse{UserId: req.UserId, Name: "Alice"}, nil
+		},
+	}
+	client, cleanup := startUserTestServer(t, srv)
+	defer cleanup()
+
+	h := handlers.NewUserHandler(client, zap.NewNop())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(authenticatedAs("user-1"))
+	router.GET("/users/:id", h.GetUserProfile)
+
+	for path, code := range map[string]int{
+		"/users/me":     http.StatusOK,
+		"/users/user-1": http.StatusOK,
+		"/users/user-2": http.StatusForbidden,
+	} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+		require.Equal(t, code, resp.Code, path)
+	}
+}
+
+func TestUserHandler_GetProfile_NotFound(t *testing.T) {
+	srv := &mockUserServer{
+		GetFn: func(ctx context.Context, req *userpb.GetUserProfileRequest) (*userpb.GetUserProfileResponse, error) {
+			return nil, status.Error(codes.NotFound, "profile not found")
+		},
+	}
+	client, cleanup := startUserTestServer(t, srv)
+	defer cleanup()
+
+	h := handlers.NewUserHandler(client, zap.NewNop())
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(authenticatedAs("user-1"))
+	router.GET("/users/:id", h.GetUserProfile)
+
+	req, _ := http.NewRequest(http.MethodGet, "/users/me", nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusNotFound, resp.Code)
+	require.JSONEq(t, `{"error":"profile not found"}`, resp.Body.String())
+}
@@ -1,14 +1,19 @@
 package main
 
 import (
+	"context"
 	"github.com/joho/godotenv"
 	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/middleware"
 	"github.com/khaldeezal/Finplan-structure/services/api-gateway/internal/router"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"os"
+	"strings"
+	"time"
 
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
 	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
 	"go.uber.org/zap"
@@ -52,28 +57,49 @@ func main() {
 	transactionClient := transactionpb.NewTransactionServiceClient(transactionConn)
 
 	logger, _ := zap.NewProduction()
-	authHandler := handlers.NewAuthHandler(authConn, logger)
-	transactionHandler := handlers.NewTransactionHandler(transactionClient)
-	userHandler := handlers.NewUserHandler(userClient, logger)
-	jwtSecret := os.Getenv("JWT_SECRET")
+	// Кеш отозванных токенов: первая загрузка при старте, дальше — периодически
+	revocations := middleware.NewRevocationCache(authpb.NewAuthServiceClient(authConn), logger)
+	if err := revocations.Sync(context.Background()); err != nil {
+		logger.Warn("initial revocation list sync failed", zap.Error(err))
+	}
+	syncInterval := 10 * time.Second
+	if v := os.Getenv("REVOCATION_SYNC_INTERVAL"); v != "" {
+		if syncInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatal("invalid REVOCATION_SYNC_INTERVAL:", err)
+		}
+	}
+	go revocations.Run(context.Background(), syncInterval)
 
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET is required")
+	// Открытые ключи auth-service для проверки подписи access-токенов
+	jwks := middleware.NewJWKSCache(authpb.NewAuthServiceClient(authConn), logger)
+	if err := jwks.Sync(context.Background()); err != nil {
+		logger.Warn("initial jwks sync failed", zap.Error(err))
+	}
+	jwksInterval := time.Minute
+	if v := os.Getenv("JWKS_SYNC_INTERVAL"); v != "" {
+		if jwksInterval, err = time.ParseDuration(v); err != nil {
+			log.Fatal("invalid JWKS_SYNC_INTERVAL:", err)
+		}
 	}
+	go jwks.Run(context.Background(), jwksInterval)
+
+	authHandler := handlers.NewAuthHandler(authConn, logger).WithRevocationCache(revocations)
+	transactionHandler := handlers.NewTransactionHandler(transactionClient)
+	userHandler := handlers.NewUserHandler(userClient, logger)
+	adminHandler := handlers.NewAdminHandler(authConn, logger)
 
 	// Роутер
-	r := router.NewRouter(
-		authHandler.Register,
-		authHandler.Login,
-		authHandler.VerifyToken,
-		transactionHandler.AddTransaction,
-		transactionHandler.ListTransactions,
-		transactionHandler.DeleteTransaction,
-		transactionHandler.GetBalance,
-		userHandler.GetUserProfile,
-		userHandler.UpdateUserProfile,
-		jwtSecret,
-	)
+	r := router.NewRouter(authHandler, transactionHandler, userHandler, adminHandler, jwks, revocations)
+
+	// c.ClientIP() учитывает X-Forwarded-For только от доверенных прокси
+	// (TRUSTED_PROXIES через запятую); без них берётся адрес соединения
+	var proxies []string
+	if v := os.Getenv("TRUSTED_PROXIES"); v != "" {
+		proxies = strings.Split(v, ",")
+	}
+	if err := r.SetTrustedProxies(proxies); err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	// Запуск сервера
 	port := os.Getenv("GATEWAY_PORT")
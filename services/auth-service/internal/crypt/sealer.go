@@ -0,0 +1,67 @@
+// Пакет crypt шифрует секреты, которые нужно хранить в базе в восстановимом виде
+package crypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Длина ключа AES-256
+const KeySize = 32
+
+var ErrCiphertext = errors.New("ciphertext is malformed or was encrypted with another key")
+
+// Шифрует данные AES-256-GCM; случайный nonce хранится перед шифртекстом
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+func NewSealer(key []byte) (*Sealer, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Sealer{aead: aead}, nil
+}
+
+// Ключ в base64, например из переменной окружения
+func NewSealerFromBase64(encoded string) (*Sealer, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key is not valid base64: %w", err)
+	}
+	return NewSealer(key)
+}
+
+// Шифрует plaintext; associated привязывает шифртекст к владельцу,
+// чтобы его нельзя было подставить в чужую запись
+func (s *Sealer) Seal(plaintext, associated []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, associated), nil
+}
+
+func (s *Sealer) Open(ciphertext, associated []byte) ([]byte, error) {
+	n := s.aead.NonceSize()
+	if len(ciphertext) < n {
+		return nil, ErrCiphertext
+	}
+	plaintext, err := s.aead.Open(nil, ciphertext[:n], ciphertext[n:], associated)
+	if err != nil {
+		return nil, ErrCiphertext
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,73 @@
+package grpcclients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ключ метаданных с токеном сервиса: по нему user-service пускает к созданию профиля и смене email
+const serviceTokenMetadataKey = "x-service-token"
+
+// Клиент user-service
+type UserClient struct {
+	client       userpb.UserServiceClient
+	serviceToken string
+	logger       *zap.Logger
+}
+
+// serviceToken — общий секрет с user-service (SERVICE_TOKEN там)
+func NewUserClient(conn *grpc.ClientConn, serviceToken string, logger *zap.Logger) *UserClient {
+	return &UserClient{
+		client:       userpb.NewUserServiceClient(conn),
+		serviceToken: serviceToken,
+		logger:       logger,
+	}
+}
+
+// Обработчик user.registered: создаёт профиль с валютой и языком по умолчанию.
+// Профиль, созданный прошлой попыткой, user-service оставляет как есть
+func (c *UserClient) HandleUserRegistered(ctx context.Context, event model.OutboxEvent) error {
+	var e model.UserRegistered
+	if err := json.Unmarshal(event.Payload, &e); err != nil {
+		return fmt.Errorf("invalid user.registered payload: %w", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenMetadataKey, c.serviceToken)
+	resp, err := c.client.CreateUserProfile(ctx, &userpb.CreateUserProfileRequest{
+		UserId: e.UserID,
+		Name:   e.Name,
+		Email:  e.Email,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create user profile: %w", err)
+	}
+	c.logger.Info("user profile provisioned", zap.String("user_id", e.UserID), zap.Bool("created", resp.GetCreated()))
+	return nil
+}
+
+// Обработчик user.email_changed: записывает подтверждённый email в профиль.
+// Пока профиль не создан, user-service отвечает NotFound, и событие будет доставлено повторно
+func (c *UserClient) HandleUserEmailChanged(ctx context.Context, event model.OutboxEvent) error {
+	var e model.UserEmailChanged
+	if err := json.Unmarshal(event.Payload, &e); err != nil {
+		return fmt.Errorf("invalid user.email_changed payload: %w", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, serviceTokenMetadataKey, c.serviceToken)
+	resp, err := c.client.UpdateUserEmail(ctx, &userpb.UpdateUserEmailRequest{
+		UserId:    e.UserID,
+		Email:     e.Email,
+		ChangedAt: timestamppb.New(e.ChangedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update profile email: %w", err)
+	}
+	c.logger.Info("user profile email synced", zap.String("user_id", e.UserID), zap.Bool("updated", resp.GetUpdated()))
+	return nil
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	service "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListUsers отдаёт страницу пользователей. Доступен поддержке и администраторам.
+func (h *AuthHandler) ListUsers(ctx context.Context, req *authpb.ListUsersRequest) (*authpb.ListUsersResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("ListUsers called", zap.String("callerID", callerID))
+	users, total, err := h.service.ListUsers(ctx, callerID, model.UserFilter{
+		Query:  req.GetQuery(),
+		Role:   req.GetRole(),
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, h.adminError(err)
+	}
+	resp := &authpb.ListUsersResponse{Users: make([]*authpb.UserInfo, 0, len(users)), Total: int32(total)}
+	for _, u := range users {
+		resp.Users = append(resp.Users, &authpb.UserInfo{
+			Id:            u.ID,
+			Email:         u.Email,
+			Name:          u.Name,
+			Role:          u.Role,
+			EmailVerified: u.EmailVerifiedAt != nil,
+			Disabled:      u.DisabledAt != nil,
+			CreatedAt:     timestamppb.New(u.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// SetUserDisabled блокирует или разблокирует аккаунт.
+func (h *AuthHandler) SetUserDisabled(ctx context.Context, req *authpb.SetUserDisabledRequest) (*authpb.SetUserDisabledResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("SetUserDisabled called", zap.String("callerID", callerID), zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	changed, err := h.service.SetUserDisabled(ctx, callerID, req.GetUserId(), req.GetDisabled())
+	if err != nil {
+		return nil, h.adminError(err)
+	}
+	return &authpb.SetUserDisabledResponse{Changed: changed}, nil
+}
+
+// UnlockAccount снимает блокировку входа после неудачных попыток.
+func (h *AuthHandler) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("UnlockAccount called", zap.String("callerID", callerID), zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	unlocked, err := h.service.UnlockAccount(ctx, callerID, req.GetUserId())
+	if err != nil {
+		return nil, h.adminError(err)
+	}
+	return &authpb.UnlockAccountResponse{Unlocked: unlocked}, nil
+}
+
+// SetUserRole назначает пользователю роль.
+func (h *AuthHandler) SetUserRole(ctx context.Context, req *authpb.SetUserRoleRequest) (*authpb.SetUserRoleResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("SetUserRole called", zap.String("callerID", callerID), zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	if err := h.service.SetUserRole(ctx, callerID, req.GetUserId(), req.GetRole()); err != nil {
+		return nil, h.adminError(err)
+	}
+	return &authpb.SetUserRoleResponse{}, nil
+}
+
+// RecordAuditEvent записывает обращение к административному API.
+func (h *AuthHandler) RecordAuditEvent(ctx context.Context, req *authpb.RecordAuditEventRequest) (*authpb.RecordAuditEventResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetAction() == "" {
+		return nil, status.Error(codes.InvalidArgument, "action is required")
+	}
+	err = h.service.RecordAudit(ctx, callerID, &model.AuditEvent{
+		Action:       req.GetAction(),
+		TargetUserID: req.GetTargetUserId(),
+		ClientIP:     req.GetClientIp(),
+	})
+	if err != nil {
+		return nil, h.adminError(err)
+	}
+	return &authpb.RecordAuditEventResponse{}, nil
+}
+
+// ListAuditEvents отдаёт журнал действий администраторов и поддержки.
+func (h *AuthHandler) ListAuditEvents(ctx context.Context, req *authpb.ListAuditEventsRequest) (*authpb.ListAuditEventsResponse, error) {
+	callerID, err := h.callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.logger.Info("ListAuditEvents called", zap.String("callerID", callerID))
+	filter := model.AuditFilter{
+		ActorID:      req.GetActorId(),
+		TargetUserID: req.GetTargetUserId(),
+		Limit:        int(req.GetLimit()),
+	}
+	if req.GetBefore() != nil {
+		filter.Before = req.GetBefore().AsTime()
+	}
+	events, err := h.service.ListAudit(ctx, callerID, filter)
+	if err != nil {
+		return nil, h.adminError(err)
+	}
+	resp := &authpb.ListAuditEventsResponse{Events: make([]*authpb.AuditEvent, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &authpb.AuditEvent{
+			Id:           e.ID,
+			ActorId:      e.ActorID,
+			ActorRole:    e.ActorRole,
+			Action:       e.Action,
+			TargetUserId: e.TargetUserID,
+			ClientIp:     e.ClientIP,
+			Details:      e.Details,
+			CreatedAt:    timestamppb.New(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) adminError(err error) error {
+	h.logger.Error("admin operation failed", zap.Error(err))
+	switch {
+	case errors.Is(err, service.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, service.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, service.ErrInvalidRole), errors.Is(err, service.ErrSelfModification):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "internal error")
+}
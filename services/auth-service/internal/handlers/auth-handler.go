@@ -2,37 +2,58 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
+
 	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
 	service "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"os"
-	"time"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
-	"github.com/golang-jwt/jwt/v5"
+// Метаданные запроса: IP клиента и access-токен пользователя, которые передаёт шлюз
+const (
+	ClientIPMetadataKey      = "x-client-ip"
+	AuthorizationMetadataKey = "authorization"
 )
 
 type AuthHandler struct {
 	authpb.UnimplementedAuthServiceServer
-	service   service.AuthService
-	logger    *zap.Logger
-	jwtSecret string
+	service service.AuthService
+	logger  *zap.Logger
 }
 
 // NewAuthHandler создаёт новый AuthHandler с внедрённой бизнес-логикой.
 func NewAuthHandler(s service.AuthService, logger *zap.Logger) *AuthHandler {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	return &AuthHandler{service: s, logger: logger, jwtSecret: jwtSecret}
+	return &AuthHandler{service: s, logger: logger}
 }
 
-func (h *AuthHandler) generateJWT(userID string) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour * 72).Unix(),
+// issueTokens выдаёт пару токенов новой сессии пользователя.
+func (h *AuthHandler) issueTokens(ctx context.Context, userID string) (*authpb.AuthResponse, error) {
+	tokens, err := h.service.IssueTokens(ctx, userID)
+	if err != nil {
+		h.logger.Error("token generation failed", zap.Error(err))
+		if errors.Is(err, service.ErrAccountDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "account disabled")
+		}
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+	return authResponse(tokens), nil
+}
+
+func authResponse(tokens *model.TokenPair) *authpb.AuthResponse {
+	return &authpb.AuthResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt.UTC().Format(time.RFC3339),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(h.jwtSecret))
 }
 
 // Register обрабатывает запрос регистрации нового пользователя.
@@ -47,61 +68,354 @@ func (h *AuthHandler) Register(ctx context.Context, req *authpb.RegisterRequest)
 		}
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	jwtToken, err := h.generateJWT(userID)
-	if err != nil {
-		h.logger.Error("JWT generation failed", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to generate token")
-	}
-	return &authpb.AuthResponse{
-		AccessToken:  jwtToken,
-		RefreshToken: "",
-		ExpiresAt:    "",
-	}, nil
+	return h.issueTokens(ctx, userID)
 }
 
 // Login обрабатывает вход пользователя.
 // Принимает email и пароль, возвращает JWT-токен или ошибку.
 func (h *AuthHandler) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.AuthResponse, error) {
 	h.logger.Info("Login called", zap.String("email", req.Email))
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
 	userID, err := h.service.Login(ctx, req.Email, req.Password)
 	if err != nil {
 		h.logger.Error("Login failed", zap.Error(err))
+		var locked *service.LockedError
+		if errors.As(err, &locked) {
+			return nil, lockedStatus(locked)
+		}
 		if err == service.ErrInvalidCredentials || err == service.ErrUserNotFound {
 			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 		}
+		if errors.Is(err, service.ErrAccountDisabled) {
+			return nil, status.Error(codes.PermissionDenied, "account disabled")
+		}
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	jwtToken, err := h.generateJWT(userID)
+	// Со вторым фактором токены выдаёт VerifyMFA
+	mfaToken, expiresAt, err := h.service.StartMFAChallenge(ctx, userID)
 	if err != nil {
-		h.logger.Error("JWT generation failed", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to generate token")
+		h.logger.Error("MFA challenge failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &authpb.AuthResponse{
-		AccessToken:  jwtToken,
-		RefreshToken: "",
-		ExpiresAt:    "",
-	}, nil
+	if mfaToken != "" {
+		return &authpb.AuthResponse{
+			MfaRequired: true,
+			MfaToken:    mfaToken,
+			ExpiresAt:   expiresAt.UTC().Format(time.RFC3339),
+		}, nil
+	}
+	return h.issueTokens(ctx, userID)
+}
+
+// RefreshToken обменивает refresh-токен на новую пару токенов.
+// Повторно предъявленный токен отзывает всю цепочку токенов этой сессии.
+func (h *AuthHandler) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.AuthResponse, error) {
+	h.logger.Info("RefreshToken called")
+	tokens, err := h.service.RefreshTokens(ctx, req.GetRefreshToken())
+	if err != nil {
+		h.logger.Error("Token refresh failed", zap.Error(err))
+		switch {
+		case errors.Is(err, service.ErrInvalidToken), errors.Is(err, service.ErrTokenReused), errors.Is(err, service.ErrUserNotFound):
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		case errors.Is(err, service.ErrAccountDisabled):
+			return nil, status.Error(codes.PermissionDenied, "account disabled")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return authResponse(tokens), nil
 }
 
 // VerifyToken проверяет валидность переданного JWT-токена.
 // Возвращает userID, связанный с токеном, или ошибку.
 func (h *AuthHandler) VerifyToken(ctx context.Context, req *authpb.VerifyTokenRequest) (*authpb.VerifyTokenResponse, error) {
-	h.logger.Info("VerifyToken called", zap.String("token", req.Token))
+	h.logger.Info("VerifyToken called")
 	userID, err := h.service.VerifyToken(ctx, req.Token)
 	if err != nil {
-		h.logger.Error("Token verification failed",
-			zap.String("token", req.Token),
-			zap.Error(err),
-		)
+		h.logger.Error("Token verification failed", zap.Error(err))
 		return nil, err
 	}
-	h.logger.Info("Token verification successful",
-		zap.String("token", req.Token),
-		zap.String("userID", userID),
-	)
-	h.logger.Info("Returning VerifyToken response", zap.String("userID", userID))
+	h.logger.Info("Token verification successful", zap.String("userID", userID))
 	return &authpb.VerifyTokenResponse{
 		UserId: userID,
 		Valid:  true,
 	}, nil
 }
+
+// RevokeToken отзывает access-токен до истечения его срока.
+func (h *AuthHandler) RevokeToken(ctx context.Context, req *authpb.RevokeTokenRequest) (*authpb.RevokeTokenResponse, error) {
+	h.logger.Info("RevokeToken called")
+	if err := h.service.RevokeToken(ctx, req.GetToken()); err != nil {
+		return nil, h.revokeError(err)
+	}
+	return &authpb.RevokeTokenResponse{Success: true}, nil
+}
+
+// Logout завершает сессию: отзывает access-токен и цепочку refresh-токенов.
+func (h *AuthHandler) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
+	h.logger.Info("Logout called")
+	if err := h.service.Logout(ctx, req.GetAccessToken(), req.GetRefreshToken()); err != nil {
+		return nil, h.revokeError(err)
+	}
+	return &authpb.LogoutResponse{Success: true}, nil
+}
+
+func (h *AuthHandler) revokeError(err error) error {
+	h.logger.Error("Token revocation failed", zap.Error(err))
+	if errors.Is(err, service.ErrInvalidToken) || errors.Is(err, service.ErrUserNotFound) {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return status.Error(codes.Internal, "internal error")
+}
+
+// ListRevokedTokens отдаёт отозванные токены для кеша на стороне шлюза.
+func (h *AuthHandler) ListRevokedTokens(ctx context.Context, req *authpb.ListRevokedTokensRequest) (*authpb.ListRevokedTokensResponse, error) {
+	// Время сервера фиксируем до запроса, чтобы не пропустить отзыв, случившийся во время выборки
+	serverTime := time.Now()
+	var since time.Time
+	if req.GetSince() != nil {
+		since = req.GetSince().AsTime()
+	}
+	tokens, err := h.service.ListRevoked(ctx, since)
+	if err != nil {
+		h.logger.Error("ListRevokedTokens failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	resp := &authpb.ListRevokedTokensResponse{
+		Tokens:     make([]*authpb.RevokedToken, 0, len(tokens)),
+		ServerTime: timestamppb.New(serverTime),
+	}
+	for _, t := range tokens {
+		resp.Tokens = append(resp.Tokens, &authpb.RevokedToken{Jti: t.JTI, ExpiresAt: timestamppb.New(t.ExpiresAt)})
+	}
+	return resp, nil
+}
+
+// GetJWKS отдаёт открытые ключи, которыми проверяются access-токены.
+func (h *AuthHandler) GetJWKS(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+	jwks := h.service.PublicKeys()
+	resp := &authpb.GetJWKSResponse{Keys: make([]*authpb.JWK, 0, len(jwks))}
+	for _, k := range jwks {
+		resp.Keys = append(resp.Keys, &authpb.JWK{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+			Crv: k.Crv,
+			X:   k.X,
+			N:   k.N,
+			E:   k.E,
+		})
+	}
+	return resp, nil
+}
+
+// VerifyEmail подтверждает email по токену из письма.
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	h.logger.Info("VerifyEmail called")
+	if err := h.service.VerifyEmail(ctx, req.GetToken()); err != nil {
+		h.logger.Error("Email verification failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &authpb.VerifyEmailResponse{Success: true}, nil
+}
+
+// RequestPasswordReset отправляет письмо для сброса пароля.
+func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	h.logger.Info("RequestPasswordReset called")
+	if err := h.service.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		h.logger.Error("Password reset request failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &authpb.RequestPasswordResetResponse{}, nil
+}
+
+// ResetPassword задаёт новый пароль по токену из письма.
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	h.logger.Info("ResetPassword called")
+	if err := h.service.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		h.logger.Error("Password reset failed", zap.Error(err))
+		switch {
+		case errors.Is(err, service.ErrWeakPassword):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrInvalidToken):
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &authpb.ResetPasswordResponse{Success: true}, nil
+}
+
+// lockedStatus — RESOURCE_EXHAUSTED с временем ожидания в RetryInfo.
+func lockedStatus(locked *service.LockedError) error {
+	st := status.New(codes.ResourceExhausted, "too many failed login attempts")
+	retry := locked.RetryAfter.Round(time.Second)
+	if retry < time.Second {
+		retry = time.Second
+	}
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry)})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// callerID проверяет access-токен из метаданных ("Bearer <token>") и возвращает его пользователя.
+// Пользователю из метаданных без токена не доверяем: его может подставить любой клиент.
+func (h *AuthHandler) callerID(ctx context.Context) (string, error) {
+	token := strings.TrimPrefix(metadataValue(ctx, AuthorizationMetadataKey), "Bearer ")
+	if token == "" {
+		return "", status.Error(codes.Unauthenticated, "access token required")
+	}
+	userID, err := h.service.VerifyToken(ctx, token)
+	if err != nil {
+		h.logger.Warn("caller token rejected", zap.Error(err))
+		return "", status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	return userID, nil
+}
+
+// VerifyMFA завершает вход кодом второго фактора и выдаёт пару токенов.
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.AuthResponse, error) {
+	h.logger.Info("VerifyMFA called")
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	userID, err := h.service.VerifyMFA(ctx, req.GetMfaToken(), req.GetCode())
+	if err != nil {
+		h.logger.Error("MFA verification failed", zap.Error(err))
+		var locked *service.LockedError
+		switch {
+		case errors.As(err, &locked):
+			return nil, lockedStatus(locked)
+		case errors.Is(err, service.ErrInvalidMFACode):
+			return nil, status.Error(codes.Unauthenticated, "invalid code")
+		case errors.Is(err, service.ErrInvalidToken):
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired mfa token")
+		}
+		return nil, h.mfaError(err)
+	}
+	return h.issueTokens(ctx, userID)
+}
+
+// EnrollMFA выдаёт секрет для приложения-аутентификатора.
+func (h *AuthHandler) EnrollMFA(ctx context.Context, req *authpb.EnrollMFARequest) (*authpb.EnrollMFAResponse, error) {
+	h.logger.Info("EnrollMFA called")
+	enrollment, err := h.service.EnrollMFA(ctx, req.GetAccessToken())
+	if err != nil {
+		h.logger.Error("MFA enrollment failed", zap.Error(err))
+		return nil, h.mfaError(err)
+	}
+	return &authpb.EnrollMFAResponse{Secret: enrollment.Secret, OtpauthUri: enrollment.URI}, nil
+}
+
+// ConfirmMFA включает второй фактор и возвращает коды восстановления.
+func (h *AuthHandler) ConfirmMFA(ctx context.Context, req *authpb.ConfirmMFARequest) (*authpb.ConfirmMFAResponse, error) {
+	h.logger.Info("ConfirmMFA called")
+	recoveryCodes, err := h.service.ConfirmMFA(ctx, req.GetAccessToken(), req.GetCode())
+	if err != nil {
+		h.logger.Error("MFA confirmation failed", zap.Error(err))
+		return nil, h.mfaError(err)
+	}
+	return &authpb.ConfirmMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableMFA отключает второй фактор.
+func (h *AuthHandler) DisableMFA(ctx context.Context, req *authpb.DisableMFARequest) (*authpb.DisableMFAResponse, error) {
+	h.logger.Info("DisableMFA called")
+	if err := h.service.DisableMFA(ctx, req.GetAccessToken(), req.GetCode()); err != nil {
+		h.logger.Error("MFA disable failed", zap.Error(err))
+		return nil, h.mfaError(err)
+	}
+	return &authpb.DisableMFAResponse{Success: true}, nil
+}
+
+func (h *AuthHandler) mfaError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidToken), errors.Is(err, service.ErrUserNotFound):
+		return status.Error(codes.Unauthenticated, "invalid token")
+	case errors.Is(err, service.ErrInvalidMFACode):
+		return status.Error(codes.InvalidArgument, "invalid code")
+	case errors.Is(err, service.ErrMFAAlreadyEnabled), errors.Is(err, service.ErrMFANotEnabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrMFANotConfigured):
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return status.Error(codes.Internal, "internal error")
+}
+
+// ChangePassword меняет пароль по текущему и выдаёт новую пару токенов;
+// остальные сессии пользователя завершаются.
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*authpb.AuthResponse, error) {
+	h.logger.Info("ChangePassword called")
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	tokens, err := h.service.ChangePassword(ctx, req.GetAccessToken(), req.GetOldPassword(), req.GetNewPassword())
+	if err != nil {
+		h.logger.Error("Password change failed", zap.Error(err))
+		return nil, h.accountError(err)
+	}
+	return authResponse(tokens), nil
+}
+
+// ChangeEmail отправляет ссылку подтверждения на новый адрес.
+func (h *AuthHandler) ChangeEmail(ctx context.Context, req *authpb.ChangeEmailRequest) (*authpb.ChangeEmailResponse, error) {
+	h.logger.Info("ChangeEmail called")
+	if ip := metadataValue(ctx, ClientIPMetadataKey); ip != "" {
+		ctx = service.WithClientIP(ctx, ip)
+	}
+	if err := h.service.ChangeEmail(ctx, req.GetAccessToken(), req.GetPassword(), req.GetNewEmail()); err != nil {
+		h.logger.Error("Email change request failed", zap.Error(err))
+		return nil, h.accountError(err)
+	}
+	return &authpb.ChangeEmailResponse{}, nil
+}
+
+// ConfirmEmailChange меняет email по токену из письма.
+func (h *AuthHandler) ConfirmEmailChange(ctx context.Context, req *authpb.ConfirmEmailChangeRequest) (*authpb.ConfirmEmailChangeResponse, error) {
+	h.logger.Info("ConfirmEmailChange called")
+	email, err := h.service.ConfirmEmailChange(ctx, req.GetToken())
+	if err != nil {
+		h.logger.Error("Email change failed", zap.Error(err))
+		if errors.Is(err, service.ErrInvalidToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid or expired token")
+		}
+		return nil, h.accountError(err)
+	}
+	return &authpb.ConfirmEmailChangeResponse{Email: email}, nil
+}
+
+func (h *AuthHandler) accountError(err error) error {
+	var locked *service.LockedError
+	switch {
+	case errors.As(err, &locked):
+		return lockedStatus(locked)
+	case errors.Is(err, service.ErrInvalidToken), errors.Is(err, service.ErrUserNotFound):
+		return status.Error(codes.Unauthenticated, "invalid token")
+	case errors.Is(err, service.ErrInvalidCredentials):
+		// Не Unauthenticated: сессия действительна, неверен только введённый пароль
+		return status.Error(codes.PermissionDenied, "invalid password")
+	case errors.Is(err, service.ErrWeakPassword), errors.Is(err, service.ErrInvalidEmail), errors.Is(err, service.ErrEmailUnchanged):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrEmailTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrEmailNotConfigured):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, service.ErrAccountDisabled):
+		return status.Error(codes.PermissionDenied, "account disabled")
+	}
+	return status.Error(codes.Internal, "internal error")
+}
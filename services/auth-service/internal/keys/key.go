@@ -0,0 +1,144 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// Поддерживаемые алгоритмы подписи access-токенов
+const (
+	AlgEdDSA = "EdDSA"
+	AlgRS256 = "RS256"
+)
+
+const rsaKeyBits = 2048
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrNoSigningKey         = errors.New("no signing key available")
+)
+
+// Ключ подписи; kid — отпечаток открытого ключа по RFC 7638
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	CreatedAt time.Time
+}
+
+// Создаёт новую пару ключей для алгоритма alg
+func Generate(alg string) (*Key, error) {
+	var (
+		priv crypto.Signer
+		err  error
+	)
+	switch alg {
+	case AlgEdDSA:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	case AlgRS256:
+		priv, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if err != nil {
+		return nil, err
+	}
+	k := &Key{Algorithm: alg, Private: priv}
+	k.ID = k.JWK().Thumbprint()
+	return k, nil
+}
+
+// Восстанавливает ключ из записи в базе
+func FromModel(m *model.SigningKey) (*Key, error) {
+	parsed, err := x509.ParsePKCS8PrivateKey(m.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse signing key %s: %w", m.KID, err)
+	}
+	var priv crypto.Signer
+	switch p := parsed.(type) {
+	case ed25519.PrivateKey:
+		if m.Algorithm == AlgEdDSA {
+			priv = p
+		}
+	case *rsa.PrivateKey:
+		if m.Algorithm == AlgRS256 {
+			priv = p
+		}
+	}
+	if priv == nil {
+		return nil, fmt.Errorf("signing key %s: %w", m.KID, ErrUnsupportedAlgorithm)
+	}
+	return &Key{ID: m.KID, Algorithm: m.Algorithm, Private: priv, CreatedAt: m.CreatedAt}, nil
+}
+
+// Запись для хранения в базе
+func (k *Key) Model() (*model.SigningKey, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.Private)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SigningKey{KID: k.ID, Algorithm: k.Algorithm, PrivateKey: der, CreatedAt: k.CreatedAt}, nil
+}
+
+func (k *Key) Public() crypto.PublicKey {
+	return k.Private.Public()
+}
+
+// Метод подписи jwt, соответствующий алгоритму ключа
+func (k *Key) Method() jwt.SigningMethod {
+	if k.Algorithm == AlgRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+// Открытая часть ключа в формате JWK (RFC 7517)
+type JWK struct {
+	Kty string
+	Kid string
+	Alg string
+	Use string
+	Crv string // OKP
+	X   string // OKP
+	N   string // RSA
+	E   string // RSA
+}
+
+func (k *Key) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Alg: k.Algorithm, Use: "sig"}
+	switch pub := k.Public().(type) {
+	case ed25519.PublicKey:
+		jwk.Kty, jwk.Crv, jwk.X = "OKP", "Ed25519", b64(pub)
+	case *rsa.PublicKey:
+		jwk.Kty, jwk.N, jwk.E = "RSA", b64(pub.N.Bytes()), b64(big.NewInt(int64(pub.E)).Bytes())
+	}
+	return jwk
+}
+
+// Отпечаток ключа по RFC 7638: sha256 от обязательных полей в лексикографическом порядке
+func (j JWK) Thumbprint() string {
+	var canonical string
+	if j.Kty == "RSA" {
+		canonical = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, j.E, j.N)
+	} else {
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, j.Crv, j.Kty, j.X)
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return b64(sum[:])
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,211 @@
+package keys
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Источник ключей для подписи и проверки access-токенов
+type Provider interface {
+	// Ключ, которым подписываются новые токены
+	Current() (*Key, error)
+	Lookup(kid string) (*Key, bool)
+	// Все ключи, открытые части которых публикуются в JWKS
+	Keys() []*Key
+}
+
+// Значения по умолчанию для ротации
+const (
+	DefaultRotation        = 30 * 24 * time.Hour
+	DefaultActivationDelay = 5 * time.Minute
+)
+
+// Настройки ротации ключей
+type Options struct {
+	Algorithm string
+	// Как часто выпускается новый ключ
+	Rotation time.Duration
+	// Сколько новый ключ публикуется, прежде чем им начнут подписывать:
+	// за это время шлюз и другие реплики успевают его загрузить
+	ActivationDelay time.Duration
+	// Сколько заменённый ключ остаётся в JWKS; не меньше срока жизни access-токена
+	Retention time.Duration
+}
+
+// Набор ключей в базе с автоматической ротацией. Все реплики сервиса
+// читают одну таблицу и выбирают ключ подписи по одним правилам
+type KeySet struct {
+	repo   repo.SigningKeyRepository
+	opts   Options
+	logger *zap.Logger
+	now    func() time.Time
+
+	mu   sync.RWMutex
+	keys []*Key // От новых к старым
+}
+
+var _ Provider = (*KeySet)(nil)
+
+func NewKeySet(r repo.SigningKeyRepository, opts Options, logger *zap.Logger) (*KeySet, error) {
+	if opts.Algorithm == "" {
+		opts.Algorithm = AlgEdDSA
+	}
+	if opts.Algorithm != AlgEdDSA && opts.Algorithm != AlgRS256 {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if opts.Rotation <= 0 {
+		opts.Rotation = DefaultRotation
+	}
+	if opts.ActivationDelay <= 0 {
+		opts.ActivationDelay = DefaultActivationDelay
+	}
+	return &KeySet{repo: r, opts: opts, logger: logger, now: time.Now}, nil
+}
+
+// Перечитывает ключи из базы, выпускает новый ключ, если текущий старше Rotation,
+// и удаляет ключи, которыми подписанные токены уже истекли
+func (s *KeySet) Refresh(ctx context.Context) error {
+	stored, err := s.repo.ListSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]*Key, 0, len(stored)+1)
+	for i := range stored {
+		k, err := FromModel(&stored[i])
+		if err != nil {
+			s.logger.Error("skipping unreadable signing key", zap.String("kid", stored[i].KID), zap.Error(err))
+			continue
+		}
+		keys = append(keys, k)
+	}
+
+	now := s.now()
+	if len(keys) == 0 || now.Sub(keys[0].CreatedAt) >= s.opts.Rotation {
+		k, err := s.create(ctx, now)
+		if err != nil {
+			return err
+		}
+		keys = append([]*Key{k}, keys...)
+	}
+
+	// Ключ больше не нужен, когда сменивший его подписывает токены дольше Retention
+	for i := 1; i < len(keys); i++ {
+		if now.Sub(keys[i-1].CreatedAt) < s.opts.ActivationDelay+s.opts.Retention {
+			continue
+		}
+		for _, old := range keys[i:] {
+			if err := s.repo.DeleteSigningKey(ctx, old.ID); err != nil {
+				return err
+			}
+			s.logger.Info("signing key retired", zap.String("kid", old.ID))
+		}
+		keys = keys[:i]
+		break
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *KeySet) create(ctx context.Context, now time.Time) (*Key, error) {
+	k, err := Generate(s.opts.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	k.CreatedAt = now
+	m, err := k.Model()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateSigningKey(ctx, m); err != nil {
+		return nil, err
+	}
+	s.logger.Info("signing key created", zap.String("kid", k.ID), zap.String("alg", k.Algorithm))
+	return k, nil
+}
+
+// Периодически вызывает Refresh, пока не отменён ctx
+func (s *KeySet) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil {
+				s.logger.Error("signing keys refresh failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Подписывает самый новый ключ, опубликованный не меньше ActivationDelay назад.
+// Если все ключи свежие (первый запуск), берётся самый старый из них
+func (s *KeySet) Current() (*Key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.keys) == 0 {
+		return nil, ErrNoSigningKey
+	}
+	now := s.now()
+	for _, k := range s.keys {
+		if now.Sub(k.CreatedAt) >= s.opts.ActivationDelay {
+			return k, nil
+		}
+	}
+	return s.keys[len(s.keys)-1], nil
+}
+
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return lookup(s.keys, kid)
+}
+
+func (s *KeySet) Keys() []*Key {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]*Key(nil), s.keys...)
+}
+
+// Неизменяемый набор ключей; подписывает первый из них
+type StaticSet struct {
+	keys []*Key
+}
+
+var _ Provider = (*StaticSet)(nil)
+
+func NewStaticSet(keys ...*Key) *StaticSet {
+	return &StaticSet{keys: keys}
+}
+
+func (s *StaticSet) Current() (*Key, error) {
+	if len(s.keys) == 0 {
+		return nil, ErrNoSigningKey
+	}
+	return s.keys[0], nil
+}
+
+func (s *StaticSet) Lookup(kid string) (*Key, bool) {
+	return lookup(s.keys, kid)
+}
+
+func (s *StaticSet) Keys() []*Key {
+	return append([]*Key(nil), s.keys...)
+}
+
+func lookup(keys []*Key, kid string) (*Key, bool) {
+	for _, k := range keys {
+		if k.ID == kid {
+			return k, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,15 @@
+// Пакет mailer отправляет служебные письма: подтверждение email, сброс пароля
+package mailer
+
+import "context"
+
+// Письмо в виде простого текста
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
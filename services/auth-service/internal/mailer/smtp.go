@@ -0,0 +1,77 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Настройки SMTP-сервера
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string // Пустой — без авторизации
+	Password string
+	From     string
+}
+
+// Отправляет письма через SMTP-сервер; STARTTLS используется, если сервер его поддерживает
+type SMTPMailer struct {
+	cfg SMTPConfig
+}
+
+var _ Mailer = (*SMTPMailer)(nil)
+
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if cfg.Host == "" || cfg.From == "" {
+		return nil, errors.New("smtp host and sender are required")
+	}
+	if cfg.Port == "" {
+		cfg.Port = "587"
+	}
+	return &SMTPMailer{cfg: cfg}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if strings.ContainsAny(msg.To, "\r\n") {
+		return errors.New("invalid recipient")
+	}
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+	addr := net.JoinHostPort(m.cfg.Host, m.cfg.Port)
+
+	// smtp.SendMail не принимает контекст, поэтому ждём его отдельно
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, m.cfg.From, []string{msg.To}, m.format(msg))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("smtp send: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *SMTPMailer) format(msg Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + m.cfg.From + "\r\n")
+	b.WriteString("To: " + msg.To + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Запись журнала действий администраторов и поддержки
+type AuditEvent struct {
+	ID           int64
+	ActorID      string
+	ActorRole    string
+	Action       string // Например, "GET /api/v1/admin/users/:id/transactions"
+	TargetUserID string
+	ClientIP     string
+	Details      string
+	CreatedAt    time.Time
+}
+
+// Фильтр журнала; записи возвращаются от новых к старым, до Before
+type AuditFilter struct {
+	ActorID      string
+	TargetUserID string
+	Before       time.Time
+	Limit        int
+}
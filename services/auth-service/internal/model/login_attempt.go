@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// Неудачные попытки входа по одному ключу: аккаунту или IP-адресу
+type LoginAttempt struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// Настройки TOTP пользователя. Secret хранится зашифрованным;
+// до подтверждения первым кодом EnabledAt пуст и вход не требует второго фактора
+type MFA struct {
+	UserID       string
+	Secret       []byte
+	EnabledAt    *time.Time
+	LastUsedStep int64 // Шаг последнего принятого кода, защита от повтора
+	CreatedAt    time.Time
+}
+
+func (m *MFA) Enabled() bool {
+	return m != nil && m.EnabledAt != nil
+}
+
+// Вход, ожидающий второго фактора. Хранится только хеш токена
+type MFAChallenge struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Attempts  int // Неверных кодов
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+// Данные для подключения приложения-аутентификатора
+type MFAEnrollment struct {
+	Secret string // base32
+	URI    string // otpauth://
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// Тема события о регистрации пользователя; по нему создаётся профиль в user-service
+const TopicUserRegistered = "user.registered"
+
+// Тема события о смене email; по нему обновляется email профиля в user-service
+const TopicUserEmailChanged = "user.email_changed"
+
+// Событие из таблицы outbox. Записывается в одной транзакции с изменением,
+// о котором сообщает, и доставляется фоновым обработчиком до успеха
+type OutboxEvent struct {
+	ID        int64
+	Topic     string
+	Payload   []byte // JSON
+	Attempts  int    // Неудачных попыток доставки
+	CreatedAt time.Time
+}
+
+// Данные события user.registered
+type UserRegistered struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+}
+
+// Данные события user.email_changed. По ChangedAt user-service не даёт
+// событию, доставленному с опозданием, перезаписать более новый адрес
+type UserEmailChanged struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	ChangedAt time.Time `json:"changed_at"`
+}
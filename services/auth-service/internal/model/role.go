@@ -0,0 +1,36 @@
+package model
+
+// Роли пользователей
+const (
+	RoleUser    = "user"
+	RoleSupport = "support"
+	RoleAdmin   = "admin"
+)
+
+// Права, которые проверяются для административных операций
+const (
+	PermUsersRead           = "users:read"            // Список пользователей
+	PermUsersManage         = "users:manage"          // Блокировка и смена роли
+	PermTransactionsReadAny = "transactions:read_any" // Чтение транзакций любого пользователя
+	PermAuditRead           = "audit:read"            // Журнал действий администраторов
+)
+
+var rolePermissions = map[string][]string{
+	RoleSupport: {PermUsersRead, PermTransactionsReadAny},
+	RoleAdmin:   {PermUsersRead, PermUsersManage, PermTransactionsReadAny, PermAuditRead},
+}
+
+// Известна ли роль
+func ValidRole(role string) bool {
+	return role == RoleUser || role == RoleSupport || role == RoleAdmin
+}
+
+// Есть ли у роли право
+func HasPermission(role, perm string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// Refresh-токен в базе. Хранится только хеш; все токены, выпущенные
+// друг за другом из одного входа, образуют семейство (FamilyID)
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time // Когда токен обменян на новый
+	RevokedAt *time.Time
+}
+
+// Пара токенов, выдаваемая при входе и обновлении
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // Окончание срока access-токена
+}
+
+// Отозванный access-токен. Запись нужна только до истечения срока токена
+type RevokedToken struct {
+	JTI       string
+	UserID    string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
+
+// Ключ подписи access-токенов. Закрытый ключ хранится в PKCS#8 (DER)
+type SigningKey struct {
+	KID        string
+	Algorithm  string
+	PrivateKey []byte
+	CreatedAt  time.Time
+}
+
+// Назначение одноразового токена из письма
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+	PurposeChangeEmail   = "change_email" // Email токена — новый адрес
+)
+
+// Одноразовый токен из письма. Хранится только хеш; Email — адрес,
+// на который ушло письмо: подтверждение действует, только пока адрес не сменился
+type EmailToken struct {
+	ID        string
+	UserID    string
+	Email     string
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
@@ -1,9 +1,23 @@
 // Используется в сервисах, хендлерах и репозиториях.
 package model
 
+import "time"
+
 type User struct {
-	ID       string
-	Email    string
-	Password string
-	Name     string
+	ID              string
+	Email           string
+	Password        string
+	Name            string
+	Role            string
+	EmailVerifiedAt *time.Time
+	DisabledAt      *time.Time // Заблокированный пользователь не может войти и обновить токены
+	CreatedAt       time.Time
+}
+
+// Фильтр списка пользователей для администраторов
+type UserFilter struct {
+	Query  string // Подстрока email или имени
+	Role   string
+	Limit  int
+	Offset int
 }
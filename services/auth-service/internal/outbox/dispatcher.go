@@ -0,0 +1,120 @@
+// Пакет outbox доставляет события из таблицы outbox их обработчикам.
+// Доставка «хотя бы один раз»: обработчики должны быть идемпотентны
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultBatchSize = 50
+	// Сколько событие остаётся за обработчиком, прежде чем его заберёт другой
+	DefaultLease = time.Minute
+
+	baseBackoff = time.Second
+	maxBackoff  = 10 * time.Minute
+)
+
+// Обработчик событий одной темы
+type Handler func(ctx context.Context, event model.OutboxEvent) error
+
+type Dispatcher struct {
+	repo      repo.OutboxRepository
+	handlers  map[string]Handler
+	batchSize int
+	lease     time.Duration
+	now       func() time.Time
+	logger    *zap.Logger
+}
+
+func NewDispatcher(r repo.OutboxRepository, logger *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		repo:      r,
+		handlers:  make(map[string]Handler),
+		batchSize: DefaultBatchSize,
+		lease:     DefaultLease,
+		now:       time.Now,
+		logger:    logger,
+	}
+}
+
+// Регистрирует обработчик темы
+func (d *Dispatcher) Handle(topic string, h Handler) {
+	d.handlers[topic] = h
+}
+
+// Отправляет одну пачку готовых событий и возвращает число доставленных.
+// Неудачные события откладываются с экспоненциальной задержкой и повторяются без ограничения числа попыток
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	events, err := d.repo.ClaimEvents(ctx, d.batchSize, d.lease)
+	if err != nil {
+		return 0, err
+	}
+	delivered := 0
+	for _, e := range events {
+		if err := d.deliver(ctx, e); err != nil {
+			d.logger.Warn("outbox delivery failed",
+				zap.Int64("id", e.ID), zap.String("topic", e.Topic), zap.Int("attempt", e.Attempts+1), zap.Error(err))
+			retryAt := d.now().Add(Backoff(e.Attempts + 1))
+			if err := d.repo.MarkFailed(ctx, e.ID, retryAt, err.Error()); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+		if err := d.repo.MarkProcessed(ctx, e.ID); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, e model.OutboxEvent) error {
+	h, ok := d.handlers[e.Topic]
+	if !ok {
+		return fmt.Errorf("no handler for topic %q", e.Topic)
+	}
+	return h(ctx, e)
+}
+
+// Отправляет события каждые interval до отмены ctx. Полная пачка
+// означает, что в очереди могут остаться события, поэтому следующая берётся сразу
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := d.Dispatch(ctx)
+		if err != nil {
+			d.logger.Error("outbox dispatch failed", zap.Error(err))
+		}
+		if err == nil && n == d.batchSize && ctx.Err() == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Задержка перед попыткой attempt (с 1): 1s, 2s, 4s … но не больше 10 минут
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := baseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
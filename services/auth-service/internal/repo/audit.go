@@ -0,0 +1,62 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+type AuditRepository interface {
+	CreateAuditEvent(ctx context.Context, e *model.AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter model.AuditFilter) ([]model.AuditEvent, error)
+}
+
+// Хранит журнал действий администраторов в PostgreSQL. Записи только добавляются
+type PostgresAuditRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ AuditRepository = (*PostgresAuditRepository)(nil)
+
+func NewPostgresAuditRepository(db *sql.DB, logger *zap.Logger) *PostgresAuditRepository {
+	return &PostgresAuditRepository{db: db, logger: logger}
+}
+
+func (r *PostgresAuditRepository) CreateAuditEvent(ctx context.Context, e *model.AuditEvent) error {
+	query := `INSERT INTO audit_log (actor_id, actor_role, action, target_user_id, client_ip, details)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at`
+	err := r.db.QueryRowContext(ctx, query, e.ActorID, e.ActorRole, e.Action, e.TargetUserID, e.ClientIP, e.Details).
+		Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert audit event", zap.Error(err))
+	}
+	return err
+}
+
+// Записи от новых к старым, созданные до filter.Before
+func (r *PostgresAuditRepository) ListAuditEvents(ctx context.Context, filter model.AuditFilter) ([]model.AuditEvent, error) {
+	query := `SELECT id, actor_id, actor_role, action, target_user_id, client_ip, details, created_at
+		FROM audit_log
+		WHERE ($1 = '' OR actor_id = $1) AND ($2 = '' OR target_user_id = $2) AND created_at < $3
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4`
+	rows, err := r.db.QueryContext(ctx, query, filter.ActorID, filter.TargetUserID, filter.Before.UTC(), filter.Limit)
+	if err != nil {
+		r.logger.Error("failed to list audit events", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []model.AuditEvent
+	for rows.Next() {
+		var e model.AuditEvent
+		if err := rows.Scan(&e.ID, &e.ActorID, &e.ActorRole, &e.Action, &e.TargetUserID, &e.ClientIP, &e.Details, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
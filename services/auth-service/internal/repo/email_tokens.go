@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Токен не найден, истёк или уже использован
+var ErrEmailTokenInvalid = errors.New("email token is invalid or expired")
+
+type EmailTokenRepository interface {
+	CreateEmailToken(ctx context.Context, t *model.EmailToken) error
+	ConsumeEmailToken(ctx context.Context, purpose, tokenHash string) (*model.EmailToken, error)
+	InvalidateEmailTokens(ctx context.Context, userID, purpose string) error
+}
+
+// Хранит токены из писем в PostgreSQL
+type PostgresEmailTokenRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ EmailTokenRepository = (*PostgresEmailTokenRepository)(nil)
+
+func NewPostgresEmailTokenRepository(db *sql.DB, logger *zap.Logger) *PostgresEmailTokenRepository {
+	return &PostgresEmailTokenRepository{db: db, logger: logger}
+}
+
+func (r *PostgresEmailTokenRepository) CreateEmailToken(ctx context.Context, t *model.EmailToken) error {
+	r.logger.Info("CreateEmailToken called", zap.String("userID", t.UserID), zap.String("purpose", t.Purpose))
+	query := `INSERT INTO email_tokens (user_id, email, purpose, token_hash, expires_at) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+	err := r.db.QueryRowContext(ctx, query, t.UserID, t.Email, t.Purpose, t.TokenHash, t.ExpiresAt.UTC()).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert email token", zap.Error(err))
+	}
+	return err
+}
+
+// Помечает токен использованным и возвращает его. Проверка срока и отметка
+// выполняются одним запросом, поэтому токен нельзя использовать дважды даже параллельно
+func (r *PostgresEmailTokenRepository) ConsumeEmailToken(ctx context.Context, purpose, tokenHash string) (*model.EmailToken, error) {
+	query := `UPDATE email_tokens SET used_at = now()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()
+		RETURNING id, user_id, email, purpose, token_hash, expires_at, created_at, used_at`
+	var t model.EmailToken
+	err := r.db.QueryRowContext(ctx, query, tokenHash, purpose).
+		Scan(&t.ID, &t.UserID, &t.Email, &t.Purpose, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmailTokenInvalid
+		}
+		r.logger.Error("failed to consume email token", zap.Error(err))
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Гасит неиспользованные токены пользователя с тем же назначением,
+// чтобы действовала только ссылка из последнего письма
+func (r *PostgresEmailTokenRepository) InvalidateEmailTokens(ctx context.Context, userID, purpose string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE email_tokens SET used_at = now() WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`, userID, purpose)
+	if err != nil {
+		r.logger.Error("failed to invalidate email tokens", zap.Error(err))
+	}
+	return err
+}
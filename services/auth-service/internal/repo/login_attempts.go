@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+type LoginAttemptRepository interface {
+	GetLoginAttempts(ctx context.Context, keys []string) ([]model.LoginAttempt, error)
+	RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error)
+	LockLogin(ctx context.Context, key string, until time.Time) error
+	ResetLoginAttempts(ctx context.Context, key string) (bool, error)
+	DeleteStaleLoginAttempts(ctx context.Context, before, now time.Time) (int64, error)
+}
+
+// Хранит счётчики неудачных входов в PostgreSQL, чтобы блокировки переживали перезапуск.
+// Все отметки времени приходят из сервиса в UTC: колонки без часового пояса,
+// и now() базы с другой таймзоной сдвинул бы окна блокировки
+type PostgresLoginAttemptRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ LoginAttemptRepository = (*PostgresLoginAttemptRepository)(nil)
+
+func NewPostgresLoginAttemptRepository(db *sql.DB, logger *zap.Logger) *PostgresLoginAttemptRepository {
+	return &PostgresLoginAttemptRepository{db: db, logger: logger}
+}
+
+// Счётчики по ключам; ключей без неудачных попыток в ответе нет
+func (r *PostgresLoginAttemptRepository) GetLoginAttempts(ctx context.Context, keys []string) ([]model.LoginAttempt, error) {
+	query := `SELECT key, failures, last_failure_at, locked_until FROM login_attempts WHERE key = ANY($1)`
+	rows, err := r.db.QueryContext(ctx, query, keys)
+	if err != nil {
+		r.logger.Error("failed to get login attempts", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []model.LoginAttempt
+	for rows.Next() {
+		var a model.LoginAttempt
+		if err := rows.Scan(&a.Key, &a.Failures, &a.LastFailureAt, &a.LockedUntil); err != nil {
+			r.logger.Error("failed to scan login attempt", zap.Error(err))
+			return nil, err
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, rows.Err()
+}
+
+// Увеличивает счётчик неудач. Если прошлая неудача была раньше чем window назад,
+// счёт начинается заново
+func (r *PostgresLoginAttemptRepository) RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error) {
+	query := `INSERT INTO login_attempts (key, failures, last_failure_at) VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < $3
+				THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = $2
+		RETURNING key, failures, last_failure_at, locked_until`
+	var a model.LoginAttempt
+	err := r.db.QueryRowContext(ctx, query, key, now.UTC(), now.Add(-window).UTC()).Scan(&a.Key, &a.Failures, &a.LastFailureAt, &a.LockedUntil)
+	if err != nil {
+		r.logger.Error("failed to record login failure", zap.Error(err))
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *PostgresLoginAttemptRepository) LockLogin(ctx context.Context, key string, until time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE login_attempts SET locked_until = $2 WHERE key = $1`, key, until.UTC())
+	if err != nil {
+		r.logger.Error("failed to lock login", zap.Error(err))
+	}
+	return err
+}
+
+// Сбрасывает счётчик и блокировку; false — записи не было
+func (r *PostgresLoginAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM login_attempts WHERE key = $1`, key)
+	if err != nil {
+		r.logger.Error("failed to reset login attempts", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Удаляет записи без действующей на момент now блокировки, последняя неудача в которых была до before
+func (r *PostgresLoginAttemptRepository) DeleteStaleLoginAttempts(ctx context.Context, before, now time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM login_attempts
+		WHERE last_failure_at < $1 AND (locked_until IS NULL OR locked_until < $2)`, before.UTC(), now.UTC())
+	if err != nil {
+		r.logger.Error("failed to delete stale login attempts", zap.Error(err))
+		return 0, err
+	}
+	return res.RowsAffected()
+}
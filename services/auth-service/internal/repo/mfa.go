@@ -0,0 +1,212 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrMFANotFound = errors.New("mfa is not configured")
+	// Второй фактор уже включён: новый секрет можно задать только после отключения
+	ErrMFAAlreadyEnabled = errors.New("mfa is already enabled")
+	// Вызов не найден, истёк или уже завершён
+	ErrMFAChallengeInvalid = errors.New("mfa challenge is invalid or expired")
+)
+
+type MFARepository interface {
+	GetMFA(ctx context.Context, userID string) (*model.MFA, error)
+	SaveMFASecret(ctx context.Context, userID string, secret []byte) error
+	EnableMFA(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	DeleteMFA(ctx context.Context, userID string) error
+
+	CreateMFAChallenge(ctx context.Context, c *model.MFAChallenge) error
+	GetMFAChallenge(ctx context.Context, tokenHash string) (*model.MFAChallenge, error)
+	RecordMFAChallengeFailure(ctx context.Context, id string) (int, error)
+	CompleteMFAChallenge(ctx context.Context, id string) (bool, error)
+}
+
+// Хранит настройки второго фактора, коды восстановления и незавершённые входы
+type PostgresMFARepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ MFARepository = (*PostgresMFARepository)(nil)
+
+func NewPostgresMFARepository(db *sql.DB, logger *zap.Logger) *PostgresMFARepository {
+	return &PostgresMFARepository{db: db, logger: logger}
+}
+
+func (r *PostgresMFARepository) GetMFA(ctx context.Context, userID string) (*model.MFA, error) {
+	query := `SELECT user_id, secret, enabled_at, last_used_step, created_at FROM user_mfa WHERE user_id = $1`
+	var m model.MFA
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&m.UserID, &m.Secret, &m.EnabledAt, &m.LastUsedStep, &m.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMFANotFound
+		}
+		r.logger.Error("failed to get mfa", zap.Error(err))
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Сохраняет секрет для подключения. Неподтверждённый секрет заменяется,
+// включённый второй фактор не трогается — возвращается ErrMFAAlreadyEnabled
+func (r *PostgresMFARepository) SaveMFASecret(ctx context.Context, userID string, secret []byte) error {
+	query := `INSERT INTO user_mfa (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, last_used_step = 0, created_at = now()
+		WHERE user_mfa.enabled_at IS NULL`
+	res, err := r.db.ExecContext(ctx, query, userID, secret)
+	if err != nil {
+		r.logger.Error("failed to save mfa secret", zap.Error(err))
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+// Включает второй фактор и заменяет коды восстановления в одной транзакции
+func (r *PostgresMFARepository) EnableMFA(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE user_mfa SET enabled_at = now(), last_used_step = $2 WHERE user_id = $1 AND enabled_at IS NULL`, userID, step)
+	if err != nil {
+		r.logger.Error("failed to enable mfa", zap.Error(err))
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFAAlreadyEnabled
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		r.logger.Error("failed to delete recovery codes", zap.Error(err))
+		return err
+	}
+	for _, h := range recoveryCodeHashes {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)`, userID, h); err != nil {
+			r.logger.Error("failed to insert recovery code", zap.Error(err))
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Запоминает шаг принятого TOTP-кода. false — код этого или более позднего шага
+// уже использован, то есть код предъявлен повторно
+func (r *PostgresMFARepository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE user_mfa SET last_used_step = $2 WHERE user_id = $1 AND last_used_step < $2`, userID, step)
+	if err != nil {
+		r.logger.Error("failed to use totp step", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Гасит код восстановления; false — такого неиспользованного кода нет
+func (r *PostgresMFARepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE mfa_recovery_codes SET used_at = now() WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`, userID, codeHash)
+	if err != nil {
+		r.logger.Error("failed to use recovery code", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Отключает второй фактор: удаляет секрет и коды восстановления
+func (r *PostgresMFARepository) DeleteMFA(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM mfa_recovery_codes WHERE user_id = $1`, userID); err != nil {
+		r.logger.Error("failed to delete recovery codes", zap.Error(err))
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_mfa WHERE user_id = $1`, userID); err != nil {
+		r.logger.Error("failed to delete mfa", zap.Error(err))
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresMFARepository) CreateMFAChallenge(ctx context.Context, c *model.MFAChallenge) error {
+	query := `INSERT INTO mfa_challenges (user_id, token_hash, expires_at) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err := r.db.QueryRowContext(ctx, query, c.UserID, c.TokenHash, c.ExpiresAt.UTC()).Scan(&c.ID, &c.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to create mfa challenge", zap.Error(err))
+	}
+	return err
+}
+
+// Действующий вызов по хешу токена
+func (r *PostgresMFARepository) GetMFAChallenge(ctx context.Context, tokenHash string) (*model.MFAChallenge, error) {
+	query := `SELECT id, user_id, token_hash, attempts, expires_at, created_at, used_at FROM mfa_challenges
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > now()`
+	var c model.MFAChallenge
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(&c.ID, &c.UserID, &c.TokenHash, &c.Attempts, &c.ExpiresAt, &c.CreatedAt, &c.UsedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMFAChallengeInvalid
+		}
+		r.logger.Error("failed to get mfa challenge", zap.Error(err))
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Засчитывает неверный код и возвращает число неверных кодов по вызову
+func (r *PostgresMFARepository) RecordMFAChallengeFailure(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.QueryRowContext(ctx, `UPDATE mfa_challenges SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`, id).Scan(&attempts)
+	if err != nil {
+		r.logger.Error("failed to record mfa failure", zap.Error(err))
+		return 0, err
+	}
+	return attempts, nil
+}
+
+// Завершает вызов; false — его уже завершил параллельный запрос
+func (r *PostgresMFARepository) CompleteMFAChallenge(ctx context.Context, id string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE mfa_challenges SET used_at = now() WHERE id = $1 AND used_at IS NULL`, id)
+	if err != nil {
+		r.logger.Error("failed to complete mfa challenge", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+type OutboxRepository interface {
+	ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]model.OutboxEvent, error)
+	MarkProcessed(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error
+}
+
+// Хранит исходящие события в таблице outbox
+type PostgresOutboxRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ OutboxRepository = (*PostgresOutboxRepository)(nil)
+
+func NewPostgresOutboxRepository(db *sql.DB, logger *zap.Logger) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{db: db, logger: logger}
+}
+
+// Забирает до limit готовых к отправке событий. Забранные события откладываются
+// на lease, поэтому другой экземпляр сервиса не отправит их одновременно с этим,
+// а если обработчик упадёт, события вернутся в очередь по истечении lease
+func (r *PostgresOutboxRepository) ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]model.OutboxEvent, error) {
+	query := `UPDATE outbox SET next_attempt_at = now() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE processed_at IS NULL AND next_attempt_at <= now()
+			ORDER BY id LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, topic, payload, attempts, created_at`
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		r.logger.Error("failed to claim outbox events", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []model.OutboxEvent
+	for rows.Next() {
+		var e model.OutboxEvent
+		if err := rows.Scan(&e.ID, &e.Topic, &e.Payload, &e.Attempts, &e.CreatedAt); err != nil {
+			r.logger.Error("failed to scan outbox event", zap.Error(err))
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (r *PostgresOutboxRepository) MarkProcessed(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox SET processed_at = now(), last_error = NULL WHERE id = $1`, id)
+	if err != nil {
+		r.logger.Error("failed to mark outbox event processed", zap.Int64("id", id), zap.Error(err))
+	}
+	return err
+}
+
+// Записывает неудачную попытку; событие будет отправлено снова не раньше retryAt
+func (r *PostgresOutboxRepository) MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	query := `UPDATE outbox SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3 WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id, retryAt.UTC(), reason)
+	if err != nil {
+		r.logger.Error("failed to mark outbox event failed", zap.Int64("id", id), zap.Error(err))
+	}
+	return err
+}
@@ -3,14 +3,24 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib" // драйвер для подключения через pgx
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
 	"go.uber.org/zap"
 )
 
+// Адрес уже занят другим пользователем
+var ErrEmailTaken = errors.New("email is already in use")
+
 // Реализует сохранение пользователей в PostgreSQL
 type PostgresUserRepository struct {
 	db     *sql.DB
@@ -22,15 +32,37 @@ func NewPostgresUserRepository(db *sql.DB, logger *zap.Logger) *PostgresUserRepo
 	return &PostgresUserRepository{db: db, logger: logger}
 }
 
-// Сохраняет нового пользователя в таблицу users
+// Сохраняет нового пользователя в таблицу users и в той же транзакции
+// ставит в outbox событие user.registered для создания профиля в user-service
 func (r *PostgresUserRepository) CreateUser(ctx context.Context, user *model.User) (string, error) {
 	r.logger.Info("CreateUser called", zap.String("email", user.Email))
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return "", err
+	}
+	defer tx.Rollback()
+
 	query := `INSERT INTO users (email, password, name) VALUES ($1, $2, $3) RETURNING id`
-	err := r.db.QueryRowContext(ctx, query, user.Email, user.Password, user.Name).Scan(&user.ID)
+	err = tx.QueryRowContext(ctx, query, user.Email, user.Password, user.Name).Scan(&user.ID)
 	if err != nil {
 		r.logger.Error("failed to insert user", zap.Error(err))
 		return "", err
 	}
+
+	payload, err := json.Marshal(model.UserRegistered{UserID: user.ID, Email: user.Email, Name: user.Name})
+	if err != nil {
+		return "", err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO outbox (topic, payload) VALUES ($1, $2)`, model.TopicUserRegistered, payload)
+	if err != nil {
+		r.logger.Error("failed to enqueue user.registered", zap.Error(err))
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit user", zap.Error(err))
+		return "", err
+	}
 	r.logger.Info("User successfully created", zap.String("userID", user.ID))
 	return user.ID, nil
 }
@@ -57,28 +89,89 @@ func ConnectDB(logger *zap.Logger) (*sql.DB, error) {
 	logger.Info("✅ Успешное подключение к PostgreSQL")
 	return db, nil
 }
-func RunMigrations(db *sql.DB, logger *zap.Logger) error {
-	query := `
-    CREATE EXTENSION IF NOT EXISTS "pgcrypto";
-    CREATE TABLE IF NOT EXISTS users (
-        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-        email TEXT UNIQUE NOT NULL,
-        password TEXT NOT NULL,
-        name TEXT NOT NULL,
-        created_at TIMESTAMP DEFAULT now()
-    );`
-	_, err := db.Exec(query)
-	if err != nil {
+
+// Применяет по порядку миграции *.up.sql из files, которых ещё нет в auth_schema_migrations
+// (база общая с другими сервисами, поэтому таблица версий своя). Базы, созданные
+// до учёта версий, проходят все миграции заново: они идемпотентны
+func RunMigrations(db *sql.DB, files fs.FS, logger *zap.Logger) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS auth_schema_migrations (
+        version TEXT PRIMARY KEY,
+        applied_at TIMESTAMP NOT NULL DEFAULT now()
+    )`); err != nil {
 		logger.Error("Ошибка миграции БД", zap.Error(err))
 		return err
 	}
+	names, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		version := strings.TrimSuffix(name, ".up.sql")
+		var applied bool
+		if err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM auth_schema_migrations WHERE version = $1)`, version).Scan(&applied); err != nil {
+			logger.Error("Ошибка миграции БД", zap.Error(err))
+			return err
+		}
+		if applied {
+			continue
+		}
+		query, err := fs.ReadFile(files, name)
+		if err != nil {
+			return err
+		}
+		if err := applyMigration(db, version, string(query)); err != nil {
+			logger.Error("Ошибка миграции БД", zap.String("version", version), zap.Error(err))
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		logger.Info("Миграция применена", zap.String("version", version))
+	}
 	logger.Info("Миграция БД успешно выполнена")
 	return nil
 }
 
+// Выполняет миграцию и отмечает её применённой в одной транзакции
+func applyMigration(db *sql.DB, version, query string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(query); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO auth_schema_migrations (version) VALUES ($1)`, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *model.User) (string, error)
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByID(ctx context.Context, id string) (*model.User, error)
+	MarkEmailVerified(ctx context.Context, userID, email string) (bool, error)
+	UpdatePassword(ctx context.Context, userID, passwordHash string) error
+	UpdateEmail(ctx context.Context, userID, email string, changedAt time.Time) error
+	ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error)
+	SetUserDisabled(ctx context.Context, userID string, disabled bool) (bool, error)
+	SetUserRole(ctx context.Context, userID, role string) error
+}
+
+// Колонки users в порядке scanUser
+const userColumns = `id, email, password, name, role, email_verified_at, disabled_at, created_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (*model.User, error) {
+	var user model.User
+	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.Role, &user.EmailVerifiedAt, &user.DisabledAt, &user.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
 }
 
 var _ UserRepository = (*PostgresUserRepository)(nil)
@@ -86,15 +179,159 @@ var _ UserRepository = (*PostgresUserRepository)(nil)
 // Получает пользователя по email из таблицы users
 func (r *PostgresUserRepository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	r.logger.Info("GetUserByEmail called", zap.String("email", email))
-	query := `SELECT id, email, password, name FROM users WHERE email = $1`
-	row := r.db.QueryRowContext(ctx, query, email)
-
-	var user model.User
-	err := row.Scan(&user.ID, &user.Email, &user.Password, &user.Name)
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, email))
 	if err != nil {
 		r.logger.Error("failed to get user by email", zap.Error(err))
 		return nil, err
 	}
 	r.logger.Info("User retrieved", zap.String("userID", user.ID))
-	return &user, nil
+	return user, nil
+}
+
+// Отмечает email подтверждённым, если у пользователя всё ещё этот адрес
+func (r *PostgresUserRepository) MarkEmailVerified(ctx context.Context, userID, email string) (bool, error) {
+	r.logger.Info("MarkEmailVerified called", zap.String("userID", userID))
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET email_verified_at = now() WHERE id = $1 AND email = $2`, userID, email)
+	if err != nil {
+		r.logger.Error("failed to mark email verified", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Сохраняет новый хеш пароля
+func (r *PostgresUserRepository) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	r.logger.Info("UpdatePassword called", zap.String("userID", userID))
+	_, err := r.db.ExecContext(ctx, `UPDATE users SET password = $1 WHERE id = $2`, passwordHash, userID)
+	if err != nil {
+		r.logger.Error("failed to update password", zap.Error(err))
+	}
+	return err
+}
+
+// Получает пользователя по ID
+func (r *PostgresUserRepository) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		r.logger.Error("failed to get user by id", zap.Error(err))
+		return nil, err
+	}
+	return user, nil
+}
+
+// Меняет email на подтверждённый адрес и в той же транзакции ставит
+// в outbox событие user.email_changed для профиля в user-service
+func (r *PostgresUserRepository) UpdateEmail(ctx context.Context, userID, email string, changedAt time.Time) error {
+	r.logger.Info("UpdateEmail called", zap.String("userID", userID))
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE users SET email = $1, email_verified_at = $2 WHERE id = $3`, email, changedAt.UTC(), userID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrEmailTaken
+		}
+		r.logger.Error("failed to update email", zap.Error(err))
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	payload, err := json.Marshal(model.UserEmailChanged{UserID: userID, Email: email, ChangedAt: changedAt.UTC()})
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO outbox (topic, payload) VALUES ($1, $2)`, model.TopicUserEmailChanged, payload)
+	if err != nil {
+		r.logger.Error("failed to enqueue user.email_changed", zap.Error(err))
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit email change", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Страница пользователей и их общее число по фильтру. Хеш пароля не выбирается
+func (r *PostgresUserRepository) ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error) {
+	query := `SELECT id, email, '', name, role, email_verified_at, disabled_at, created_at, count(*) OVER ()
+		FROM users
+		WHERE ($1 = '' OR email ILIKE '%' || $1 || '%' OR name ILIKE '%' || $1 || '%') AND ($2 = '' OR role = $2)
+		ORDER BY created_at, id
+		LIMIT $3 OFFSET $4`
+	rows, err := r.db.QueryContext(ctx, query, filter.Query, filter.Role, filter.Limit, filter.Offset)
+	if err != nil {
+		r.logger.Error("failed to list users", zap.Error(err))
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		users []model.User
+		total int
+	)
+	for rows.Next() {
+		var u model.User
+		if err := rows.Scan(&u.ID, &u.Email, &u.Password, &u.Name, &u.Role, &u.EmailVerifiedAt, &u.DisabledAt, &u.CreatedAt, &total); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, u)
+	}
+	return users, total, rows.Err()
+}
+
+// Блокирует или разблокирует пользователя. false — состояние уже было таким
+func (r *PostgresUserRepository) SetUserDisabled(ctx context.Context, userID string, disabled bool) (bool, error) {
+	r.logger.Info("SetUserDisabled called", zap.String("userID", userID), zap.Bool("disabled", disabled))
+	query := `UPDATE users SET disabled_at = now() WHERE id = $1 AND disabled_at IS NULL`
+	if !disabled {
+		query = `UPDATE users SET disabled_at = NULL WHERE id = $1 AND disabled_at IS NOT NULL`
+	}
+	res, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		r.logger.Error("failed to set user disabled", zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return true, nil
+	}
+	if _, err := r.GetUserByID(ctx, userID); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// Меняет роль пользователя
+func (r *PostgresUserRepository) SetUserRole(ctx context.Context, userID, role string) error {
+	r.logger.Info("SetUserRole called", zap.String("userID", userID), zap.String("role", role))
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET role = $1 WHERE id = $2`, role, userID)
+	if err != nil {
+		r.logger.Error("failed to set user role", zap.Error(err))
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
 }
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	// Токен уже обменян или отозван
+	ErrRefreshTokenUsed = errors.New("refresh token already used")
+)
+
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, t *model.RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, usedID string, next *model.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeUserTokens(ctx context.Context, userID string) error
+}
+
+// Хранит refresh-токены в PostgreSQL
+type PostgresRefreshTokenRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ RefreshTokenRepository = (*PostgresRefreshTokenRepository)(nil)
+
+func NewPostgresRefreshTokenRepository(db *sql.DB, logger *zap.Logger) *PostgresRefreshTokenRepository {
+	return &PostgresRefreshTokenRepository{db: db, logger: logger}
+}
+
+// Пустой family_id открывает новое семейство
+const insertRefreshTokenQuery = `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at)
+	VALUES ($1, COALESCE(CAST(NULLIF($2::text, '') AS uuid), gen_random_uuid()), $3, $4)
+	RETURNING id, family_id, created_at`
+
+// Сохраняет новый refresh-токен
+func (r *PostgresRefreshTokenRepository) CreateRefreshToken(ctx context.Context, t *model.RefreshToken) error {
+	r.logger.Info("CreateRefreshToken called", zap.String("userID", t.UserID))
+	err := r.db.QueryRowContext(ctx, insertRefreshTokenQuery, t.UserID, t.FamilyID, t.TokenHash, t.ExpiresAt.UTC()).Scan(&t.ID, &t.FamilyID, &t.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert refresh token", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Ищет refresh-токен по хешу
+func (r *PostgresRefreshTokenRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	query := `SELECT id, user_id, family_id, token_hash, expires_at, created_at, used_at, revoked_at FROM refresh_tokens WHERE token_hash = $1`
+	var t model.RefreshToken
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(&t.ID, &t.UserID, &t.FamilyID, &t.TokenHash, &t.ExpiresAt, &t.CreatedAt, &t.UsedAt, &t.RevokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRefreshTokenNotFound
+		}
+		r.logger.Error("failed to get refresh token", zap.Error(err))
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Помечает токен использованным и сохраняет следующий в одной транзакции.
+// Если токен уже использован (в том числе параллельным запросом), возвращает ErrRefreshTokenUsed
+func (r *PostgresRefreshTokenRepository) RotateRefreshToken(ctx context.Context, usedID string, next *model.RefreshToken) error {
+	r.logger.Info("RotateRefreshToken called", zap.String("tokenID", usedID))
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE refresh_tokens SET used_at = now() WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL`, usedID)
+	if err != nil {
+		r.logger.Error("failed to mark refresh token used", zap.Error(err))
+		return err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return ErrRefreshTokenUsed
+	}
+	err = tx.QueryRowContext(ctx, insertRefreshTokenQuery, next.UserID, next.FamilyID, next.TokenHash, next.ExpiresAt.UTC()).Scan(&next.ID, &next.FamilyID, &next.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert refresh token", zap.Error(err))
+		return err
+	}
+	return tx.Commit()
+}
+
+// Отзывает все токены семейства
+func (r *PostgresRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	r.logger.Warn("RevokeFamily called", zap.String("familyID", familyID))
+	_, err := r.db.ExecContext(ctx, `UPDATE refresh_tokens SET revoked_at = now() WHERE family_id = $1 AND revoked_at IS NULL`, familyID)
+	if err != nil {
+		r.logger.Error("failed to revoke refresh token family", zap.Error(err))
+	}
+	return err
+}
+
+// Отзывает все refresh-токены пользователя, то есть завершает все его сессии
+func (r *PostgresRefreshTokenRepository) RevokeUserTokens(ctx context.Context, userID string) error {
+	r.logger.Warn("RevokeUserTokens called", zap.String("userID", userID))
+	_, err := r.db.ExecContext(ctx, `UPDATE refresh_tokens SET revoked_at = now() WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		r.logger.Error("failed to revoke user refresh tokens", zap.Error(err))
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+type RevokedTokenRepository interface {
+	RevokeToken(ctx context.Context, t *model.RevokedToken) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	ListRevokedSince(ctx context.Context, since time.Time) ([]model.RevokedToken, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Хранит отозванные access-токены в PostgreSQL
+type PostgresRevokedTokenRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ RevokedTokenRepository = (*PostgresRevokedTokenRepository)(nil)
+
+func NewPostgresRevokedTokenRepository(db *sql.DB, logger *zap.Logger) *PostgresRevokedTokenRepository {
+	return &PostgresRevokedTokenRepository{db: db, logger: logger}
+}
+
+// Добавляет токен в список отозванных; повторный отзыв ничего не меняет
+func (r *PostgresRevokedTokenRepository) RevokeToken(ctx context.Context, t *model.RevokedToken) error {
+	r.logger.Info("RevokeToken called", zap.String("jti", t.JTI), zap.String("userID", t.UserID))
+	query := `INSERT INTO revoked_tokens (jti, user_id, expires_at) VALUES ($1, $2, $3) ON CONFLICT (jti) DO NOTHING`
+	_, err := r.db.ExecContext(ctx, query, t.JTI, t.UserID, t.ExpiresAt.UTC())
+	if err != nil {
+		r.logger.Error("failed to revoke token", zap.Error(err))
+	}
+	return err
+}
+
+func (r *PostgresRevokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`, jti).Scan(&revoked)
+	if err != nil {
+		r.logger.Error("failed to check revoked token", zap.Error(err))
+		return false, err
+	}
+	return revoked, nil
+}
+
+// Возвращает ещё не истёкшие токены, отозванные после since
+func (r *PostgresRevokedTokenRepository) ListRevokedSince(ctx context.Context, since time.Time) ([]model.RevokedToken, error) {
+	query := `SELECT jti, user_id, expires_at, revoked_at FROM revoked_tokens WHERE revoked_at > $1 AND expires_at > now() ORDER BY revoked_at`
+	rows, err := r.db.QueryContext(ctx, query, since.UTC())
+	if err != nil {
+		r.logger.Error("failed to list revoked tokens", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []model.RevokedToken
+	for rows.Next() {
+		var t model.RevokedToken
+		if err := rows.Scan(&t.JTI, &t.UserID, &t.ExpiresAt, &t.RevokedAt); err != nil {
+			r.logger.Error("failed to scan revoked token", zap.Error(err))
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, rows.Err()
+}
+
+// Удаляет записи о токенах, срок которых истёк до before
+func (r *PostgresRevokedTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM revoked_tokens WHERE expires_at < $1`, before.UTC())
+	if err != nil {
+		r.logger.Error("failed to delete expired revoked tokens", zap.Error(err))
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+type SigningKeyRepository interface {
+	CreateSigningKey(ctx context.Context, k *model.SigningKey) error
+	ListSigningKeys(ctx context.Context) ([]model.SigningKey, error)
+	DeleteSigningKey(ctx context.Context, kid string) error
+}
+
+// Хранит ключи подписи в PostgreSQL, чтобы все реплики сервиса использовали общий набор
+type PostgresSigningKeyRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+var _ SigningKeyRepository = (*PostgresSigningKeyRepository)(nil)
+
+func NewPostgresSigningKeyRepository(db *sql.DB, logger *zap.Logger) *PostgresSigningKeyRepository {
+	return &PostgresSigningKeyRepository{db: db, logger: logger}
+}
+
+func (r *PostgresSigningKeyRepository) CreateSigningKey(ctx context.Context, k *model.SigningKey) error {
+	r.logger.Info("CreateSigningKey called", zap.String("kid", k.KID), zap.String("alg", k.Algorithm))
+	query := `INSERT INTO signing_keys (kid, algorithm, private_key, created_at) VALUES ($1, $2, $3, $4)`
+	if _, err := r.db.ExecContext(ctx, query, k.KID, k.Algorithm, k.PrivateKey, k.CreatedAt.UTC()); err != nil {
+		r.logger.Error("failed to create signing key", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Возвращает все ключи, от новых к старым
+func (r *PostgresSigningKeyRepository) ListSigningKeys(ctx context.Context) ([]model.SigningKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT kid, algorithm, private_key, created_at FROM signing_keys ORDER BY created_at DESC, kid`)
+	if err != nil {
+		r.logger.Error("failed to list signing keys", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []model.SigningKey
+	for rows.Next() {
+		var k model.SigningKey
+		if err := rows.Scan(&k.KID, &k.Algorithm, &k.PrivateKey, &k.CreatedAt); err != nil {
+			r.logger.Error("failed to scan signing key", zap.Error(err))
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+func (r *PostgresSigningKeyRepository) DeleteSigningKey(ctx context.Context, kid string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM signing_keys WHERE kid = $1`, kid); err != nil {
+		r.logger.Error("failed to delete signing key", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/mailer"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrEmailTaken         = errors.New("email is already in use")
+	ErrEmailUnchanged     = errors.New("new email matches the current one")
+	ErrEmailNotConfigured = errors.New("email delivery is not configured")
+)
+
+// Проверяет access-токен и текущий пароль владельца. Неверный пароль засчитывается
+// как неудачный вход, иначе смену пароля можно использовать для перебора в обход блокировки
+func (s *authService) reauthenticate(ctx context.Context, accessToken, password string) (*model.User, error) {
+	userID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	keys := s.lockoutKeys(ctx, user.Email)
+	if err := s.checkLockout(ctx, keys); err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		s.logger.Warn("re-authentication failed", zap.String("userID", userID))
+		s.recordLoginFailure(ctx, keys)
+		return nil, ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+// Меняет пароль по текущему паролю. Все остальные сессии завершаются:
+// refresh-токены отзываются, а вызывающий получает новую пару токенов
+func (s *authService) ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) (*model.TokenPair, error) {
+	if len(newPassword) < MinPasswordLength {
+		return nil, ErrWeakPassword
+	}
+	user, err := s.reauthenticate(ctx, accessToken, oldPassword)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdatePassword(ctx, user.ID, string(hash)); err != nil {
+		return nil, err
+	}
+	if err := s.tokens.RevokeUserTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if err := s.RevokeToken(ctx, accessToken); err != nil {
+		s.logger.Error("failed to revoke current access token", zap.String("userID", user.ID), zap.Error(err))
+	}
+	s.logger.Info("password changed", zap.String("userID", user.ID))
+	s.notify(ctx, user.Email, "Пароль изменён", fmt.Sprintf("Здравствуйте, %s!\n\nПароль от вашего аккаунта изменён, "+
+		"остальные сеансы завершены. Если это были не вы, восстановите доступ через сброс пароля.", user.Name))
+	return s.IssueTokens(ctx, user.ID)
+}
+
+// Отправляет ссылку подтверждения на новый адрес. Email меняется только
+// после перехода по ней (ConfirmEmailChange), до этого вход — по прежнему адресу
+func (s *authService) ChangeEmail(ctx context.Context, accessToken, password, newEmail string) error {
+	newEmail = strings.TrimSpace(newEmail)
+	if !strings.Contains(newEmail, "@") {
+		return ErrInvalidEmail
+	}
+	if !s.email.enabled() {
+		return ErrEmailNotConfigured
+	}
+	user, err := s.reauthenticate(ctx, accessToken, password)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(newEmail, user.Email) {
+		return ErrEmailUnchanged
+	}
+	if _, err := s.repo.GetUserByEmail(ctx, newEmail); err == nil {
+		return ErrEmailTaken
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	link, err := s.issueEmailToken(ctx, &model.User{ID: user.ID, Email: newEmail}, model.PurposeChangeEmail, s.email.ChangeEmailTTL, s.email.ChangeEmailURL)
+	if err != nil {
+		s.logger.Error("failed to issue change email token", zap.String("userID", user.ID), zap.Error(err))
+		return err
+	}
+	err = s.email.Mailer.Send(ctx, mailer.Message{
+		To:      newEmail,
+		Subject: "Подтверждение нового email",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\nЧтобы сделать этот адрес адресом вашего аккаунта, перейдите по ссылке:\n%s\n\n"+
+			"Ссылка действует %s. Если вы не меняли email, просто проигнорируйте письмо.",
+			user.Name, link, s.email.ChangeEmailTTL),
+	})
+	if err != nil {
+		s.logger.Error("failed to send change email confirmation", zap.String("userID", user.ID), zap.Error(err))
+		return err
+	}
+	s.logger.Info("email change requested", zap.String("userID", user.ID))
+	return nil
+}
+
+// Меняет email на адрес из письма и возвращает его. Профиль в user-service
+// обновляется по событию из outbox; ссылки сброса пароля, отправленные на прежний адрес, гаснут
+func (s *authService) ConfirmEmailChange(ctx context.Context, token string) (string, error) {
+	if !s.email.enabled() {
+		return "", ErrInvalidToken
+	}
+	t, err := s.email.Tokens.ConsumeEmailToken(ctx, model.PurposeChangeEmail, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repo.ErrEmailTokenInvalid) {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+	user, err := s.repo.GetUserByID(ctx, t.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+	if err := s.repo.UpdateEmail(ctx, t.UserID, t.Email, s.now()); err != nil {
+		if errors.Is(err, repo.ErrEmailTaken) {
+			return "", ErrEmailTaken
+		}
+		return "", err
+	}
+	if err := s.email.Tokens.InvalidateEmailTokens(ctx, t.UserID, model.PurposeResetPassword); err != nil {
+		s.logger.Error("failed to invalidate reset tokens", zap.String("userID", t.UserID), zap.Error(err))
+	}
+	s.logger.Info("email changed", zap.String("userID", t.UserID))
+	s.notify(ctx, user.Email, "Email изменён", fmt.Sprintf("Здравствуйте, %s!\n\nАдрес вашего аккаунта изменён на %s. "+
+		"Если это были не вы, обратитесь в поддержку.", user.Name, t.Email))
+	return t.Email, nil
+}
+
+// Письмо-уведомление об изменении аккаунта; ошибка отправки только логируется
+func (s *authService) notify(ctx context.Context, to, subject, body string) {
+	if !s.email.enabled() {
+		return
+	}
+	if err := s.email.Mailer.Send(ctx, mailer.Message{To: to, Subject: subject, Body: body}); err != nil {
+		s.logger.Error("failed to send notification", zap.String("subject", subject), zap.Error(err))
+	}
+}
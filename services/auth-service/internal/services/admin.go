@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidRole      = errors.New("unknown role")
+	// Администратор не может заблокировать себя или снять с себя роль
+	ErrSelfModification = errors.New("cannot modify own account")
+)
+
+// Размеры страниц административных списков
+const (
+	DefaultAdminPageSize = 50
+	MaxAdminPageSize     = 500
+)
+
+// Проверяет право вызывающего по роли из базы, а не из токена:
+// снятая роль или блокировка действуют сразу, не дожидаясь истечения access-токена
+func (s *authService) authorize(ctx context.Context, callerID, perm string) (*model.User, error) {
+	if callerID == "" {
+		return nil, ErrPermissionDenied
+	}
+	caller, err := s.repo.GetUserByID(ctx, callerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPermissionDenied
+		}
+		return nil, err
+	}
+	if caller.DisabledAt != nil || !model.HasPermission(caller.Role, perm) {
+		s.logger.Warn("admin operation denied", zap.String("callerID", callerID), zap.String("permission", perm))
+		return nil, ErrPermissionDenied
+	}
+	return caller, nil
+}
+
+func pageSize(limit int) int {
+	if limit <= 0 {
+		return DefaultAdminPageSize
+	}
+	if limit > MaxAdminPageSize {
+		return MaxAdminPageSize
+	}
+	return limit
+}
+
+// Список пользователей для поддержки и администраторов
+func (s *authService) ListUsers(ctx context.Context, callerID string, filter model.UserFilter) ([]model.User, int, error) {
+	if _, err := s.authorize(ctx, callerID, model.PermUsersRead); err != nil {
+		return nil, 0, err
+	}
+	if filter.Role != "" && !model.ValidRole(filter.Role) {
+		return nil, 0, ErrInvalidRole
+	}
+	filter.Limit = pageSize(filter.Limit)
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return s.repo.ListUsers(ctx, filter)
+}
+
+// Блокирует или разблокирует пользователя. При блокировке отзываются его refresh-токены,
+// уже выданные access-токены доживают свой короткий срок. false — состояние не изменилось
+func (s *authService) SetUserDisabled(ctx context.Context, callerID, userID string, disabled bool) (bool, error) {
+	caller, err := s.authorize(ctx, callerID, model.PermUsersManage)
+	if err != nil {
+		return false, err
+	}
+	if callerID == userID {
+		return false, ErrSelfModification
+	}
+	changed, err := s.repo.SetUserDisabled(ctx, userID, disabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, ErrUserNotFound
+		}
+		return false, err
+	}
+	if changed && disabled {
+		if err := s.tokens.RevokeUserTokens(ctx, userID); err != nil {
+			return false, err
+		}
+	}
+	action := "user.enable"
+	if disabled {
+		action = "user.disable"
+	}
+	s.writeAudit(ctx, caller, action, userID, fmt.Sprintf("changed=%t", changed))
+	return changed, nil
+}
+
+// Назначает пользователю роль. Новая роль попадает в токены при следующем обновлении
+func (s *authService) SetUserRole(ctx context.Context, callerID, userID, role string) error {
+	caller, err := s.authorize(ctx, callerID, model.PermUsersManage)
+	if err != nil {
+		return err
+	}
+	if !model.ValidRole(role) {
+		return ErrInvalidRole
+	}
+	if callerID == userID {
+		return ErrSelfModification
+	}
+	if err := s.repo.SetUserRole(ctx, userID, role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	s.writeAudit(ctx, caller, "user.set_role", userID, "role="+role)
+	return nil
+}
+
+// Снимает блокировку входа и обнуляет счётчик неудач аккаунта.
+// false — неудачных попыток по аккаунту не было
+func (s *authService) UnlockAccount(ctx context.Context, callerID, userID string) (bool, error) {
+	caller, err := s.authorize(ctx, callerID, model.PermUsersManage)
+	if err != nil {
+		return false, err
+	}
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, ErrUserNotFound
+		}
+		return false, err
+	}
+	unlocked := false
+	if s.lockout.Attempts != nil {
+		unlocked, err = s.lockout.Attempts.ResetLoginAttempts(ctx, accountKey(user.Email))
+		if err != nil {
+			return false, err
+		}
+	}
+	s.writeAudit(ctx, caller, "user.unlock", userID, fmt.Sprintf("unlocked=%t", unlocked))
+	return unlocked, nil
+}
+
+// Записывает обращение к административному API. Записать может любой,
+// у кого есть хотя бы чтение пользователей: без записи шлюз запрос не выполняет
+func (s *authService) RecordAudit(ctx context.Context, callerID string, e *model.AuditEvent) error {
+	caller, err := s.authorize(ctx, callerID, model.PermUsersRead)
+	if err != nil {
+		return err
+	}
+	e.ActorID = caller.ID
+	e.ActorRole = caller.Role
+	return s.audit.CreateAuditEvent(ctx, e)
+}
+
+// Журнал действий, от новых записей к старым
+func (s *authService) ListAudit(ctx context.Context, callerID string, filter model.AuditFilter) ([]model.AuditEvent, error) {
+	if _, err := s.authorize(ctx, callerID, model.PermAuditRead); err != nil {
+		return nil, err
+	}
+	filter.Limit = pageSize(filter.Limit)
+	if filter.Before.IsZero() {
+		filter.Before = s.now()
+	}
+	return s.audit.ListAuditEvents(ctx, filter)
+}
+
+// Изменение уже применено, поэтому ошибка записи в журнал только логируется
+func (s *authService) writeAudit(ctx context.Context, caller *model.User, action, targetID, details string) {
+	e := &model.AuditEvent{
+		ActorID:      caller.ID,
+		ActorRole:    caller.Role,
+		Action:       action,
+		TargetUserID: targetID,
+		ClientIP:     clientIP(ctx),
+		Details:      details,
+	}
+	if err := s.audit.CreateAuditEvent(ctx, e); err != nil {
+		s.logger.Error("failed to write audit event", zap.String("action", action), zap.Error(err))
+	}
+}
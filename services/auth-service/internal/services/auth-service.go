@@ -2,11 +2,13 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
 	"go.uber.org/zap"
@@ -19,31 +21,95 @@ var (
 	ErrInvalidEmail       = errors.New("invalid email format")
 	ErrUserNotFound       = errors.New("user not found")
 	ErrInvalidToken       = errors.New("invalid token")
+	// Повторное использование refresh-токена: всё семейство отозвано
+	ErrTokenReused = errors.New("refresh token reuse detected")
+	// Аккаунт заблокирован администратором
+	ErrAccountDisabled = errors.New("account disabled")
 )
 
+// Сроки жизни токенов по умолчанию
+const (
+	DefaultAccessTTL  = 15 * time.Minute
+	DefaultRefreshTTL = 30 * 24 * time.Hour
+)
+
+// Сроки жизни токенов; нулевые значения заменяются значениями по умолчанию
+type TokenTTL struct {
+	Access  time.Duration
+	Refresh time.Duration
+}
+
 // Бизнес-логика аутентификации
 type AuthService interface {
 	Register(ctx context.Context, email, password, name string) (string, error)
 	Login(ctx context.Context, email, password string) (string, error)
 	VerifyToken(ctx context.Context, token string) (string, error)
+	IssueTokens(ctx context.Context, userID string) (*model.TokenPair, error)
+	RefreshTokens(ctx context.Context, refreshToken string) (*model.TokenPair, error)
+	RevokeToken(ctx context.Context, accessToken string) error
+	Logout(ctx context.Context, accessToken, refreshToken string) error
+	ListRevoked(ctx context.Context, since time.Time) ([]model.RevokedToken, error)
+	PublicKeys() []keys.JWK
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	UnlockAccount(ctx context.Context, callerID, userID string) (bool, error)
+	StartMFAChallenge(ctx context.Context, userID string) (string, time.Time, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string) (string, error)
+	EnrollMFA(ctx context.Context, accessToken string) (*model.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, accessToken, code string) ([]string, error)
+	DisableMFA(ctx context.Context, accessToken, code string) error
+	ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) (*model.TokenPair, error)
+	ChangeEmail(ctx context.Context, accessToken, password, newEmail string) error
+	ConfirmEmailChange(ctx context.Context, token string) (string, error)
+	ListUsers(ctx context.Context, callerID string, filter model.UserFilter) ([]model.User, int, error)
+	SetUserDisabled(ctx context.Context, callerID, userID string, disabled bool) (bool, error)
+	SetUserRole(ctx context.Context, callerID, userID, role string) error
+	RecordAudit(ctx context.Context, callerID string, e *model.AuditEvent) error
+	ListAudit(ctx context.Context, callerID string, filter model.AuditFilter) ([]model.AuditEvent, error)
 }
 
 type authService struct {
-	repo      repo.UserRepository
-	jwtSecret string
-	logger    *zap.Logger
+	repo    repo.UserRepository
+	tokens  repo.RefreshTokenRepository
+	revoked repo.RevokedTokenRepository
+	audit   repo.AuditRepository
+	email   EmailConfig
+	lockout LockoutConfig
+	mfa     MFAConfig
+	keys    keys.Provider
+	ttl     TokenTTL
+	logger  *zap.Logger
+	now     func() time.Time
 }
 
 // Новый экземпляр сервиса аутентификации
-func NewAuthService(r repo.UserRepository, secret string, logger *zap.Logger) AuthService {
-	if secret == "" {
-		logger.Fatal("JWT_SECRET is required")
+func NewAuthService(r repo.UserRepository, tokens repo.RefreshTokenRepository, revoked repo.RevokedTokenRepository, audit repo.AuditRepository, email EmailConfig, lockout LockoutConfig, mfa MFAConfig, signing keys.Provider, ttl TokenTTL, logger *zap.Logger) AuthService {
+	if signing == nil {
+		logger.Fatal("signing keys are required")
 		return nil
 	}
+	if ttl.Access <= 0 {
+		ttl.Access = DefaultAccessTTL
+	}
+	if ttl.Refresh <= 0 {
+		ttl.Refresh = DefaultRefreshTTL
+	}
+	email.setDefaults()
+	lockout.setDefaults()
+	mfa.setDefaults()
 	return &authService{
-		repo:      r,
-		jwtSecret: secret,
-		logger:    logger,
+		repo:    r,
+		tokens:  tokens,
+		revoked: revoked,
+		audit:   audit,
+		email:   email,
+		lockout: lockout,
+		mfa:     mfa,
+		keys:    signing,
+		ttl:     ttl,
+		logger:  logger,
+		now:     time.Now,
 	}
 }
 
@@ -69,62 +135,144 @@ func (s *authService) Register(ctx context.Context, email, password, name string
 		return "", err
 	}
 	s.logger.Info("user created", zap.String("email", email))
+	s.sendVerification(ctx, user)
 	return id, nil
 }
 
-// Создаёт JWT-токен с userID, живёт 72 часа
-func (s *authService) generateJWT(userID string) (string, error) {
+// Создаёт access-токен с userID и ролью на срок ttl.Access.
+// Токен подписывается текущим ключом набора, kid в заголовке указывает на ключ в JWKS
+func (s *authService) generateJWT(userID, role string) (string, time.Time, error) {
 	s.logger.Info("Generating JWT", zap.String("userID", userID))
-	s.logger.Info("JWT Secret at generateJWT", zap.String("secret", s.jwtSecret))
+	key, err := s.keys.Current()
+	if err != nil {
+		s.logger.Error("no signing key", zap.Error(err))
+		return "", time.Time{}, err
+	}
+	jti, err := randomToken(16)
+	if err != nil {
+		s.logger.Error("failed to generate jti", zap.Error(err))
+		return "", time.Time{}, err
+	}
+	expiresAt := s.now().Add(s.ttl.Access)
 	claims := jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour * 72).Unix(), // 72 часа действия токена
+		"role":    role, // По роли шлюз проверяет права на маршруты
+		"jti":     jti,  // По jti токен можно отозвать до истечения срока
+		"exp":     expiresAt.Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(s.jwtSecret))
+	token := jwt.NewWithClaims(key.Method(), claims)
+	token.Header["kid"] = key.ID
+	signedToken, err := token.SignedString(key.Private)
 	if err != nil {
 		s.logger.Error("failed to sign JWT", zap.Error(err))
-		return "", err
+		return "", time.Time{}, err
 	}
-	return signedToken, nil
+	return signedToken, expiresAt, nil
 }
 
-// Проверяет email и пароль, возвращает userID или ошибку
+// Проверяет email и пароль, возвращает userID или ошибку.
+// После серии неудач по аккаунту или IP клиента (см. WithClientIP) вход временно
+// закрывается: возвращается LockedError, пароль при этом не проверяется
 func (s *authService) Login(ctx context.Context, email, password string) (string, error) {
 	s.logger.Info("Login called", zap.String("email", email))
+	keys := s.lockoutKeys(ctx, email)
+	if err := s.checkLockout(ctx, keys); err != nil {
+		return "", err
+	}
 	user, err := s.repo.GetUserByEmail(ctx, email)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			s.recordLoginFailure(ctx, keys)
+			return "", ErrInvalidCredentials
+		}
 		return "", err
 	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		s.logger.Error("invalid credentials", zap.Error(err))
+		s.recordLoginFailure(ctx, keys)
 		return "", ErrInvalidCredentials
 	}
+	if user.DisabledAt != nil {
+		s.logger.Warn("disabled user tried to log in", zap.String("userID", user.ID))
+		return "", ErrAccountDisabled
+	}
+	// Со вторым фактором счётчик сбрасывается только после верного кода (VerifyMFA)
+	enabled, err := s.mfaEnabled(ctx, user.ID)
+	if err != nil {
+		return "", err
+	}
+	if !enabled {
+		s.resetLoginFailures(ctx, email)
+	}
 	s.logger.Info("user logged in", zap.String("userID", user.ID))
 	return user.ID, nil
 }
 
-// Проверяет валидность JWT-токена и возвращает userID из токена или ошибку
+// Проверяет валидность JWT-токена и возвращает userID из токена или ошибку.
+// Отозванные токены и токены без jti не принимаются
 func (s *authService) VerifyToken(ctx context.Context, tokenStr string) (string, error) {
 	s.logger.Info("Verifying token")
-	s.logger.Info("VerifyToken called", zap.String("secret", s.jwtSecret), zap.String("token", tokenStr))
+	claims, err := s.parseAccessToken(tokenStr)
+	if err != nil {
+		return "", err
+	}
+	revoked, err := s.revoked.IsRevoked(ctx, claims.JTI)
+	if err != nil {
+		return "", err
+	}
+	if revoked {
+		s.logger.Warn("revoked token presented", zap.String("jti", claims.JTI), zap.String("userID", claims.UserID))
+		return "", ErrInvalidToken
+	}
+	return claims.UserID, nil
+}
+
+// Данные access-токена, нужные сервису
+type accessClaims struct {
+	UserID    string
+	JTI       string
+	ExpiresAt time.Time
+}
+
+// Проверяет подпись и срок access-токена и достаёт из него claims
+func (s *authService) parseAccessToken(tokenStr string) (*accessClaims, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := s.keys.Lookup(kid)
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+		if token.Method.Alg() != key.Algorithm {
 			return nil, errors.New("unexpected signing method")
 		}
-		return []byte(s.jwtSecret), nil
-	})
+		return key.Public(), nil
+	}, jwt.WithValidMethods([]string{keys.AlgEdDSA, keys.AlgRS256}))
 	if err != nil || !token.Valid {
 		s.logger.Error("invalid token", zap.Error(err))
-		return "", ErrInvalidToken
+		return nil, ErrInvalidToken
 	}
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", ErrInvalidToken
+		return nil, ErrInvalidToken
 	}
 	userID, ok := claims["user_id"].(string)
 	if !ok {
-		return "", ErrUserNotFound
+		return nil, ErrUserNotFound
+	}
+	jti, _ := claims["jti"].(string)
+	exp, err := claims.GetExpirationTime()
+	if jti == "" || err != nil || exp == nil {
+		return nil, ErrInvalidToken
+	}
+	return &accessClaims{UserID: userID, JTI: jti, ExpiresAt: exp.Time}, nil
+}
+
+// Открытые ключи для проверки access-токенов
+func (s *authService) PublicKeys() []keys.JWK {
+	all := s.keys.Keys()
+	jwks := make([]keys.JWK, 0, len(all))
+	for _, k := range all {
+		jwks = append(jwks, k.JWK())
 	}
-	return userID, nil
+	return jwks
 }
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/mailer"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Сроки действия ссылок из писем по умолчанию
+const (
+	DefaultVerifyEmailTTL   = 24 * time.Hour
+	DefaultResetPasswordTTL = time.Hour
+	DefaultChangeEmailTTL   = 24 * time.Hour
+)
+
+// Минимальная длина нового пароля при сбросе
+const MinPasswordLength = 8
+
+var ErrWeakPassword = fmt.Errorf("password must be at least %d characters", MinPasswordLength)
+
+// Письма подтверждения email и сброса пароля. Без Tokens и Mailer письма не отправляются,
+// а токены из писем не принимаются
+type EmailConfig struct {
+	Tokens repo.EmailTokenRepository
+	Mailer mailer.Mailer
+	// Адреса страниц, на которые ведут ссылки из писем; токен добавляется параметром token
+	VerifyURL      string
+	ResetURL       string
+	ChangeEmailURL string
+	VerifyTTL      time.Duration
+	ResetTTL       time.Duration
+	ChangeEmailTTL time.Duration
+}
+
+func (c *EmailConfig) setDefaults() {
+	if c.VerifyTTL <= 0 {
+		c.VerifyTTL = DefaultVerifyEmailTTL
+	}
+	if c.ResetTTL <= 0 {
+		c.ResetTTL = DefaultResetPasswordTTL
+	}
+	if c.ChangeEmailTTL <= 0 {
+		c.ChangeEmailTTL = DefaultChangeEmailTTL
+	}
+}
+
+func (c *EmailConfig) enabled() bool {
+	return c.Tokens != nil && c.Mailer != nil
+}
+
+// Отправляет письмо со ссылкой подтверждения. Ошибка не мешает регистрации:
+// пользователь может запросить сброс пароля, который тоже подтверждает адрес
+func (s *authService) sendVerification(ctx context.Context, user *model.User) {
+	if !s.email.enabled() {
+		s.logger.Warn("email verification is not configured", zap.String("userID", user.ID))
+		return
+	}
+	link, err := s.issueEmailToken(ctx, user, model.PurposeVerifyEmail, s.email.VerifyTTL, s.email.VerifyURL)
+	if err != nil {
+		s.logger.Error("failed to issue verification token", zap.String("userID", user.ID), zap.Error(err))
+		return
+	}
+	err = s.email.Mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\nЧтобы подтвердить адрес, перейдите по ссылке:\n%s\n\nСсылка действует %s.",
+			user.Name, link, s.email.VerifyTTL),
+	})
+	if err != nil {
+		s.logger.Error("failed to send verification email", zap.String("userID", user.ID), zap.Error(err))
+	}
+}
+
+// Выпускает новый токен вместо прежних с тем же назначением и возвращает ссылку с ним
+func (s *authService) issueEmailToken(ctx context.Context, user *model.User, purpose string, ttl time.Duration, baseURL string) (string, error) {
+	raw, err := randomToken(32)
+	if err != nil {
+		return "", err
+	}
+	if err := s.email.Tokens.InvalidateEmailTokens(ctx, user.ID, purpose); err != nil {
+		return "", err
+	}
+	err = s.email.Tokens.CreateEmailToken(ctx, &model.EmailToken{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Purpose:   purpose,
+		TokenHash: hashToken(raw),
+		ExpiresAt: s.now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return tokenLink(baseURL, raw)
+}
+
+func tokenLink(baseURL, token string) (string, error) {
+	if baseURL == "" {
+		return token, nil
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Подтверждает email по токену из письма
+func (s *authService) VerifyEmail(ctx context.Context, token string) error {
+	if !s.email.enabled() {
+		return ErrInvalidToken
+	}
+	t, err := s.email.Tokens.ConsumeEmailToken(ctx, model.PurposeVerifyEmail, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repo.ErrEmailTokenInvalid) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+	verified, err := s.repo.MarkEmailVerified(ctx, t.UserID, t.Email)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		// Адрес сменился после отправки письма
+		s.logger.Warn("verification token for outdated email", zap.String("userID", t.UserID))
+		return ErrInvalidToken
+	}
+	s.logger.Info("email verified", zap.String("userID", t.UserID))
+	return nil
+}
+
+// Отправляет письмо для сброса пароля. Для незарегистрированного адреса
+// ничего не делает и не сообщает об этом, чтобы по ответу нельзя было проверить email
+func (s *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	s.logger.Info("RequestPasswordReset called")
+	if !s.email.enabled() {
+		s.logger.Warn("password reset is not configured")
+		return nil
+	}
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	link, err := s.issueEmailToken(ctx, user, model.PurposeResetPassword, s.email.ResetTTL, s.email.ResetURL)
+	if err != nil {
+		s.logger.Error("failed to issue reset token", zap.String("userID", user.ID), zap.Error(err))
+		return err
+	}
+	err = s.email.Mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Здравствуйте, %s!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\n"+
+			"Ссылка действует %s. Если вы не запрашивали сброс, просто проигнорируйте письмо.",
+			user.Name, link, s.email.ResetTTL),
+	})
+	if err != nil {
+		s.logger.Error("failed to send reset email", zap.String("userID", user.ID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Задаёт новый пароль по токену из письма и завершает все сессии пользователя.
+// Письмо пришло на адрес пользователя, поэтому email заодно считается подтверждённым
+func (s *authService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if len(newPassword) < MinPasswordLength {
+		return ErrWeakPassword
+	}
+	if !s.email.enabled() {
+		return ErrInvalidToken
+	}
+	t, err := s.email.Tokens.ConsumeEmailToken(ctx, model.PurposeResetPassword, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repo.ErrEmailTokenInvalid) {
+			return ErrInvalidToken
+		}
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.UpdatePassword(ctx, t.UserID, string(hash)); err != nil {
+		return err
+	}
+	if err := s.tokens.RevokeUserTokens(ctx, t.UserID); err != nil {
+		return err
+	}
+	if _, err := s.repo.MarkEmailVerified(ctx, t.UserID, t.Email); err != nil {
+		s.logger.Error("failed to mark email verified", zap.String("userID", t.UserID), zap.Error(err))
+	}
+	s.logger.Info("password reset", zap.String("userID", t.UserID))
+	return nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Вход заблокирован после серии неудачных попыток
+var ErrTooManyAttempts = errors.New("too many failed login attempts")
+
+// Ошибка блокировки входа со временем, через которое можно повторить попытку
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LockedError) Unwrap() error { return ErrTooManyAttempts }
+
+// Правила блокировки: первые FreeAttempts неудач не ограничиваются, после каждой
+// следующей вход закрывается на BaseDelay, 2·BaseDelay, 4·BaseDelay … но не больше MaxDelay.
+// Счёт начинается заново, если неудач не было дольше Window
+type LockoutPolicy struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Window       time.Duration
+}
+
+// Правила по умолчанию. С одного IP пробуют много аккаунтов, поэтому лимит выше
+var (
+	DefaultAccountLockout = LockoutPolicy{FreeAttempts: 5, BaseDelay: 30 * time.Second, MaxDelay: time.Hour, Window: 24 * time.Hour}
+	DefaultIPLockout      = LockoutPolicy{FreeAttempts: 20, BaseDelay: 30 * time.Second, MaxDelay: time.Hour, Window: time.Hour}
+)
+
+// Защита входа от перебора. Без Attempts попытки не ограничиваются
+type LockoutConfig struct {
+	Attempts repo.LoginAttemptRepository
+	Account  LockoutPolicy
+	IP       LockoutPolicy
+}
+
+func (c *LockoutConfig) setDefaults() {
+	if c.Account.FreeAttempts <= 0 {
+		c.Account = DefaultAccountLockout
+	}
+	if c.IP.FreeAttempts <= 0 {
+		c.IP = DefaultIPLockout
+	}
+}
+
+// Задержка после failures-й подряд неудачи; 0 — без блокировки
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	over := failures - p.FreeAttempts
+	if over <= 0 {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := 1; i < over; i++ {
+		d *= 2
+		if d >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return min(d, p.MaxDelay)
+}
+
+type clientIPKey struct{}
+
+// Добавляет в контекст IP клиента, от которого пришёл запрос на вход
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+func clientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// Ключи счётчиков для попытки входа и правила для каждого
+func (s *authService) lockoutKeys(ctx context.Context, email string) map[string]LockoutPolicy {
+	keys := map[string]LockoutPolicy{accountKey(email): s.lockout.Account}
+	if ip := clientIP(ctx); ip != "" {
+		keys["ip:"+ip] = s.lockout.IP
+	}
+	return keys
+}
+
+// Возвращает LockedError, если вход по одному из ключей сейчас закрыт
+func (s *authService) checkLockout(ctx context.Context, keys map[string]LockoutPolicy) error {
+	if s.lockout.Attempts == nil {
+		return nil
+	}
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		names = append(names, k)
+	}
+	attempts, err := s.lockout.Attempts.GetLoginAttempts(ctx, names)
+	if err != nil {
+		return err
+	}
+	var wait time.Duration
+	now := s.now()
+	for _, a := range attempts {
+		if a.LockedUntil != nil && a.LockedUntil.After(now) {
+			wait = max(wait, a.LockedUntil.Sub(now))
+		}
+	}
+	if wait > 0 {
+		s.logger.Warn("login locked", zap.Strings("keys", names), zap.Duration("retryAfter", wait))
+		return &LockedError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// Засчитывает неудачный вход и при превышении лимита закрывает вход по ключу
+func (s *authService) recordLoginFailure(ctx context.Context, keys map[string]LockoutPolicy) {
+	if s.lockout.Attempts == nil {
+		return
+	}
+	for key, policy := range keys {
+		a, err := s.lockout.Attempts.RecordLoginFailure(ctx, key, s.now(), policy.Window)
+		if err != nil {
+			s.logger.Error("failed to record login failure", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		if delay := policy.Delay(a.Failures); delay > 0 {
+			s.logger.Warn("login lockout", zap.String("key", key), zap.Int("failures", a.Failures), zap.Duration("delay", delay))
+			if err := s.lockout.Attempts.LockLogin(ctx, key, s.now().Add(delay)); err != nil {
+				s.logger.Error("failed to lock login", zap.String("key", key), zap.Error(err))
+			}
+		}
+	}
+}
+
+// После успешного входа счётчик аккаунта обнуляется. Счётчик IP остаётся:
+// иначе перебор чужих паролей можно разбавлять входами в свой аккаунт
+func (s *authService) resetLoginFailures(ctx context.Context, email string) {
+	if s.lockout.Attempts == nil {
+		return
+	}
+	if _, err := s.lockout.Attempts.ResetLoginAttempts(ctx, accountKey(email)); err != nil {
+		s.logger.Error("failed to reset login attempts", zap.Error(err))
+	}
+}
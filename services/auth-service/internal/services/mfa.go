@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/crypt"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/totp"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrMFANotConfigured  = errors.New("two-factor authentication is not configured")
+	ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidMFACode    = errors.New("invalid two-factor code")
+)
+
+// Значения по умолчанию для второго фактора
+const (
+	DefaultMFAIssuer       = "Finplan"
+	DefaultMFAChallengeTTL = 5 * time.Minute
+	// Неверных кодов на один вход; дальше нужно заново ввести пароль
+	DefaultMFAMaxAttempts = 5
+	RecoveryCodeCount     = 10
+)
+
+// Второй фактор (TOTP). Без Store и Sealer вход выполняется только по паролю
+type MFAConfig struct {
+	Store        repo.MFARepository
+	Sealer       *crypt.Sealer // Шифрует секреты TOTP в базе
+	Issuer       string        // Название сервиса в приложении-аутентификаторе
+	ChallengeTTL time.Duration
+	MaxAttempts  int
+}
+
+func (c *MFAConfig) setDefaults() {
+	if c.Issuer == "" {
+		c.Issuer = DefaultMFAIssuer
+	}
+	if c.ChallengeTTL <= 0 {
+		c.ChallengeTTL = DefaultMFAChallengeTTL
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = DefaultMFAMaxAttempts
+	}
+}
+
+func (c *MFAConfig) enabled() bool {
+	return c.Store != nil && c.Sealer != nil
+}
+
+// Если у пользователя включён второй фактор, открывает вход, ожидающий кода,
+// и возвращает его токен. Пустой токен — второй фактор не нужен
+func (s *authService) StartMFAChallenge(ctx context.Context, userID string) (string, time.Time, error) {
+	enabled, err := s.mfaEnabled(ctx, userID)
+	if err != nil || !enabled {
+		return "", time.Time{}, err
+	}
+	raw, err := randomToken(32)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := s.now().Add(s.mfa.ChallengeTTL)
+	err = s.mfa.Store.CreateMFAChallenge(ctx, &model.MFAChallenge{
+		UserID:    userID,
+		TokenHash: hashToken(raw),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	s.logger.Info("mfa challenge started", zap.String("userID", userID))
+	return raw, expiresAt, nil
+}
+
+// Завершает вход кодом из приложения или кодом восстановления и возвращает userID.
+// После MaxAttempts неверных кодов токен входа перестаёт действовать
+func (s *authService) VerifyMFA(ctx context.Context, mfaToken, code string) (string, error) {
+	if !s.mfa.enabled() {
+		return "", ErrMFANotConfigured
+	}
+	ch, err := s.mfa.Store.GetMFAChallenge(ctx, hashToken(mfaToken))
+	if err != nil {
+		if errors.Is(err, repo.ErrMFAChallengeInvalid) {
+			return "", ErrInvalidToken
+		}
+		return "", err
+	}
+	if ch.Attempts >= s.mfa.MaxAttempts {
+		return "", ErrInvalidToken
+	}
+	// Неверные коды считаются в общий лимит неудачных входов аккаунта:
+	// иначе код можно подбирать, каждый раз заново вводя известный пароль
+	user, err := s.repo.GetUserByID(ctx, ch.UserID)
+	if err != nil {
+		return "", err
+	}
+	keys := s.lockoutKeys(ctx, user.Email)
+	if err := s.checkLockout(ctx, keys); err != nil {
+		return "", err
+	}
+	m, err := s.mfa.Store.GetMFA(ctx, ch.UserID)
+	if err != nil && !errors.Is(err, repo.ErrMFANotFound) {
+		return "", err
+	}
+	if !m.Enabled() {
+		// Второй фактор отключили, пока вход ждал кода
+		return "", ErrInvalidToken
+	}
+	ok, err := s.checkMFACode(ctx, m, code)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		if _, err := s.mfa.Store.RecordMFAChallengeFailure(ctx, ch.ID); err != nil {
+			return "", err
+		}
+		s.recordLoginFailure(ctx, keys)
+		s.logger.Warn("invalid mfa code", zap.String("userID", ch.UserID))
+		return "", ErrInvalidMFACode
+	}
+	completed, err := s.mfa.Store.CompleteMFAChallenge(ctx, ch.ID)
+	if err != nil {
+		return "", err
+	}
+	if !completed {
+		return "", ErrInvalidToken
+	}
+	s.resetLoginFailures(ctx, user.Email)
+	s.logger.Info("mfa login completed", zap.String("userID", ch.UserID))
+	return ch.UserID, nil
+}
+
+// Включён ли у пользователя второй фактор
+func (s *authService) mfaEnabled(ctx context.Context, userID string) (bool, error) {
+	if !s.mfa.enabled() {
+		return false, nil
+	}
+	m, err := s.mfa.Store.GetMFA(ctx, userID)
+	if err != nil && !errors.Is(err, repo.ErrMFANotFound) {
+		return false, err
+	}
+	return m.Enabled(), nil
+}
+
+// Принимает TOTP-код (каждый не больше одного раза) или неиспользованный код восстановления
+func (s *authService) checkMFACode(ctx context.Context, m *model.MFA, code string) (bool, error) {
+	secret, err := s.mfa.Sealer.Open(m.Secret, []byte(m.UserID))
+	if err != nil {
+		return false, err
+	}
+	if step, ok := totp.Validate(secret, code, s.now()); ok {
+		return s.mfa.Store.UseTOTPStep(ctx, m.UserID, step)
+	}
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, nil
+	}
+	used, err := s.mfa.Store.UseRecoveryCode(ctx, m.UserID, hashToken(normalized))
+	if used {
+		s.logger.Warn("recovery code used", zap.String("userID", m.UserID))
+	}
+	return used, err
+}
+
+// Выпускает новый секрет для приложения-аутентификатора. Второй фактор
+// включится после подтверждения кодом (ConfirmMFA)
+func (s *authService) EnrollMFA(ctx context.Context, accessToken string) (*model.MFAEnrollment, error) {
+	if !s.mfa.enabled() {
+		return nil, ErrMFANotConfigured
+	}
+	userID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := s.mfa.Sealer.Seal(secret, []byte(userID))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfa.Store.SaveMFASecret(ctx, userID, sealed); err != nil {
+		if errors.Is(err, repo.ErrMFAAlreadyEnabled) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, err
+	}
+	s.logger.Info("mfa enrollment started", zap.String("userID", userID))
+	return &model.MFAEnrollment{
+		Secret: totp.EncodeSecret(secret),
+		URI:    totp.URI(s.mfa.Issuer, user.Email, secret),
+	}, nil
+}
+
+// Включает второй фактор по первому коду из приложения и возвращает коды
+// восстановления. Коды показываются один раз, в базе хранятся только их хеши
+func (s *authService) ConfirmMFA(ctx context.Context, accessToken, code string) ([]string, error) {
+	if !s.mfa.enabled() {
+		return nil, ErrMFANotConfigured
+	}
+	userID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.mfa.Store.GetMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrMFANotFound) {
+			return nil, ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if m.Enabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	secret, err := s.mfa.Sealer.Open(m.Secret, []byte(userID))
+	if err != nil {
+		return nil, err
+	}
+	step, ok := totp.Validate(secret, code, s.now())
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		c, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = c
+		hashes[i] = hashToken(normalizeRecoveryCode(c))
+	}
+	if err := s.mfa.Store.EnableMFA(ctx, userID, step, hashes); err != nil {
+		if errors.Is(err, repo.ErrMFAAlreadyEnabled) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, err
+	}
+	s.logger.Info("mfa enabled", zap.String("userID", userID))
+	return codes, nil
+}
+
+// Отключает второй фактор. Нужен действующий код из приложения или код восстановления
+func (s *authService) DisableMFA(ctx context.Context, accessToken, code string) error {
+	if !s.mfa.enabled() {
+		return ErrMFANotConfigured
+	}
+	userID, err := s.VerifyToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	m, err := s.mfa.Store.GetMFA(ctx, userID)
+	if err != nil && !errors.Is(err, repo.ErrMFANotFound) {
+		return err
+	}
+	if !m.Enabled() {
+		return ErrMFANotEnabled
+	}
+	ok, err := s.checkMFACode(ctx, m, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidMFACode
+	}
+	if err := s.mfa.Store.DeleteMFA(ctx, userID); err != nil {
+		return err
+	}
+	s.logger.Info("mfa disabled", zap.String("userID", userID))
+	return nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Код восстановления вида abcd-efgh (40 бит)
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, 5)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	c := strings.ToLower(recoveryEncoding.EncodeToString(buf))
+	return c[:4] + "-" + c[4:], nil
+}
+
+// Код без регистра, пробелов и дефисов; пустая строка — не похоже на код восстановления
+func normalizeRecoveryCode(code string) string {
+	c := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(c) != 8 {
+		return ""
+	}
+	return c
+}
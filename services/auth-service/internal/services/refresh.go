@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Выдаёт access-токен и refresh-токен нового семейства (после регистрации или входа)
+func (s *authService) IssueTokens(ctx context.Context, userID string) (*model.TokenPair, error) {
+	user, err := s.activeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	raw, stored, err := s.newRefreshToken(userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tokens.CreateRefreshToken(ctx, stored); err != nil {
+		return nil, err
+	}
+	return s.tokenPair(user, raw)
+}
+
+// Обменивает refresh-токен на новую пару. Каждый токен можно использовать один раз:
+// повторное предъявление означает утечку, и тогда отзывается всё семейство
+func (s *authService) RefreshTokens(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidToken
+	}
+	stored, err := s.tokens.GetRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, repo.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+	if stored.RevokedAt != nil {
+		return nil, ErrInvalidToken
+	}
+	if stored.UsedAt != nil {
+		return nil, s.revokeFamily(ctx, stored)
+	}
+	if !s.now().Before(stored.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+	// Роль берётся из базы: после её смены новые access-токены получают новые права
+	user, err := s.activeUser(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, next, err := s.newRefreshToken(stored.UserID, stored.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tokens.RotateRefreshToken(ctx, stored.ID, next); err != nil {
+		if errors.Is(err, repo.ErrRefreshTokenUsed) {
+			// Токен успели использовать параллельно
+			return nil, s.revokeFamily(ctx, stored)
+		}
+		return nil, err
+	}
+	s.logger.Info("refresh token rotated", zap.String("userID", stored.UserID))
+	return s.tokenPair(user, raw)
+}
+
+// Пользователь, которому можно выдать токены: существует и не заблокирован
+func (s *authService) activeUser(ctx context.Context, userID string) (*model.User, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if user.DisabledAt != nil {
+		return nil, ErrAccountDisabled
+	}
+	return user, nil
+}
+
+func (s *authService) revokeFamily(ctx context.Context, stored *model.RefreshToken) error {
+	s.logger.Warn("refresh token reuse detected, revoking family",
+		zap.String("userID", stored.UserID),
+		zap.String("familyID", stored.FamilyID),
+	)
+	if err := s.tokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+	return ErrTokenReused
+}
+
+func (s *authService) tokenPair(user *model.User, refreshToken string) (*model.TokenPair, error) {
+	role := user.Role
+	if role == "" {
+		role = model.RoleUser
+	}
+	access, expiresAt, err := s.generateJWT(user.ID, role)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TokenPair{AccessToken: access, RefreshToken: refreshToken, ExpiresAt: expiresAt}, nil
+}
+
+// Генерирует случайный refresh-токен; в базу попадает только его хеш
+func (s *authService) newRefreshToken(userID, familyID string) (string, *model.RefreshToken, error) {
+	raw, err := randomToken(32)
+	if err != nil {
+		s.logger.Error("failed to generate refresh token", zap.Error(err))
+		return "", nil, err
+	}
+	return raw, &model.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(raw),
+		ExpiresAt: s.now().Add(s.ttl.Refresh),
+	}, nil
+}
+
+// Случайная строка из n байт в base64url
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// У токена 256 бит случайности, поэтому достаточно SHA-256 без соли
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
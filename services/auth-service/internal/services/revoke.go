@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Отзывает access-токен: до истечения срока он не пройдёт проверку
+func (s *authService) RevokeToken(ctx context.Context, accessToken string) error {
+	claims, err := s.parseAccessToken(accessToken)
+	if err != nil {
+		return err
+	}
+	return s.revoke(ctx, claims)
+}
+
+func (s *authService) revoke(ctx context.Context, claims *accessClaims) error {
+	s.logger.Info("revoking token", zap.String("userID", claims.UserID), zap.String("jti", claims.JTI))
+	return s.revoked.RevokeToken(ctx, &model.RevokedToken{
+		JTI:       claims.JTI,
+		UserID:    claims.UserID,
+		ExpiresAt: claims.ExpiresAt,
+	})
+}
+
+// Завершает сессию: отзывает access-токен и, если передан, всё семейство refresh-токена.
+// Refresh-токен другого пользователя не трогается
+func (s *authService) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	claims, err := s.parseAccessToken(accessToken)
+	if err != nil {
+		return err
+	}
+	if err := s.revoke(ctx, claims); err != nil {
+		return err
+	}
+	if refreshToken == "" {
+		return nil
+	}
+	stored, err := s.tokens.GetRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, repo.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+	if stored.UserID != claims.UserID {
+		s.logger.Warn("logout with foreign refresh token", zap.String("userID", claims.UserID))
+		return nil
+	}
+	s.logger.Info("user logged out", zap.String("userID", claims.UserID))
+	return s.tokens.RevokeFamily(ctx, stored.FamilyID)
+}
+
+// Отозванные и ещё не истёкшие токены, отозванные после since
+func (s *authService) ListRevoked(ctx context.Context, since time.Time) ([]model.RevokedToken, error) {
+	return s.revoked.ListRevokedSince(ctx, since)
+}
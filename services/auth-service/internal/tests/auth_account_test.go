@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type accountTestDeps struct {
+	emailTestDeps
+	revoked  *MockRevokedTokenRepository
+	attempts *MockLoginAttemptRepository
+}
+
+func newAccountTestService(t *testing.T) (services.AuthService, accountTestDeps) {
+	ctrl := gomock.NewController(t)
+	d := accountTestDeps{
+		emailTestDeps: emailTestDeps{
+			users:  NewMockUserRepository(ctrl),
+			tokens: NewMockRefreshTokenRepository(ctrl),
+			email:  NewMockEmailTokenRepository(ctrl),
+			sent:   &sentMail{},
+		},
+		revoked:  NewMockRevokedTokenRepository(ctrl),
+		attempts: NewMockLoginAttemptRepository(ctrl),
+	}
+	s := services.NewAuthService(d.users, d.tokens, d.revoked, nil, services.EmailConfig{
+		Tokens:         d.email,
+		Mailer:         d.sent,
+		ChangeEmailURL: "https://finplan.example/change-email",
+	}, services.LockoutConfig{Attempts: d.attempts}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	d.revoked.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	d.attempts.EXPECT().GetLoginAttempts(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	return s, d
+}
+
+func accountUser(t *testing.T) *model.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	return &model.User{ID: "user-1", Email: "anna@example.com", Password: string(hash), Name: "Anna"}
+}
+
+func TestChangePassword(t *testing.T) {
+	s, d := newAccountTestService(t)
+	ctx := context.Background()
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": time.Now().Add(time.Minute).Unix()})
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(accountUser(t), nil).AnyTimes()
+
+	// Слишком короткий пароль отклоняется до проверки текущего
+	_, err := s.ChangePassword(ctx, token, "old-password", "short")
+	require.ErrorIs(t, err, services.ErrWeakPassword)
+
+	// Неверный текущий пароль засчитывается как неудачный вход
+	d.attempts.EXPECT().RecordLoginFailure(gomock.Any(), "account:anna@example.com", gomock.Any(), gomock.Any()).Return(&model.LoginAttempt{Failures: 1}, nil)
+	_, err = s.ChangePassword(ctx, token, "wrong", "new-password")
+	require.ErrorIs(t, err, services.ErrInvalidCredentials)
+
+	var newHash string
+	d.users.EXPECT().UpdatePassword(gomock.Any(), "user-1", gomock.Any()).DoAndReturn(func(_ context.Context, _, hash string) error {
+		newHash = hash
+		return nil
+	})
+	d.tokens.EXPECT().RevokeUserTokens(gomock.Any(), "user-1").Return(nil)
+	d.revoked.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rt *model.RevokedToken) error {
+		require.Equal(t, "jti-1", rt.JTI)
+		return nil
+	})
+	d.tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+	pair, err := s.ChangePassword(ctx, token, "old-password", "new-password")
+	require.NoError(t, err)
+	require.NotEmpty(t, pair.AccessToken)
+	require.NotEmpty(t, pair.RefreshToken)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte("new-password")))
+	require.Len(t, *d.sent, 1)
+	require.Equal(t, "anna@example.com", (*d.sent)[0].To)
+}
+
+func TestChangeEmail_SendsLinkToNewAddress(t *testing.T) {
+	s, d := newAccountTestService(t)
+	ctx := context.Background()
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": time.Now().Add(time.Minute).Unix()})
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(accountUser(t), nil).AnyTimes()
+
+	require.ErrorIs(t, s.ChangeEmail(ctx, token, "old-password", "ANNA@example.com"), services.ErrEmailUnchanged)
+
+	d.users.EXPECT().GetUserByEmail(gomock.Any(), "taken@example.com").Return(&model.User{ID: "user-2"}, nil)
+	require.ErrorIs(t, s.ChangeEmail(ctx, token, "old-password", "taken@example.com"), services.ErrEmailTaken)
+
+	d.users.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(nil, sql.ErrNoRows)
+	d.email.EXPECT().InvalidateEmailTokens(gomock.Any(), "user-1", model.PurposeChangeEmail).Return(nil)
+	var stored *model.EmailToken
+	d.email.EXPECT().CreateEmailToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tok *model.EmailToken) error {
+		stored = tok
+		return nil
+	})
+	require.NoError(t, s.ChangeEmail(ctx, token, "old-password", " new@example.com "))
+
+	require.Len(t, *d.sent, 1)
+	msg := (*d.sent)[0]
+	require.Equal(t, "new@example.com", msg.To)
+	link := tokenFromMail(t, msg, "https://finplan.example/change-email")
+	require.Equal(t, sha256Hex(link), stored.TokenHash)
+	require.Equal(t, "new@example.com", stored.Email)
+	require.Equal(t, model.PurposeChangeEmail, stored.Purpose)
+}
+
+func TestConfirmEmailChange(t *testing.T) {
+	s, d := newAccountTestService(t)
+	ctx := context.Background()
+
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeChangeEmail, sha256Hex("good")).
+		Return(&model.EmailToken{UserID: "user-1", Email: "new@example.com"}, nil)
+	d.users.EXPECT().GetUserByID(ctx, "user-1").Return(accountUser(t), nil)
+	d.users.EXPECT().UpdateEmail(ctx, "user-1", "new@example.com", gomock.Any()).Return(nil)
+	d.email.EXPECT().InvalidateEmailTokens(ctx, "user-1", model.PurposeResetPassword).Return(nil)
+
+	email, err := s.ConfirmEmailChange(ctx, "good")
+	require.NoError(t, err)
+	require.Equal(t, "new@example.com", email)
+	// Уведомление уходит на прежний адрес
+	require.Len(t, *d.sent, 1)
+	require.Equal(t, "anna@example.com", (*d.sent)[0].To)
+
+	// Адрес успели занять, пока письмо шло
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeChangeEmail, sha256Hex("late")).
+		Return(&model.EmailToken{UserID: "user-1", Email: "taken@example.com"}, nil)
+	d.users.EXPECT().GetUserByID(ctx, "user-1").Return(accountUser(t), nil)
+	d.users.EXPECT().UpdateEmail(ctx, "user-1", "taken@example.com", gomock.Any()).Return(repo2.ErrEmailTaken)
+	_, err = s.ConfirmEmailChange(ctx, "late")
+	require.ErrorIs(t, err, services.ErrEmailTaken)
+
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeChangeEmail, sha256Hex("used")).Return(nil, repo2.ErrEmailTokenInvalid)
+	_, err = s.ConfirmEmailChange(ctx, "used")
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
+
+func TestUpdateEmail_EnqueuesEvent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	r := repo2.NewPostgresUserRepository(db, zap.NewNop())
+	changedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	payload, err := json.Marshal(model.UserEmailChanged{UserID: "user-1", Email: "new@example.com", ChangedAt: changedAt})
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET email = $1, email_verified_at = $2 WHERE id = $3`)).
+		WithArgs("new@example.com", changedAt, "user-1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO outbox (topic, payload) VALUES ($1, $2)`)).
+		WithArgs(model.TopicUserEmailChanged, payload).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, r.UpdateEmail(context.Background(), "user-1", "new@example.com", changedAt))
+
+	// Нарушение уникальности email
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET email = $1`)).WillReturnError(&pgconn.PgError{Code: "23505"})
+	mock.ExpectRollback()
+	require.ErrorIs(t, r.UpdateEmail(context.Background(), "user-1", "taken@example.com", changedAt), repo2.ErrEmailTaken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type adminTestDeps struct {
+	users  *MockUserRepository
+	tokens *MockRefreshTokenRepository
+	audit  *MockAuditRepository
+}
+
+func newAdminTestService(t *testing.T) (services.AuthService, adminTestDeps) {
+	ctrl := gomock.NewController(t)
+	d := adminTestDeps{
+		users:  NewMockUserRepository(ctrl),
+		tokens: NewMockRefreshTokenRepository(ctrl),
+		audit:  NewMockAuditRepository(ctrl),
+	}
+	s := services.NewAuthService(d.users, d.tokens, NewMockRevokedTokenRepository(ctrl), d.audit, services.EmailConfig{},
+		services.LockoutConfig{}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	d.users.EXPECT().GetUserByID(gomock.Any(), "admin-1").Return(&model.User{ID: "admin-1", Role: model.RoleAdmin}, nil).AnyTimes()
+	d.users.EXPECT().GetUserByID(gomock.Any(), "support-1").Return(&model.User{ID: "support-1", Role: model.RoleSupport}, nil).AnyTimes()
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(&model.User{ID: "user-1", Role: model.RoleUser}, nil).AnyTimes()
+	return s, d
+}
+
+func TestIssueTokens_RoleClaim(t *testing.T) {
+	s, d := newAdminTestService(t)
+	d.tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+	pair, err := s.IssueTokens(context.Background(), "support-1")
+	require.NoError(t, err)
+	parsed, err := jwt.Parse(pair.AccessToken, func(*jwt.Token) (interface{}, error) { return testKey.Public(), nil })
+	require.NoError(t, err)
+	require.Equal(t, model.RoleSupport, parsed.Claims.(jwt.MapClaims)["role"])
+}
+
+func TestRefreshTokens_DisabledUser(t *testing.T) {
+	s, d := newAdminTestService(t)
+	disabledAt := time.Now()
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-2").Return(&model.User{ID: "user-2", Role: model.RoleUser, DisabledAt: &disabledAt}, nil)
+	d.tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).
+		Return(&model.RefreshToken{ID: "rt-1", UserID: "user-2", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+	_, err := s.RefreshTokens(context.Background(), "refresh")
+	require.ErrorIs(t, err, services.ErrAccountDisabled)
+}
+
+func TestAdmin_PermissionsFromDatabaseRole(t *testing.T) {
+	s, d := newAdminTestService(t)
+	ctx := context.Background()
+
+	_, _, err := s.ListUsers(ctx, "user-1", model.UserFilter{})
+	require.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, _, err = s.ListUsers(ctx, "", model.UserFilter{})
+	require.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = s.SetUserDisabled(ctx, "support-1", "user-1", true)
+	require.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = s.ListAudit(ctx, "support-1", model.AuditFilter{})
+	require.ErrorIs(t, err, services.ErrPermissionDenied)
+
+	d.users.EXPECT().ListUsers(ctx, model.UserFilter{Query: "anna", Limit: services.DefaultAdminPageSize}).
+		Return([]model.User{{ID: "user-1"}}, 1, nil)
+	users, total, err := s.ListUsers(ctx, "support-1", model.UserFilter{Query: "anna"})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, 1, total)
+}
+
+func TestSetUserDisabled_RevokesSessionsAndAudits(t *testing.T) {
+	s, d := newAdminTestService(t)
+	ctx := services.WithClientIP(context.Background(), "10.0.0.1")
+
+	_, err := s.SetUserDisabled(ctx, "admin-1", "admin-1", true)
+	require.ErrorIs(t, err, services.ErrSelfModification)
+
+	d.users.EXPECT().SetUserDisabled(ctx, "user-1", true).Return(true, nil)
+	d.tokens.EXPECT().RevokeUserTokens(ctx, "user-1").Return(nil)
+	d.audit.EXPECT().CreateAuditEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *model.AuditEvent) error {
+		require.Equal(t, "admin-1", e.ActorID)
+		require.Equal(t, model.RoleAdmin, e.ActorRole)
+		require.Equal(t, "user.disable", e.Action)
+		require.Equal(t, "user-1", e.TargetUserID)
+		require.Equal(t, "10.0.0.1", e.ClientIP)
+		return nil
+	})
+	changed, err := s.SetUserDisabled(ctx, "admin-1", "user-1", true)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	d.users.EXPECT().SetUserDisabled(ctx, "missing", false).Return(false, sql.ErrNoRows)
+	_, err = s.SetUserDisabled(ctx, "admin-1", "missing", false)
+	require.ErrorIs(t, err, services.ErrUserNotFound)
+}
+
+func TestSetUserRole(t *testing.T) {
+	s, d := newAdminTestService(t)
+	ctx := context.Background()
+
+	require.ErrorIs(t, s.SetUserRole(ctx, "admin-1", "user-1", "root"), services.ErrInvalidRole)
+	require.ErrorIs(t, s.SetUserRole(ctx, "admin-1", "admin-1", model.RoleUser), services.ErrSelfModification)
+
+	d.users.EXPECT().SetUserRole(ctx, "user-1", model.RoleSupport).Return(nil)
+	d.audit.EXPECT().CreateAuditEvent(ctx, gomock.Any()).Return(nil)
+	require.NoError(t, s.SetUserRole(ctx, "admin-1", "user-1", model.RoleSupport))
+}
+
+func TestRecordAudit_ActorFromDatabase(t *testing.T) {
+	s, d := newAdminTestService(t)
+	ctx := context.Background()
+
+	d.audit.EXPECT().CreateAuditEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *model.AuditEvent) error {
+		require.Equal(t, "support-1", e.ActorID)
+		require.Equal(t, model.RoleSupport, e.ActorRole)
+		return nil
+	})
+	// Переданные в событии actor_* не учитываются
+	require.NoError(t, s.RecordAudit(ctx, "support-1", &model.AuditEvent{ActorID: "admin-1", ActorRole: model.RoleAdmin, Action: "GET /users"}))
+	require.ErrorIs(t, s.RecordAudit(ctx, "user-1", &model.AuditEvent{Action: "GET /users"}), services.ErrPermissionDenied)
+}
+
+func TestListUsersHandler_CallerFromToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := NewMockAuthService(ctrl)
+	h := handlers.NewAuthHandler(svc, zap.NewNop())
+
+	// Без токена пользователь из метаданных не принимается
+	spoofed := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "admin-1"))
+	_, err := h.ListUsers(spoofed, &authpb.ListUsersRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs(handlers.AuthorizationMetadataKey, "Bearer forged"))
+	svc.EXPECT().VerifyToken(forged, "forged").Return("", services.ErrInvalidToken)
+	_, err = h.ListUsers(forged, &authpb.ListUsersRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(handlers.AuthorizationMetadataKey, "Bearer access"))
+	svc.EXPECT().VerifyToken(ctx, "access").Return("user-1", nil).AnyTimes()
+	svc.EXPECT().ListUsers(ctx, "user-1", gomock.Any()).Return(nil, 0, services.ErrPermissionDenied)
+	_, err = h.ListUsers(ctx, &authpb.ListUsersRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	createdAt := time.Now()
+	svc.EXPECT().ListUsers(ctx, "user-1", model.UserFilter{Role: model.RoleAdmin, Limit: 10}).
+		Return([]model.User{{ID: "admin-1", Role: model.RoleAdmin, Password: "hash", EmailVerifiedAt: &createdAt, CreatedAt: createdAt}}, 3, nil)
+	resp, err := h.ListUsers(ctx, &authpb.ListUsersRequest{Role: model.RoleAdmin, Limit: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.Total)
+	require.True(t, resp.Users[0].EmailVerified)
+	require.False(t, resp.Users[0].Disabled)
+}
+
+func TestListUsers_Query(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repo2.NewPostgresUserRepository(db, zap.NewNop())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`count(*) OVER ()`)).
+		WithArgs("anna", "", 20, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password", "name", "role", "email_verified_at", "disabled_at", "created_at", "count"}).
+			AddRow("user-1", "anna@example.com", "", "Anna", model.RoleUser, nil, nil, time.Now(), 41))
+
+	users, total, err := repo.ListUsers(context.Background(), model.UserFilter{Query: "anna", Limit: 20, Offset: 40})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Empty(t, users[0].Password)
+	require.Equal(t, 41, total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/mailer"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Письма, сохранённые вместо отправки
+type sentMail []mailer.Message
+
+func (o *sentMail) Send(ctx context.Context, msg mailer.Message) error {
+	*o = append(*o, msg)
+	return nil
+}
+
+type emailTestDeps struct {
+	users  *MockUserRepository
+	tokens *MockRefreshTokenRepository
+	email  *MockEmailTokenRepository
+	sent   *sentMail
+}
+
+func newEmailTestService(t *testing.T) (services.AuthService, emailTestDeps) {
+	ctrl := gomock.NewController(t)
+	d := emailTestDeps{
+		users:  NewMockUserRepository(ctrl),
+		tokens: NewMockRefreshTokenRepository(ctrl),
+		email:  NewMockEmailTokenRepository(ctrl),
+		sent:   &sentMail{},
+	}
+	s := services.NewAuthService(d.users, d.tokens, NewMockRevokedTokenRepository(ctrl), nil, services.EmailConfig{
+		Tokens:    d.email,
+		Mailer:    d.sent,
+		VerifyURL: "https://finplan.example/verify",
+		ResetURL:  "https://finplan.example/reset",
+	}, services.LockoutConfig{}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	return s, d
+}
+
+// Токен из ссылки в письме
+func tokenFromMail(t *testing.T, msg mailer.Message, base string) string {
+	i := strings.Index(msg.Body, base)
+	require.GreaterOrEqual(t, i, 0, msg.Body)
+	link := strings.Fields(msg.Body[i:])[0]
+	u, err := url.Parse(link)
+	require.NoError(t, err)
+	return u.Query().Get("token")
+}
+
+func TestRegister_SendsVerificationEmail(t *testing.T) {
+	s, d := newEmailTestService(t)
+
+	d.users.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u *model.User) (string, error) {
+		u.ID = "user-1"
+		return u.ID, nil
+	})
+	d.email.EXPECT().InvalidateEmailTokens(gomock.Any(), "user-1", model.PurposeVerifyEmail).Return(nil)
+	var stored *model.EmailToken
+	d.email.EXPECT().CreateEmailToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tok *model.EmailToken) error {
+		stored = tok
+		return nil
+	})
+
+	_, err := s.Register(context.Background(), "anna@example.com", "password123", "Anna")
+	require.NoError(t, err)
+
+	require.Len(t, *d.sent, 1)
+	msg := (*d.sent)[0]
+	require.Equal(t, "anna@example.com", msg.To)
+	token := tokenFromMail(t, msg, "https://finplan.example/verify")
+	require.NotEmpty(t, token)
+	// В базе только хеш токена
+	require.Equal(t, sha256Hex(token), stored.TokenHash)
+	require.NotContains(t, stored.TokenHash, token)
+	require.Equal(t, "anna@example.com", stored.Email)
+}
+
+func TestVerifyEmail(t *testing.T) {
+	s, d := newEmailTestService(t)
+	ctx := context.Background()
+
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeVerifyEmail, sha256Hex("good")).
+		Return(&model.EmailToken{UserID: "user-1", Email: "anna@example.com"}, nil)
+	d.users.EXPECT().MarkEmailVerified(ctx, "user-1", "anna@example.com").Return(true, nil)
+	require.NoError(t, s.VerifyEmail(ctx, "good"))
+
+	// Использованный или истёкший токен
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeVerifyEmail, sha256Hex("good")).Return(nil, repo2.ErrEmailTokenInvalid)
+	require.ErrorIs(t, s.VerifyEmail(ctx, "good"), services.ErrInvalidToken)
+
+	// Адрес сменился после отправки письма
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeVerifyEmail, sha256Hex("old")).
+		Return(&model.EmailToken{UserID: "user-1", Email: "old@example.com"}, nil)
+	d.users.EXPECT().MarkEmailVerified(ctx, "user-1", "old@example.com").Return(false, nil)
+	require.ErrorIs(t, s.VerifyEmail(ctx, "old"), services.ErrInvalidToken)
+}
+
+func TestRequestPasswordReset_UnknownEmail(t *testing.T) {
+	s, d := newEmailTestService(t)
+
+	d.users.EXPECT().GetUserByEmail(gomock.Any(), "nobody@example.com").Return(nil, sql.ErrNoRows)
+
+	require.NoError(t, s.RequestPasswordReset(context.Background(), "nobody@example.com"))
+	require.Empty(t, *d.sent)
+}
+
+func TestPasswordReset_Flow(t *testing.T) {
+	s, d := newEmailTestService(t)
+	ctx := context.Background()
+	user := &model.User{ID: "user-1", Email: "anna@example.com", Name: "Anna"}
+
+	d.users.EXPECT().GetUserByEmail(ctx, user.Email).Return(user, nil)
+	d.email.EXPECT().InvalidateEmailTokens(ctx, user.ID, model.PurposeResetPassword).Return(nil)
+	var stored *model.EmailToken
+	d.email.EXPECT().CreateEmailToken(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tok *model.EmailToken) error {
+		stored = tok
+		return nil
+	})
+	require.NoError(t, s.RequestPasswordReset(ctx, user.Email))
+	require.Len(t, *d.sent, 1)
+	token := tokenFromMail(t, (*d.sent)[0], "https://finplan.example/reset")
+
+	// Короткий пароль отклоняется, не расходуя токен
+	require.ErrorIs(t, s.ResetPassword(ctx, token, "short"), services.ErrWeakPassword)
+
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeResetPassword, stored.TokenHash).Return(stored, nil)
+	d.users.EXPECT().UpdatePassword(ctx, user.ID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, hash string) error {
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("new-password")))
+		return nil
+	})
+	d.tokens.EXPECT().RevokeUserTokens(ctx, user.ID).Return(nil)
+	d.users.EXPECT().MarkEmailVerified(ctx, user.ID, user.Email).Return(true, nil)
+	require.NoError(t, s.ResetPassword(ctx, token, "new-password"))
+
+	// Повторно ссылка не работает
+	d.email.EXPECT().ConsumeEmailToken(ctx, model.PurposeResetPassword, stored.TokenHash).Return(nil, repo2.ErrEmailTokenInvalid)
+	require.ErrorIs(t, s.ResetPassword(ctx, token, "another-password"), services.ErrInvalidToken)
+}
+
+func TestConsumeEmailToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	r := repo2.NewPostgresEmailTokenRepository(db, zap.NewNop())
+
+	query := regexp.QuoteMeta(`UPDATE email_tokens SET used_at = now()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > now()`)
+	mock.ExpectQuery(query).WithArgs("hash", model.PurposeVerifyEmail).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "email", "purpose", "token_hash", "expires_at", "created_at", "used_at"}))
+
+	_, err = r.ConsumeEmailToken(context.Background(), model.PurposeVerifyEmail, "hash")
+	require.ErrorIs(t, err, repo2.ErrEmailTokenInvalid)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	m := mailer.NewFileMailer(path)
+
+	require.NoError(t, m.Send(context.Background(), mailer.Message{To: "a@example.com", Subject: "Первое", Body: "ссылка 1"}))
+	require.NoError(t, m.Send(context.Background(), mailer.Message{To: "b@example.com", Subject: "Второе", Body: "ссылка 2"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "To: a@example.com\nSubject: Первое\n\nссылка 1")
+	require.Contains(t, string(data), "To: b@example.com\nSubject: Второе\n\nссылка 2")
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testKeyOptions = keys.Options{
+	Algorithm:       keys.AlgEdDSA,
+	Rotation:        24 * time.Hour,
+	ActivationDelay: 5 * time.Minute,
+	Retention:       time.Hour,
+}
+
+func storedKey(t *testing.T, age time.Duration) model.SigningKey {
+	k, err := keys.Generate(keys.AlgEdDSA)
+	require.NoError(t, err)
+	k.CreatedAt = time.Now().Add(-age)
+	m, err := k.Model()
+	require.NoError(t, err)
+	return *m
+}
+
+func TestKeySet_CreatesFirstKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	r := NewMockSigningKeyRepository(ctrl)
+	r.EXPECT().ListSigningKeys(gomock.Any()).Return(nil, nil)
+	var created *model.SigningKey
+	r.EXPECT().CreateSigningKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *model.SigningKey) error {
+		created = k
+		return nil
+	})
+
+	ks, err := keys.NewKeySet(r, testKeyOptions, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, ks.Refresh(context.Background()))
+
+	// Единственный ключ подписывает сразу, не дожидаясь ActivationDelay
+	current, err := ks.Current()
+	require.NoError(t, err)
+	require.Equal(t, created.KID, current.ID)
+	require.Equal(t, keys.AlgEdDSA, created.Algorithm)
+}
+
+func TestKeySet_RotationKeepsSigningUntilPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	r := NewMockSigningKeyRepository(ctrl)
+	old := storedKey(t, 25*time.Hour)
+	r.EXPECT().ListSigningKeys(gomock.Any()).Return([]model.SigningKey{old}, nil)
+	r.EXPECT().CreateSigningKey(gomock.Any(), gomock.Any()).Return(nil)
+
+	ks, err := keys.NewKeySet(r, testKeyOptions, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, ks.Refresh(context.Background()))
+
+	require.Len(t, ks.Keys(), 2)
+	current, err := ks.Current()
+	require.NoError(t, err)
+	require.Equal(t, old.KID, current.ID)
+}
+
+func TestKeySet_SwitchesToNewKeyAndRetiresOld(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	r := NewMockSigningKeyRepository(ctrl)
+	newest := storedKey(t, 10*time.Minute)
+	previous := storedKey(t, 2*time.Hour)
+	retired := storedKey(t, 26*time.Hour)
+	// previous подписывает дольше ActivationDelay+Retention, поэтому retired больше не нужен
+	r.EXPECT().ListSigningKeys(gomock.Any()).Return([]model.SigningKey{newest, previous, retired}, nil)
+	r.EXPECT().DeleteSigningKey(gomock.Any(), retired.KID).Return(nil)
+
+	ks, err := keys.NewKeySet(r, testKeyOptions, zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, ks.Refresh(context.Background()))
+
+	current, err := ks.Current()
+	require.NoError(t, err)
+	require.Equal(t, newest.KID, current.ID)
+	_, ok := ks.Lookup(previous.KID)
+	require.True(t, ok)
+	_, ok = ks.Lookup(retired.KID)
+	require.False(t, ok)
+}
+
+func TestKeySet_RejectsUnknownAlgorithm(t *testing.T) {
+	_, err := keys.NewKeySet(nil, keys.Options{Algorithm: "HS256"}, zap.NewNop())
+	require.ErrorIs(t, err, keys.ErrUnsupportedAlgorithm)
+}
+
+func TestKey_ModelRoundTrip(t *testing.T) {
+	for _, alg := range []string{keys.AlgEdDSA, keys.AlgRS256} {
+		k, err := keys.Generate(alg)
+		require.NoError(t, err)
+		m, err := k.Model()
+		require.NoError(t, err)
+		restored, err := keys.FromModel(m)
+		require.NoError(t, err)
+		require.Equal(t, k.ID, restored.ID)
+		require.Equal(t, k.ID, restored.JWK().Thumbprint())
+		require.Equal(t, k.JWK(), restored.JWK())
+	}
+}
+
+func TestVerifyToken_RS256(t *testing.T) {
+	rsaKey, err := keys.Generate(keys.AlgRS256)
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	tokens := NewMockRefreshTokenRepository(ctrl)
+	revoked := NewMockRevokedTokenRepository(ctrl)
+	users := NewMockUserRepository(ctrl)
+	users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(&model.User{ID: "user-1", Role: model.RoleUser}, nil)
+	s := services.NewAuthService(users, tokens, revoked, nil, services.EmailConfig{}, services.LockoutConfig{}, services.MFAConfig{}, keys.NewStaticSet(rsaKey), services.TokenTTL{}, zap.NewNop())
+
+	tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+	pair, err := s.IssueTokens(context.Background(), "user-1")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(pair.AccessToken, jwt.MapClaims{})
+	require.NoError(t, err)
+	require.Equal(t, "RS256", parsed.Header["alg"])
+	require.Equal(t, rsaKey.ID, parsed.Header["kid"])
+
+	revoked.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+	userID, err := s.VerifyToken(context.Background(), pair.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, "user-1", userID)
+
+	jwks := s.PublicKeys()
+	require.Len(t, jwks, 1)
+	require.Equal(t, "RSA", jwks[0].Kty)
+	require.Equal(t, "AQAB", jwks[0].E)
+}
+
+func TestVerifyToken_RejectsForeignKeys(t *testing.T) {
+	s, _, _ := newRevokeTestService(t)
+	claims := jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": time.Now().Add(time.Minute).Unix()}
+
+	// Подпись общим секретом не принимается, даже с известным kid
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	hmac.Header["kid"] = testKey.ID
+	hmacToken, err := hmac.SignedString([]byte("shared-secret"))
+	require.NoError(t, err)
+	_, err = s.VerifyToken(context.Background(), hmacToken)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+
+	// Ключ, которого нет в наборе
+	other, err := keys.Generate(keys.AlgEdDSA)
+	require.NoError(t, err)
+	foreign := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	foreign.Header["kid"] = other.ID
+	foreignToken, err := foreign.SignedString(other.Private)
+	require.NoError(t, err)
+	_, err = s.VerifyToken(context.Background(), foreignToken)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newLockoutTestService(t *testing.T) (services.AuthService, *MockUserRepository, *MockLoginAttemptRepository) {
+	ctrl := gomock.NewController(t)
+	users := NewMockUserRepository(ctrl)
+	attempts := NewMockLoginAttemptRepository(ctrl)
+	s := services.NewAuthService(users, NewMockRefreshTokenRepository(ctrl), NewMockRevokedTokenRepository(ctrl), nil,
+		services.EmailConfig{}, services.LockoutConfig{Attempts: attempts}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	return s, users, attempts
+}
+
+func TestLockoutPolicy_Delay(t *testing.T) {
+	p := services.LockoutPolicy{FreeAttempts: 5, BaseDelay: 30 * time.Second, MaxDelay: time.Hour}
+	require.Zero(t, p.Delay(5))
+	require.Equal(t, 30*time.Second, p.Delay(6))
+	require.Equal(t, 2*time.Minute, p.Delay(8))
+	require.Equal(t, time.Hour, p.Delay(50))
+}
+
+func TestLogin_LockedSkipsPasswordCheck(t *testing.T) {
+	s, _, attempts := newLockoutTestService(t)
+	ctx := services.WithClientIP(context.Background(), "10.0.0.1")
+
+	until := time.Now().Add(2 * time.Minute)
+	attempts.EXPECT().GetLoginAttempts(gomock.Any(), gomock.InAnyOrder([]string{"account:anna@example.com", "ip:10.0.0.1"})).
+		Return([]model.LoginAttempt{{Key: "ip:10.0.0.1", Failures: 21, LockedUntil: &until}}, nil)
+
+	_, err := s.Login(ctx, "Anna@example.com", "password")
+	require.ErrorIs(t, err, services.ErrTooManyAttempts)
+	var locked *services.LockedError
+	require.ErrorAs(t, err, &locked)
+	require.InDelta(t, 2*time.Minute, locked.RetryAfter, float64(time.Second))
+}
+
+func TestLogin_FailureLocksAfterFreeAttempts(t *testing.T) {
+	s, users, attempts := newLockoutTestService(t)
+	ctx := services.WithClientIP(context.Background(), "10.0.0.1")
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("right"), bcrypt.MinCost)
+	require.NoError(t, err)
+	attempts.EXPECT().GetLoginAttempts(gomock.Any(), gomock.Any()).Return(nil, nil)
+	users.EXPECT().GetUserByEmail(gomock.Any(), "anna@example.com").Return(&model.User{ID: "user-1", Password: string(hash)}, nil)
+	attempts.EXPECT().RecordLoginFailure(gomock.Any(), "account:anna@example.com", gomock.Any(), services.DefaultAccountLockout.Window).
+		Return(&model.LoginAttempt{Key: "account:anna@example.com", Failures: 6}, nil)
+	attempts.EXPECT().RecordLoginFailure(gomock.Any(), "ip:10.0.0.1", gomock.Any(), services.DefaultIPLockout.Window).
+		Return(&model.LoginAttempt{Key: "ip:10.0.0.1", Failures: 6}, nil)
+	// Лимит исчерпан только у аккаунта
+	attempts.EXPECT().LockLogin(gomock.Any(), "account:anna@example.com", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, until time.Time) error {
+			require.WithinDuration(t, time.Now().Add(30*time.Second), until, time.Second)
+			return nil
+		})
+
+	_, err = s.Login(ctx, "anna@example.com", "wrong")
+	require.ErrorIs(t, err, services.ErrInvalidCredentials)
+}
+
+func TestLogin_UnknownEmailCountsAsFailure(t *testing.T) {
+	s, users, attempts := newLockoutTestService(t)
+
+	attempts.EXPECT().GetLoginAttempts(gomock.Any(), []string{"account:nobody@example.com"}).Return(nil, nil)
+	users.EXPECT().GetUserByEmail(gomock.Any(), "nobody@example.com").Return(nil, sql.ErrNoRows)
+	attempts.EXPECT().RecordLoginFailure(gomock.Any(), "account:nobody@example.com", gomock.Any(), gomock.Any()).
+		Return(&model.LoginAttempt{Failures: 1}, nil)
+
+	_, err := s.Login(context.Background(), "nobody@example.com", "password")
+	require.ErrorIs(t, err, services.ErrInvalidCredentials)
+}
+
+func TestLogin_SuccessResetsAccountCounter(t *testing.T) {
+	s, users, attempts := newLockoutTestService(t)
+	ctx := services.WithClientIP(context.Background(), "10.0.0.1")
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("right"), bcrypt.MinCost)
+	require.NoError(t, err)
+	attempts.EXPECT().GetLoginAttempts(gomock.Any(), gomock.Any()).Return([]model.LoginAttempt{{Key: "account:anna@example.com", Failures: 3}}, nil)
+	users.EXPECT().GetUserByEmail(gomock.Any(), "anna@example.com").Return(&model.User{ID: "user-1", Password: string(hash)}, nil)
+	attempts.EXPECT().ResetLoginAttempts(gomock.Any(), "account:anna@example.com").Return(true, nil)
+
+	userID, err := s.Login(ctx, "anna@example.com", "right")
+	require.NoError(t, err)
+	require.Equal(t, "user-1", userID)
+}
+
+func TestLoginHandler_ResourceExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := NewMockAuthService(ctrl)
+	h := handlers.NewAuthHandler(svc, zap.NewNop())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(handlers.ClientIPMetadataKey, "10.0.0.1"))
+	svc.EXPECT().Login(gomock.Any(), "anna@example.com", "password").
+		DoAndReturn(func(ctx context.Context, _, _ string) (string, error) {
+			return "", &services.LockedError{RetryAfter: 90 * time.Second}
+		})
+
+	_, err := h.Login(ctx, &authpb.LoginRequest{Email: "anna@example.com", Password: "password"})
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	retry, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, 90*time.Second, retry.GetRetryDelay().AsDuration())
+}
+
+func TestUnlockAccount_RequiresManagePermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	users := NewMockUserRepository(ctrl)
+	attempts := NewMockLoginAttemptRepository(ctrl)
+	audit := NewMockAuditRepository(ctrl)
+	s := services.NewAuthService(users, NewMockRefreshTokenRepository(ctrl), NewMockRevokedTokenRepository(ctrl), audit,
+		services.EmailConfig{}, services.LockoutConfig{Attempts: attempts}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	ctx := context.Background()
+	users.EXPECT().GetUserByID(ctx, "support-1").Return(&model.User{ID: "support-1", Role: model.RoleSupport}, nil).AnyTimes()
+	users.EXPECT().GetUserByID(ctx, "admin-1").Return(&model.User{ID: "admin-1", Role: model.RoleAdmin}, nil).AnyTimes()
+
+	// Поддержке снимать блокировку нельзя
+	_, err := s.UnlockAccount(ctx, "support-1", "user-1")
+	require.ErrorIs(t, err, services.ErrPermissionDenied)
+
+	users.EXPECT().GetUserByID(ctx, "user-2").Return(nil, sql.ErrNoRows)
+	_, err = s.UnlockAccount(ctx, "admin-1", "user-2")
+	require.ErrorIs(t, err, services.ErrUserNotFound)
+
+	users.EXPECT().GetUserByID(ctx, "user-1").Return(&model.User{ID: "user-1", Email: "Anna@example.com", Role: model.RoleUser}, nil)
+	attempts.EXPECT().ResetLoginAttempts(ctx, "account:anna@example.com").Return(true, nil)
+	audit.EXPECT().CreateAuditEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *model.AuditEvent) error {
+		require.Equal(t, "admin-1", e.ActorID)
+		require.Equal(t, "user.unlock", e.Action)
+		require.Equal(t, "user-1", e.TargetUserID)
+		return nil
+	})
+	unlocked, err := s.UnlockAccount(ctx, "admin-1", "user-1")
+	require.NoError(t, err)
+	require.True(t, unlocked)
+}
+
+func TestUnlockAccountHandler_CallerFromToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	svc := NewMockAuthService(ctrl)
+	h := handlers.NewAuthHandler(svc, zap.NewNop())
+	req := &authpb.UnlockAccountRequest{UserId: "user-1"}
+
+	_, err := h.UnlockAccount(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(handlers.AuthorizationMetadataKey, "Bearer access"))
+	svc.EXPECT().VerifyToken(ctx, "access").Return("admin-1", nil).AnyTimes()
+	svc.EXPECT().UnlockAccount(gomock.Any(), "admin-1", "user-1").Return(true, nil)
+	resp, err := h.UnlockAccount(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.GetUnlocked())
+}
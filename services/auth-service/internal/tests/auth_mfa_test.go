@@ -0,0 +1,236 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/crypt"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/totp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Тестовые векторы RFC 6238 (SHA1), последние 6 цифр
+func TestTOTP_RFC6238Vectors(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range cases {
+		require.Equal(t, want, totp.Code(secret, totp.Step(time.Unix(unix, 0))), unix)
+	}
+}
+
+func TestTOTP_Validate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+
+	// Код предыдущего шага принимается, код двух шагов назад — нет
+	prev := totp.Code(secret, totp.Step(now)-1)
+	step, ok := totp.Validate(secret, prev, now)
+	require.True(t, ok)
+	require.Equal(t, totp.Step(now)-1, step)
+
+	_, ok = totp.Validate(secret, totp.Code(secret, totp.Step(now)-2), now)
+	require.False(t, ok)
+	_, ok = totp.Validate(secret, "12345", now)
+	require.False(t, ok)
+
+	u, err := url.Parse(totp.URI("Finplan", "anna@example.com", secret))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, totp.EncodeSecret(secret), u.Query().Get("secret"))
+	require.Equal(t, "Finplan", u.Query().Get("issuer"))
+}
+
+func TestSealer(t *testing.T) {
+	key := make([]byte, crypt.KeySize)
+	sealer, err := crypt.NewSealer(key)
+	require.NoError(t, err)
+
+	sealed, err := sealer.Seal([]byte("secret"), []byte("user-1"))
+	require.NoError(t, err)
+	plain, err := sealer.Open(sealed, []byte("user-1"))
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(plain))
+
+	// Шифртекст чужой записи не расшифровывается
+	_, err = sealer.Open(sealed, []byte("user-2"))
+	require.ErrorIs(t, err, crypt.ErrCiphertext)
+
+	_, err = crypt.NewSealer([]byte("short"))
+	require.Error(t, err)
+}
+
+type mfaTestDeps struct {
+	users   *MockUserRepository
+	revoked *MockRevokedTokenRepository
+	store   *MockMFARepository
+}
+
+func newMFATestService(t *testing.T) (services.AuthService, mfaTestDeps) {
+	ctrl := gomock.NewController(t)
+	d := mfaTestDeps{
+		users:   NewMockUserRepository(ctrl),
+		revoked: NewMockRevokedTokenRepository(ctrl),
+		store:   NewMockMFARepository(ctrl),
+	}
+	sealer, err := crypt.NewSealer(make([]byte, crypt.KeySize))
+	require.NoError(t, err)
+	s := services.NewAuthService(d.users, NewMockRefreshTokenRepository(ctrl), d.revoked, nil, services.EmailConfig{},
+		services.LockoutConfig{}, services.MFAConfig{Store: d.store, Sealer: sealer}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	return s, d
+}
+
+func mfaAccessToken(t *testing.T, d mfaTestDeps, userID string) string {
+	d.revoked.EXPECT().IsRevoked(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	return signTestToken(t, jwt.MapClaims{"user_id": userID, "jti": "jti-" + userID, "exp": time.Now().Add(time.Minute).Unix()})
+}
+
+func hashHex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestMFA_EnrollConfirmAndLogin(t *testing.T) {
+	s, d := newMFATestService(t)
+	ctx := context.Background()
+	token := mfaAccessToken(t, d, "user-1")
+
+	// Подключение: секрет сохраняется зашифрованным
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(&model.User{ID: "user-1", Email: "anna@example.com"}, nil).AnyTimes()
+	mfa := &model.MFA{UserID: "user-1"}
+	d.store.EXPECT().SaveMFASecret(ctx, "user-1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, sealed []byte) error {
+		mfa.Secret = sealed
+		return nil
+	})
+	enrollment, err := s.EnrollMFA(ctx, token)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/"))
+	secret, err := totp.Secret(enrollment.Secret)
+	require.NoError(t, err)
+	require.NotContains(t, string(mfa.Secret), string(secret))
+
+	// Подтверждение неверным кодом не включает второй фактор
+	d.store.EXPECT().GetMFA(gomock.Any(), "user-1").DoAndReturn(func(context.Context, string) (*model.MFA, error) {
+		return mfa, nil
+	}).AnyTimes()
+	_, err = s.ConfirmMFA(ctx, token, "000000")
+	require.ErrorIs(t, err, services.ErrInvalidMFACode)
+
+	var hashes []string
+	d.store.EXPECT().EnableMFA(ctx, "user-1", gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, step int64, h []string) error {
+		now := time.Now()
+		mfa.EnabledAt = &now
+		mfa.LastUsedStep = step
+		hashes = h
+		return nil
+	})
+	recovery, err := s.ConfirmMFA(ctx, token, totp.Code(secret, totp.Step(time.Now())))
+	require.NoError(t, err)
+	require.Len(t, recovery, services.RecoveryCodeCount)
+	require.Equal(t, hashHex(strings.ReplaceAll(recovery[0], "-", "")), hashes[0])
+
+	// Вход требует второго фактора
+	var challenge *model.MFAChallenge
+	d.store.EXPECT().CreateMFAChallenge(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c *model.MFAChallenge) error {
+		c.ID = "ch-1"
+		challenge = c
+		return nil
+	})
+	mfaToken, _, err := s.StartMFAChallenge(ctx, "user-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, mfaToken)
+	require.Equal(t, hashHex(mfaToken), challenge.TokenHash)
+
+	d.store.EXPECT().GetMFAChallenge(gomock.Any(), challenge.TokenHash).Return(challenge, nil).AnyTimes()
+
+	// Код восстановления подходит вместо кода из приложения
+	d.store.EXPECT().UseRecoveryCode(gomock.Any(), "user-1", hashes[3]).Return(true, nil)
+	d.store.EXPECT().CompleteMFAChallenge(gomock.Any(), "ch-1").Return(true, nil)
+	userID, err := s.VerifyMFA(ctx, mfaToken, strings.ToUpper(recovery[3]))
+	require.NoError(t, err)
+	require.Equal(t, "user-1", userID)
+}
+
+func TestMFA_VerifyRejectsReplayAndCountsFailures(t *testing.T) {
+	s, d := newMFATestService(t)
+	ctx := context.Background()
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	sealer, err := crypt.NewSealer(make([]byte, crypt.KeySize))
+	require.NoError(t, err)
+	sealed, err := sealer.Seal(secret, []byte("user-1"))
+	require.NoError(t, err)
+	now := time.Now()
+
+	challenge := &model.MFAChallenge{ID: "ch-1", UserID: "user-1"}
+	d.store.EXPECT().GetMFAChallenge(gomock.Any(), hashHex("mfa-token")).Return(challenge, nil).AnyTimes()
+	d.store.EXPECT().GetMFA(gomock.Any(), "user-1").Return(&model.MFA{UserID: "user-1", Secret: sealed, EnabledAt: &now}, nil).AnyTimes()
+	d.users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(&model.User{ID: "user-1", Email: "anna@example.com"}, nil).AnyTimes()
+
+	// Код уже принят при прошлом входе
+	code := totp.Code(secret, totp.Step(now))
+	d.store.EXPECT().UseTOTPStep(gomock.Any(), "user-1", totp.Step(now)).Return(false, nil)
+	d.store.EXPECT().RecordMFAChallengeFailure(gomock.Any(), "ch-1").Return(1, nil)
+	_, err = s.VerifyMFA(ctx, "mfa-token", code)
+	require.ErrorIs(t, err, services.ErrInvalidMFACode)
+
+	// После исчерпания попыток токен входа больше не принимается
+	challenge.Attempts = services.DefaultMFAMaxAttempts
+	_, err = s.VerifyMFA(ctx, "mfa-token", code)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+
+	d.store.EXPECT().GetMFAChallenge(gomock.Any(), hashHex("expired")).Return(nil, repo.ErrMFAChallengeInvalid)
+	_, err = s.VerifyMFA(ctx, "expired", code)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
+
+func TestLoginHandler_MFARequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := NewMockAuthService(ctrl)
+	h := handlers.NewAuthHandler(svc, zap.NewNop())
+
+	expiresAt := time.Now().Add(5 * time.Minute)
+	svc.EXPECT().Login(gomock.Any(), "anna@example.com", "password").Return("user-1", nil)
+	svc.EXPECT().StartMFAChallenge(gomock.Any(), "user-1").Return("mfa-token", expiresAt, nil)
+
+	resp, err := h.Login(context.Background(), &authpb.LoginRequest{Email: "anna@example.com", Password: "password"})
+	require.NoError(t, err)
+	require.True(t, resp.GetMfaRequired())
+	require.Equal(t, "mfa-token", resp.GetMfaToken())
+	require.Empty(t, resp.GetAccessToken())
+	require.Empty(t, resp.GetRefreshToken())
+
+	svc.EXPECT().VerifyMFA(gomock.Any(), "mfa-token", "123456").Return("", services.ErrInvalidMFACode)
+	_, err = h.VerifyMFA(context.Background(), &authpb.VerifyMFARequest{MfaToken: "mfa-token", Code: "123456"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	svc.EXPECT().VerifyMFA(gomock.Any(), "mfa-token", "654321").Return("user-1", nil)
+	svc.EXPECT().IssueTokens(gomock.Any(), "user-1").Return(&model.TokenPair{AccessToken: "access", RefreshToken: "refresh", ExpiresAt: expiresAt}, nil)
+	resp, err = h.VerifyMFA(context.Background(), &authpb.VerifyMFARequest{MfaToken: "mfa-token", Code: "654321"})
+	require.NoError(t, err)
+	require.Equal(t, "access", resp.GetAccessToken())
+}
@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/migrations"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRunMigrations_AppliesPendingInOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	files := fstest.MapFS{
+		"0002_b.up.sql":   {Data: []byte("CREATE TABLE b ()")},
+		"0001_a.up.sql":   {Data: []byte("CREATE TABLE a ()")},
+		"0001_a.down.sql": {Data: []byte("DROP TABLE a")},
+	}
+	applied := regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM auth_schema_migrations WHERE version = $1)`)
+
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS auth_schema_migrations`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(applied).WithArgs("0001_a").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(applied).WithArgs("0002_b").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE b ()`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO auth_schema_migrations`)).WithArgs("0002_b").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.RunMigrations(db, files, zap.NewNop()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrations_Embedded(t *testing.T) {
+	entries, err := migrations.Files.ReadDir(".")
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.Equal(t, "0001_create_users.up.sql", entries[0].Name())
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testKey = func() *keys.Key {
+	k, err := keys.Generate(keys.AlgEdDSA)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}()
+
+func newRefreshTestService(t *testing.T) (services.AuthService, *MockRefreshTokenRepository) {
+	ctrl := gomock.NewController(t)
+	tokens := NewMockRefreshTokenRepository(ctrl)
+	users := NewMockUserRepository(ctrl)
+	users.EXPECT().GetUserByID(gomock.Any(), "user-1").Return(&model.User{ID: "user-1", Role: model.RoleUser}, nil).AnyTimes()
+	s := services.NewAuthService(users, tokens, NewMockRevokedTokenRepository(ctrl), nil, services.EmailConfig{}, services.LockoutConfig{}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{Access: 10 * time.Minute}, zap.NewNop())
+	return s, tokens
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestIssueTokens_StoresHashOnly(t *testing.T) {
+	s, tokens := newRefreshTestService(t)
+
+	var stored *model.RefreshToken
+	tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rt *model.RefreshToken) error {
+		stored = rt
+		return nil
+	})
+
+	pair, err := s.IssueTokens(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.NotEmpty(t, pair.RefreshToken)
+	require.Equal(t, sha256Hex(pair.RefreshToken), stored.TokenHash)
+	require.Empty(t, stored.FamilyID) // Новое семейство создаёт база
+	require.WithinDuration(t, time.Now().Add(services.DefaultRefreshTTL), stored.ExpiresAt, time.Minute)
+
+	// Access-токен короткоживущий
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), pair.ExpiresAt, time.Minute)
+	parsed, err := jwt.Parse(pair.AccessToken, func(*jwt.Token) (interface{}, error) { return testKey.Public(), nil })
+	require.NoError(t, err)
+	require.Equal(t, "user-1", parsed.Claims.(jwt.MapClaims)["user_id"])
+	require.NotEmpty(t, parsed.Claims.(jwt.MapClaims)["jti"])
+}
+
+func TestRefreshTokens_Rotates(t *testing.T) {
+	s, tokens := newRefreshTestService(t)
+	stored := &model.RefreshToken{ID: "rt-1", UserID: "user-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), sha256Hex("old-token")).Return(stored, nil)
+	tokens.EXPECT().RotateRefreshToken(gomock.Any(), "rt-1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, next *model.RefreshToken) error {
+		require.Equal(t, "fam-1", next.FamilyID)
+		require.Equal(t, "user-1", next.UserID)
+		return nil
+	})
+
+	pair, err := s.RefreshTokens(context.Background(), "old-token")
+	require.NoError(t, err)
+	require.NotEqual(t, "old-token", pair.RefreshToken)
+	require.NotEmpty(t, pair.AccessToken)
+}
+
+func TestRefreshTokens_ReuseRevokesFamily(t *testing.T) {
+	s, tokens := newRefreshTestService(t)
+	usedAt := time.Now().Add(-time.Minute)
+	stored := &model.RefreshToken{ID: "rt-1", UserID: "user-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour), UsedAt: &usedAt}
+
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(stored, nil)
+	tokens.EXPECT().RevokeFamily(gomock.Any(), "fam-1").Return(nil)
+
+	_, err := s.RefreshTokens(context.Background(), "old-token")
+	require.ErrorIs(t, err, services.ErrTokenReused)
+}
+
+func TestRefreshTokens_ConcurrentReuse(t *testing.T) {
+	s, tokens := newRefreshTestService(t)
+	stored := &model.RefreshToken{ID: "rt-1", UserID: "user-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(stored, nil)
+	tokens.EXPECT().RotateRefreshToken(gomock.Any(), "rt-1", gomock.Any()).Return(repo2.ErrRefreshTokenUsed)
+	tokens.EXPECT().RevokeFamily(gomock.Any(), "fam-1").Return(nil)
+
+	_, err := s.RefreshTokens(context.Background(), "old-token")
+	require.ErrorIs(t, err, services.ErrTokenReused)
+}
+
+func TestRefreshTokens_Invalid(t *testing.T) {
+	revokedAt := time.Now()
+	cases := map[string]*model.RefreshToken{
+		"expired": {ID: "rt-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(-time.Second)},
+		"revoked": {ID: "rt-1", FamilyID: "fam-1", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+	}
+	for name, stored := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, tokens := newRefreshTestService(t)
+			tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(stored, nil)
+			_, err := s.RefreshTokens(context.Background(), "old-token")
+			require.ErrorIs(t, err, services.ErrInvalidToken)
+		})
+	}
+
+	s, tokens := newRefreshTestService(t)
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(nil, repo2.ErrRefreshTokenNotFound)
+	_, err := s.RefreshTokens(context.Background(), "unknown")
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
+
+func TestRotateRefreshToken_AlreadyUsed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repo2.NewPostgresRefreshTokenRepository(db, zap.NewNop())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE refresh_tokens SET used_at = now() WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL`)).
+		WithArgs("rt-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.RotateRefreshToken(context.Background(), "rt-1", &model.RefreshToken{UserID: "user-1", FamilyID: "fam-1"})
+	require.ErrorIs(t, err, repo2.ErrRefreshTokenUsed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateRefreshToken_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repo2.NewPostgresRefreshTokenRepository(db, zap.NewNop())
+
+	next := &model.RefreshToken{UserID: "user-1", FamilyID: "fam-1", TokenHash: "hash", ExpiresAt: time.Now()}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE refresh_tokens SET used_at = now()`)).
+		WithArgs("rt-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO refresh_tokens`)).
+		WithArgs("user-1", "fam-1", "hash", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "family_id", "created_at"}).AddRow("rt-2", "fam-1", time.Now()))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.RotateRefreshToken(context.Background(), "rt-1", next))
+	require.Equal(t, "rt-2", next.ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
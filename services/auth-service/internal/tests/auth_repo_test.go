@@ -6,6 +6,7 @@ import (
 	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
@@ -29,9 +30,14 @@ func TestCreateUser(t *testing.T) {
 		Name:     "Test User",
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO users (email, password, name) VALUES ($1, $2, $3) RETURNING id`)).
 		WithArgs(user.Email, user.Password, user.Name).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(user.ID))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO outbox (topic, payload) VALUES ($1, $2)`)).
+		WithArgs(model.TopicUserRegistered, []byte(`{"user_id":"123","email":"test@example.com","name":"Test User"}`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
 	_, err = repo.CreateUser(context.Background(), user)
 	require.NoError(t, err)
@@ -56,9 +62,11 @@ func TestCreateUser_DuplicateEmail(t *testing.T) {
 
 	pqErr := &pq.Error{Code: "23505"}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO users (email, password, name) VALUES ($1, $2, $3) RETURNING id`)).
 		WithArgs(user.Email, user.Password, user.Name).
 		WillReturnError(pqErr)
+	mock.ExpectRollback()
 
 	_, err = repo.CreateUser(context.Background(), user)
 	require.Error(t, err)
@@ -81,12 +89,42 @@ func TestCreateUser_DBError(t *testing.T) {
 		Name:     "Test User",
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO users (email, password, name) VALUES ($1, $2, $3) RETURNING id`)).
 		WithArgs(user.Email, user.Password, user.Name).
 		WillReturnError(errors.New("some db error"))
+	mock.ExpectRollback()
 
 	_, err = repo.CreateUser(context.Background(), user)
 	require.Error(t, err)
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+// Отметки времени неудач и окно счёта передаются из часов сервиса в UTC, а не берутся из now() базы
+func TestRecordLoginFailure_UsesServiceClockInUTC(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repo2.NewPostgresLoginAttemptRepository(db, zap.NewNop())
+	now := time.Date(2025, 3, 1, 15, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	mock.ExpectQuery(`INSERT INTO login_attempts`).
+		WithArgs("account:anna@example.com", now.UTC(), now.Add(-15*time.Minute).UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "failures", "last_failure_at", "locked_until"}).
+			AddRow("account:anna@example.com", 2, now.UTC(), nil))
+
+	a, err := repo.RecordLoginFailure(context.Background(), "account:anna@example.com", now, 15*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, a.Failures)
+
+	mock.ExpectExec(`DELETE FROM login_attempts`).
+		WithArgs(now.Add(-time.Hour).UTC(), now.UTC()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	n, err := repo.DeleteStaleLoginAttempts(context.Background(), now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	repo2 "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newRevokeTestService(t *testing.T) (services.AuthService, *MockRefreshTokenRepository, *MockRevokedTokenRepository) {
+	ctrl := gomock.NewController(t)
+	tokens := NewMockRefreshTokenRepository(ctrl)
+	revoked := NewMockRevokedTokenRepository(ctrl)
+	s := services.NewAuthService(nil, tokens, revoked, nil, services.EmailConfig{}, services.LockoutConfig{}, services.MFAConfig{}, keys.NewStaticSet(testKey), services.TokenTTL{}, zap.NewNop())
+	return s, tokens, revoked
+}
+
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = testKey.ID
+	signed, err := token.SignedString(testKey.Private)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestVerifyToken_Revoked(t *testing.T) {
+	s, _, revoked := newRevokeTestService(t)
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": time.Now().Add(time.Minute).Unix()})
+
+	revoked.EXPECT().IsRevoked(gomock.Any(), "jti-1").Return(true, nil)
+	_, err := s.VerifyToken(context.Background(), token)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+
+	revoked.EXPECT().IsRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	userID, err := s.VerifyToken(context.Background(), token)
+	require.NoError(t, err)
+	require.Equal(t, "user-1", userID)
+}
+
+func TestVerifyToken_WithoutJTI(t *testing.T) {
+	s, _, _ := newRevokeTestService(t)
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "exp": time.Now().Add(time.Minute).Unix()})
+
+	_, err := s.VerifyToken(context.Background(), token)
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
+
+func TestLogout_RevokesAccessAndRefreshFamily(t *testing.T) {
+	s, tokens, revoked := newRevokeTestService(t)
+	exp := time.Now().Add(time.Minute).Truncate(time.Second)
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": exp.Unix()})
+
+	revoked.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rt *model.RevokedToken) error {
+		require.Equal(t, "jti-1", rt.JTI)
+		require.Equal(t, "user-1", rt.UserID)
+		require.True(t, exp.Equal(rt.ExpiresAt))
+		return nil
+	})
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), sha256Hex("refresh-1")).Return(&model.RefreshToken{UserID: "user-1", FamilyID: "fam-1"}, nil)
+	tokens.EXPECT().RevokeFamily(gomock.Any(), "fam-1").Return(nil)
+
+	require.NoError(t, s.Logout(context.Background(), token, "refresh-1"))
+}
+
+func TestLogout_ForeignRefreshTokenIgnored(t *testing.T) {
+	s, tokens, revoked := newRevokeTestService(t)
+	token := signTestToken(t, jwt.MapClaims{"user_id": "user-1", "jti": "jti-1", "exp": time.Now().Add(time.Minute).Unix()})
+
+	revoked.EXPECT().RevokeToken(gomock.Any(), gomock.Any()).Return(nil)
+	tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(&model.RefreshToken{UserID: "user-2", FamilyID: "fam-2"}, nil)
+
+	require.NoError(t, s.Logout(context.Background(), token, "refresh-of-user-2"))
+}
+
+func TestRevokeToken_InvalidToken(t *testing.T) {
+	s, _, _ := newRevokeTestService(t)
+	err := s.RevokeToken(context.Background(), "not-a-jwt")
+	require.ErrorIs(t, err, services.ErrInvalidToken)
+}
+
+func TestListRevokedSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := repo2.NewPostgresRevokedTokenRepository(db, zap.NewNop())
+
+	since := time.Now().Add(-time.Minute)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT jti, user_id, expires_at, revoked_at FROM revoked_tokens WHERE revoked_at > $1 AND expires_at > now()`)).
+		WithArgs(since.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"jti", "user_id", "expires_at", "revoked_at"}).
+			AddRow("jti-1", "user-1", time.Now().Add(time.Minute), time.Now()))
+
+	tokens, err := repo.ListRevokedSince(context.Background(), since)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, "jti-1", tokens[0].JTI)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,8 +7,11 @@ package tests
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	keys "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
 )
 
 // MockAuthService is a mock of AuthService interface.
@@ -34,6 +37,155 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeEmail mocks base method.
+func (m *MockAuthService) ChangeEmail(ctx context.Context, accessToken, password, newEmail string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEmail", ctx, accessToken, password, newEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeEmail indicates an expected call of ChangeEmail.
+func (mr *MockAuthServiceMockRecorder) ChangeEmail(ctx, accessToken, password, newEmail interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEmail", reflect.TypeOf((*MockAuthService)(nil).ChangeEmail), ctx, accessToken, password, newEmail)
+}
+
+// ChangePassword mocks base method.
+func (m *MockAuthService) ChangePassword(ctx context.Context, accessToken, oldPassword, newPassword string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, accessToken, oldPassword, newPassword)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceMockRecorder) ChangePassword(ctx, accessToken, oldPassword, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), ctx, accessToken, oldPassword, newPassword)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockAuthService) ConfirmEmailChange(ctx context.Context, token string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockAuthServiceMockRecorder) ConfirmEmailChange(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockAuthService)(nil).ConfirmEmailChange), ctx, token)
+}
+
+// ConfirmMFA mocks base method.
+func (m *MockAuthService) ConfirmMFA(ctx context.Context, accessToken, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmMFA", ctx, accessToken, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmMFA indicates an expected call of ConfirmMFA.
+func (mr *MockAuthServiceMockRecorder) ConfirmMFA(ctx, accessToken, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmMFA", reflect.TypeOf((*MockAuthService)(nil).ConfirmMFA), ctx, accessToken, code)
+}
+
+// DisableMFA mocks base method.
+func (m *MockAuthService) DisableMFA(ctx context.Context, accessToken, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", ctx, accessToken, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableMFA indicates an expected call of DisableMFA.
+func (mr *MockAuthServiceMockRecorder) DisableMFA(ctx, accessToken, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAuthService)(nil).DisableMFA), ctx, accessToken, code)
+}
+
+// EnrollMFA mocks base method.
+func (m *MockAuthService) EnrollMFA(ctx context.Context, accessToken string) (*model.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, accessToken)
+	ret0, _ := ret[0].(*model.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockAuthServiceMockRecorder) EnrollMFA(ctx, accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAuthService)(nil).EnrollMFA), ctx, accessToken)
+}
+
+// IssueTokens mocks base method.
+func (m *MockAuthService) IssueTokens(ctx context.Context, userID string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokens", ctx, userID)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueTokens indicates an expected call of IssueTokens.
+func (mr *MockAuthServiceMockRecorder) IssueTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokens", reflect.TypeOf((*MockAuthService)(nil).IssueTokens), ctx, userID)
+}
+
+// ListAudit mocks base method.
+func (m *MockAuthService) ListAudit(ctx context.Context, callerID string, filter model.AuditFilter) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAudit", ctx, callerID, filter)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAudit indicates an expected call of ListAudit.
+func (mr *MockAuthServiceMockRecorder) ListAudit(ctx, callerID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAudit", reflect.TypeOf((*MockAuthService)(nil).ListAudit), ctx, callerID, filter)
+}
+
+// ListRevoked mocks base method.
+func (m *MockAuthService) ListRevoked(ctx context.Context, since time.Time) ([]model.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevoked", ctx, since)
+	ret0, _ := ret[0].([]model.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevoked indicates an expected call of ListRevoked.
+func (mr *MockAuthServiceMockRecorder) ListRevoked(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevoked", reflect.TypeOf((*MockAuthService)(nil).ListRevoked), ctx, since)
+}
+
+// ListUsers mocks base method.
+func (m *MockAuthService) ListUsers(ctx context.Context, callerID string, filter model.UserFilter) ([]model.User, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, callerID, filter)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAuthServiceMockRecorder) ListUsers(ctx, callerID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAuthService)(nil).ListUsers), ctx, callerID, filter)
+}
+
 // Login mocks base method.
 func (m *MockAuthService) Login(ctx context.Context, email, password string) (string, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +201,63 @@ func (mr *MockAuthServiceMockRecorder) Login(ctx, email, password interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, email, password)
 }
 
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, accessToken, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, accessToken, refreshToken)
+}
+
+// PublicKeys mocks base method.
+func (m *MockAuthService) PublicKeys() []keys.JWK {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys")
+	ret0, _ := ret[0].([]keys.JWK)
+	return ret0
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockAuthServiceMockRecorder) PublicKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockAuthService)(nil).PublicKeys))
+}
+
+// RecordAudit mocks base method.
+func (m *MockAuthService) RecordAudit(ctx context.Context, callerID string, e *model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAudit", ctx, callerID, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAudit indicates an expected call of RecordAudit.
+func (mr *MockAuthServiceMockRecorder) RecordAudit(ctx, callerID, e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAudit", reflect.TypeOf((*MockAuthService)(nil).RecordAudit), ctx, callerID, e)
+}
+
+// RefreshTokens mocks base method.
+func (m *MockAuthService) RefreshTokens(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokens", ctx, refreshToken)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokens indicates an expected call of RefreshTokens.
+func (mr *MockAuthServiceMockRecorder) RefreshTokens(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockAuthService)(nil).RefreshTokens), ctx, refreshToken)
+}
+
 // Register mocks base method.
 func (m *MockAuthService) Register(ctx context.Context, email, password, name string) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +273,137 @@ func (mr *MockAuthServiceMockRecorder) Register(ctx, email, password, name inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthService)(nil).Register), ctx, email, password, name)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceMockRecorder) RequestPasswordReset(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthService)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceMockRecorder) ResetPassword(ctx, token, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthService)(nil).ResetPassword), ctx, token, newPassword)
+}
+
+// RevokeToken mocks base method.
+func (m *MockAuthService) RevokeToken(ctx context.Context, accessToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, accessToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockAuthServiceMockRecorder) RevokeToken(ctx, accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockAuthService)(nil).RevokeToken), ctx, accessToken)
+}
+
+// SetUserDisabled mocks base method.
+func (m *MockAuthService) SetUserDisabled(ctx context.Context, callerID, userID string, disabled bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserDisabled", ctx, callerID, userID, disabled)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserDisabled indicates an expected call of SetUserDisabled.
+func (mr *MockAuthServiceMockRecorder) SetUserDisabled(ctx, callerID, userID, disabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserDisabled", reflect.TypeOf((*MockAuthService)(nil).SetUserDisabled), ctx, callerID, userID, disabled)
+}
+
+// SetUserRole mocks base method.
+func (m *MockAuthService) SetUserRole(ctx context.Context, callerID, userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserRole", ctx, callerID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserRole indicates an expected call of SetUserRole.
+func (mr *MockAuthServiceMockRecorder) SetUserRole(ctx, callerID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserRole", reflect.TypeOf((*MockAuthService)(nil).SetUserRole), ctx, callerID, userID, role)
+}
+
+// StartMFAChallenge mocks base method.
+func (m *MockAuthService) StartMFAChallenge(ctx context.Context, userID string) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMFAChallenge", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartMFAChallenge indicates an expected call of StartMFAChallenge.
+func (mr *MockAuthServiceMockRecorder) StartMFAChallenge(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMFAChallenge", reflect.TypeOf((*MockAuthService)(nil).StartMFAChallenge), ctx, userID)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockAuthService) UnlockAccount(ctx context.Context, callerID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, callerID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockAuthServiceMockRecorder) UnlockAccount(ctx, callerID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockAuthService)(nil).UnlockAccount), ctx, callerID, userID)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthService) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthServiceMockRecorder) VerifyEmail(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthService)(nil).VerifyEmail), ctx, token)
+}
+
+// VerifyMFA mocks base method.
+func (m *MockAuthService) VerifyMFA(ctx context.Context, mfaToken, code string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, mfaToken, code)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthServiceMockRecorder) VerifyMFA(ctx, mfaToken, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthService)(nil).VerifyMFA), ctx, mfaToken, code)
+}
+
 // VerifyToken mocks base method.
 func (m *MockAuthService) VerifyToken(ctx context.Context, token string) (string, error) {
 	m.ctrl.T.Helper()
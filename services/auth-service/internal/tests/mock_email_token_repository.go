@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/auth-service/internal/repo/email_tokens.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// MockEmailTokenRepository is a mock of EmailTokenRepository interface.
+type MockEmailTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailTokenRepositoryMockRecorder
+}
+
+// MockEmailTokenRepositoryMockRecorder is the mock recorder for MockEmailTokenRepository.
+type MockEmailTokenRepositoryMockRecorder struct {
+	mock *MockEmailTokenRepository
+}
+
+// NewMockEmailTokenRepository creates a new mock instance.
+func NewMockEmailTokenRepository(ctrl *gomock.Controller) *MockEmailTokenRepository {
+	mock := &MockEmailTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailTokenRepository) EXPECT() *MockEmailTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeEmailToken mocks base method.
+func (m *MockEmailTokenRepository) ConsumeEmailToken(ctx context.Context, purpose, tokenHash string) (*model.EmailToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeEmailToken", ctx, purpose, tokenHash)
+	ret0, _ := ret[0].(*model.EmailToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeEmailToken indicates an expected call of ConsumeEmailToken.
+func (mr *MockEmailTokenRepositoryMockRecorder) ConsumeEmailToken(ctx, purpose, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeEmailToken", reflect.TypeOf((*MockEmailTokenRepository)(nil).ConsumeEmailToken), ctx, purpose, tokenHash)
+}
+
+// CreateEmailToken mocks base method.
+func (m *MockEmailTokenRepository) CreateEmailToken(ctx context.Context, t *model.EmailToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailToken", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailToken indicates an expected call of CreateEmailToken.
+func (mr *MockEmailTokenRepositoryMockRecorder) CreateEmailToken(ctx, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailToken", reflect.TypeOf((*MockEmailTokenRepository)(nil).CreateEmailToken), ctx, t)
+}
+
+// InvalidateEmailTokens mocks base method.
+func (m *MockEmailTokenRepository) InvalidateEmailTokens(ctx context.Context, userID, purpose string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateEmailTokens", ctx, userID, purpose)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateEmailTokens indicates an expected call of InvalidateEmailTokens.
+func (mr *MockEmailTokenRepositoryMockRecorder) InvalidateEmailTokens(ctx, userID, purpose interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateEmailTokens", reflect.TypeOf((*MockEmailTokenRepository)(nil).InvalidateEmailTokens), ctx, userID, purpose)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/auth-service/internal/repo/login_attempts.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// MockLoginAttemptRepository is a mock of LoginAttemptRepository interface.
+type MockLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptRepositoryMockRecorder
+}
+
+// MockLoginAttemptRepositoryMockRecorder is the mock recorder for MockLoginAttemptRepository.
+type MockLoginAttemptRepositoryMockRecorder struct {
+	mock *MockLoginAttemptRepository
+}
+
+// NewMockLoginAttemptRepository creates a new mock instance.
+func NewMockLoginAttemptRepository(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteStaleLoginAttempts mocks base method.
+func (m *MockLoginAttemptRepository) DeleteStaleLoginAttempts(ctx context.Context, before, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleLoginAttempts", ctx, before, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleLoginAttempts indicates an expected call of DeleteStaleLoginAttempts.
+func (mr *MockLoginAttemptRepositoryMockRecorder) DeleteStaleLoginAttempts(ctx, before, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleLoginAttempts", reflect.TypeOf((*MockLoginAttemptRepository)(nil).DeleteStaleLoginAttempts), ctx, before, now)
+}
+
+// GetLoginAttempts mocks base method.
+func (m *MockLoginAttemptRepository) GetLoginAttempts(ctx context.Context, keys []string) ([]model.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginAttempts", ctx, keys)
+	ret0, _ := ret[0].([]model.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginAttempts indicates an expected call of GetLoginAttempts.
+func (mr *MockLoginAttemptRepositoryMockRecorder) GetLoginAttempts(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginAttempts", reflect.TypeOf((*MockLoginAttemptRepository)(nil).GetLoginAttempts), ctx, keys)
+}
+
+// LockLogin mocks base method.
+func (m *MockLoginAttemptRepository) LockLogin(ctx context.Context, key string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", ctx, key, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockLoginAttemptRepositoryMockRecorder) LockLogin(ctx, key, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockLoginAttemptRepository)(nil).LockLogin), ctx, key, until)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockLoginAttemptRepository) RecordLoginFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*model.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, key, now, window)
+	ret0, _ := ret[0].(*model.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockLoginAttemptRepositoryMockRecorder) RecordLoginFailure(ctx, key, now, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockLoginAttemptRepository)(nil).RecordLoginFailure), ctx, key, now, window)
+}
+
+// ResetLoginAttempts mocks base method.
+func (m *MockLoginAttemptRepository) ResetLoginAttempts(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginAttempts", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetLoginAttempts indicates an expected call of ResetLoginAttempts.
+func (mr *MockLoginAttemptRepositoryMockRecorder) ResetLoginAttempts(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginAttempts", reflect.TypeOf((*MockLoginAttemptRepository)(nil).ResetLoginAttempts), ctx, key)
+}
@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/auth-service/internal/repo/mfa.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// MockMFARepository is a mock of MFARepository interface.
+type MockMFARepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFARepositoryMockRecorder
+}
+
+// MockMFARepositoryMockRecorder is the mock recorder for MockMFARepository.
+type MockMFARepositoryMockRecorder struct {
+	mock *MockMFARepository
+}
+
+// NewMockMFARepository creates a new mock instance.
+func NewMockMFARepository(ctrl *gomock.Controller) *MockMFARepository {
+	mock := &MockMFARepository{ctrl: ctrl}
+	mock.recorder = &MockMFARepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFARepository) EXPECT() *MockMFARepositoryMockRecorder {
+	return m.recorder
+}
+
+// CompleteMFAChallenge mocks base method.
+func (m *MockMFARepository) CompleteMFAChallenge(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMFAChallenge", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMFAChallenge indicates an expected call of CompleteMFAChallenge.
+func (mr *MockMFARepositoryMockRecorder) CompleteMFAChallenge(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMFAChallenge", reflect.TypeOf((*MockMFARepository)(nil).CompleteMFAChallenge), ctx, id)
+}
+
+// CreateMFAChallenge mocks base method.
+func (m *MockMFARepository) CreateMFAChallenge(ctx context.Context, c *model.MFAChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMFAChallenge", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMFAChallenge indicates an expected call of CreateMFAChallenge.
+func (mr *MockMFARepositoryMockRecorder) CreateMFAChallenge(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMFAChallenge", reflect.TypeOf((*MockMFARepository)(nil).CreateMFAChallenge), ctx, c)
+}
+
+// DeleteMFA mocks base method.
+func (m *MockMFARepository) DeleteMFA(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMFA", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMFA indicates an expected call of DeleteMFA.
+func (mr *MockMFARepositoryMockRecorder) DeleteMFA(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMFA", reflect.TypeOf((*MockMFARepository)(nil).DeleteMFA), ctx, userID)
+}
+
+// EnableMFA mocks base method.
+func (m *MockMFARepository) EnableMFA(ctx context.Context, userID string, step int64, recoveryCodeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableMFA", ctx, userID, step, recoveryCodeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableMFA indicates an expected call of EnableMFA.
+func (mr *MockMFARepositoryMockRecorder) EnableMFA(ctx, userID, step, recoveryCodeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableMFA", reflect.TypeOf((*MockMFARepository)(nil).EnableMFA), ctx, userID, step, recoveryCodeHashes)
+}
+
+// GetMFA mocks base method.
+func (m *MockMFARepository) GetMFA(ctx context.Context, userID string) (*model.MFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMFA", ctx, userID)
+	ret0, _ := ret[0].(*model.MFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFA indicates an expected call of GetMFA.
+func (mr *MockMFARepositoryMockRecorder) GetMFA(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFA", reflect.TypeOf((*MockMFARepository)(nil).GetMFA), ctx, userID)
+}
+
+// GetMFAChallenge mocks base method.
+func (m *MockMFARepository) GetMFAChallenge(ctx context.Context, tokenHash string) (*model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMFAChallenge", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.MFAChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFAChallenge indicates an expected call of GetMFAChallenge.
+func (mr *MockMFARepositoryMockRecorder) GetMFAChallenge(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFAChallenge", reflect.TypeOf((*MockMFARepository)(nil).GetMFAChallenge), ctx, tokenHash)
+}
+
+// RecordMFAChallengeFailure mocks base method.
+func (m *MockMFARepository) RecordMFAChallengeFailure(ctx context.Context, id string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMFAChallengeFailure", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordMFAChallengeFailure indicates an expected call of RecordMFAChallengeFailure.
+func (mr *MockMFARepositoryMockRecorder) RecordMFAChallengeFailure(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMFAChallengeFailure", reflect.TypeOf((*MockMFARepository)(nil).RecordMFAChallengeFailure), ctx, id)
+}
+
+// SaveMFASecret mocks base method.
+func (m *MockMFARepository) SaveMFASecret(ctx context.Context, userID string, secret []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMFASecret", ctx, userID, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMFASecret indicates an expected call of SaveMFASecret.
+func (mr *MockMFARepositoryMockRecorder) SaveMFASecret(ctx, userID, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMFASecret", reflect.TypeOf((*MockMFARepository)(nil).SaveMFASecret), ctx, userID, secret)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockMFARepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockMFARepositoryMockRecorder) UseRecoveryCode(ctx, userID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockMFARepository)(nil).UseRecoveryCode), ctx, userID, codeHash)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockMFARepository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockMFARepositoryMockRecorder) UseTOTPStep(ctx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockMFARepository)(nil).UseTOTPStep), ctx, userID, step)
+}
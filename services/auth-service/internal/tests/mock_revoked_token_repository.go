@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/auth-service/internal/repo/revoked_tokens.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// MockRevokedTokenRepository is a mock of RevokedTokenRepository interface.
+type MockRevokedTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokedTokenRepositoryMockRecorder
+}
+
+// MockRevokedTokenRepositoryMockRecorder is the mock recorder for MockRevokedTokenRepository.
+type MockRevokedTokenRepositoryMockRecorder struct {
+	mock *MockRevokedTokenRepository
+}
+
+// NewMockRevokedTokenRepository creates a new mock instance.
+func NewMockRevokedTokenRepository(ctrl *gomock.Controller) *MockRevokedTokenRepository {
+	mock := &MockRevokedTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRevokedTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokedTokenRepository) EXPECT() *MockRevokedTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpired mocks base method.
+func (m *MockRevokedTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRevokedTokenRepositoryMockRecorder) DeleteExpired(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRevokedTokenRepository)(nil).DeleteExpired), ctx, before)
+}
+
+// IsRevoked mocks base method.
+func (m *MockRevokedTokenRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockRevokedTokenRepositoryMockRecorder) IsRevoked(ctx, jti interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockRevokedTokenRepository)(nil).IsRevoked), ctx, jti)
+}
+
+// ListRevokedSince mocks base method.
+func (m *MockRevokedTokenRepository) ListRevokedSince(ctx context.Context, since time.Time) ([]model.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevokedSince", ctx, since)
+	ret0, _ := ret[0].([]model.RevokedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevokedSince indicates an expected call of ListRevokedSince.
+func (mr *MockRevokedTokenRepositoryMockRecorder) ListRevokedSince(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevokedSince", reflect.TypeOf((*MockRevokedTokenRepository)(nil).ListRevokedSince), ctx, since)
+}
+
+// RevokeToken mocks base method.
+func (m *MockRevokedTokenRepository) RevokeToken(ctx context.Context, t *model.RevokedToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockRevokedTokenRepositoryMockRecorder) RevokeToken(ctx, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockRevokedTokenRepository)(nil).RevokeToken), ctx, t)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services/auth-service/internal/repo/signing_keys.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+)
+
+// MockSigningKeyRepository is a mock of SigningKeyRepository interface.
+type MockSigningKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyRepositoryMockRecorder
+}
+
+// MockSigningKeyRepositoryMockRecorder is the mock recorder for MockSigningKeyRepository.
+type MockSigningKeyRepositoryMockRecorder struct {
+	mock *MockSigningKeyRepository
+}
+
+// NewMockSigningKeyRepository creates a new mock instance.
+func NewMockSigningKeyRepository(ctrl *gomock.Controller) *MockSigningKeyRepository {
+	mock := &MockSigningKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyRepository) EXPECT() *MockSigningKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSigningKey mocks base method.
+func (m *MockSigningKeyRepository) CreateSigningKey(ctx context.Context, k *model.SigningKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSigningKey", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSigningKey indicates an expected call of CreateSigningKey.
+func (mr *MockSigningKeyRepositoryMockRecorder) CreateSigningKey(ctx, k interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSigningKey", reflect.TypeOf((*MockSigningKeyRepository)(nil).CreateSigningKey), ctx, k)
+}
+
+// DeleteSigningKey mocks base method.
+func (m *MockSigningKeyRepository) DeleteSigningKey(ctx context.Context, kid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSigningKey", ctx, kid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSigningKey indicates an expected call of DeleteSigningKey.
+func (mr *MockSigningKeyRepositoryMockRecorder) DeleteSigningKey(ctx, kid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSigningKey", reflect.TypeOf((*MockSigningKeyRepository)(nil).DeleteSigningKey), ctx, kid)
+}
+
+// ListSigningKeys mocks base method.
+func (m *MockSigningKeyRepository) ListSigningKeys(ctx context.Context) ([]model.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSigningKeys", ctx)
+	ret0, _ := ret[0].([]model.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSigningKeys indicates an expected call of ListSigningKeys.
+func (mr *MockSigningKeyRepositoryMockRecorder) ListSigningKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSigningKeys", reflect.TypeOf((*MockSigningKeyRepository)(nil).ListSigningKeys), ctx)
+}
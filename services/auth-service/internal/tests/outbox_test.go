@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/grpcclients"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/outbox"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestDispatcher_MarksProcessed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockOutboxRepository(ctrl)
+
+	event := model.OutboxEvent{ID: 1, Topic: model.TopicUserRegistered, Payload: []byte(`{}`)}
+	mockRepo.EXPECT().ClaimEvents(gomock.Any(), outbox.DefaultBatchSize, outbox.DefaultLease).Return([]model.OutboxEvent{event}, nil)
+	mockRepo.EXPECT().MarkProcessed(gomock.Any(), int64(1)).Return(nil)
+
+	d := outbox.NewDispatcher(mockRepo, zap.NewNop())
+	var got model.OutboxEvent
+	d.Handle(model.TopicUserRegistered, func(ctx context.Context, e model.OutboxEvent) error {
+		got = e
+		return nil
+	})
+
+	n, err := d.Dispatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, event, got)
+}
+
+func TestDispatcher_RetriesWithBackoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockOutboxRepository(ctrl)
+
+	events := []model.OutboxEvent{
+		{ID: 1, Topic: model.TopicUserRegistered, Attempts: 2},
+		{ID: 2, Topic: "unknown.topic"},
+	}
+	mockRepo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Any()).Return(events, nil)
+
+	start := time.Now()
+	mockRepo.EXPECT().MarkFailed(gomock.Any(), int64(1), gomock.Any(), "user-service unavailable").
+		DoAndReturn(func(_ context.Context, _ int64, retryAt time.Time, _ string) error {
+			// Третья попытка: задержка 4s
+			require.WithinDuration(t, start.Add(4*time.Second), retryAt, time.Second)
+			return nil
+		})
+	mockRepo.EXPECT().MarkFailed(gomock.Any(), int64(2), gomock.Any(), gomock.Any()).Return(nil)
+
+	d := outbox.NewDispatcher(mockRepo, zap.NewNop())
+	d.Handle(model.TopicUserRegistered, func(ctx context.Context, e model.OutboxEvent) error {
+		return errors.New("user-service unavailable")
+	})
+
+	n, err := d.Dispatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, time.Second, outbox.Backoff(1))
+	require.Equal(t, 8*time.Second, outbox.Backoff(4))
+	require.Equal(t, 10*time.Minute, outbox.Backoff(30))
+}
+
+type fakeUserServer struct {
+	userpb.UnimplementedUserServiceServer
+	requests []*userpb.CreateUserProfileRequest
+	callers  []string
+}
+
+func (s *fakeUserServer) CreateUserProfile(ctx context.Context, req *userpb.CreateUserProfileRequest) (*userpb.CreateUserProfileResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.requests = append(s.requests, req)
+	s.callers = append(s.callers, md.Get("x-service-token")...)
+	return &userpb.CreateUserProfileResponse{Created: len(s.requests) == 1}, nil
+}
+
+func TestUserClient_HandleUserRegistered(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	users := &fakeUserServer{}
+	userpb.RegisterUserServiceServer(srv, users)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := grpcclients.NewUserClient(conn, "service-secret", zap.NewNop())
+	event := model.OutboxEvent{
+		ID:      1,
+		Topic:   model.TopicUserRegistered,
+		Payload: []byte(`{"user_id":"user-1","email":"a@example.com","name":"Anna"}`),
+	}
+	// Повторная доставка того же события не считается ошибкой
+	require.NoError(t, client.HandleUserRegistered(context.Background(), event))
+	require.NoError(t, client.HandleUserRegistered(context.Background(), event))
+
+	require.Len(t, users.requests, 2)
+	require.Equal(t, "user-1", users.requests[0].GetUserId())
+	require.Equal(t, "a@example.com", users.requests[0].GetEmail())
+	require.Equal(t, "Anna", users.requests[0].GetName())
+	require.Equal(t, []string{"service-secret", "service-secret"}, users.callers)
+
+	require.Error(t, client.HandleUserRegistered(context.Background(), model.OutboxEvent{Payload: []byte(`not json`)}))
+}
@@ -0,0 +1,93 @@
+// Пакет totp реализует одноразовые пароли по времени (RFC 6238) в варианте,
+// который понимают приложения-аутентификаторы: HMAC-SHA1, 6 цифр, шаг 30 секунд
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	Digits = 6
+	Period = 30 * time.Second
+	// Допустимое расхождение часов клиента и сервера, в шагах
+	Skew = 1
+
+	secretSize = 20
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Новый случайный секрет (160 бит)
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// Секрет в base32 — в таком виде его вводят в приложение вручную
+func EncodeSecret(secret []byte) string {
+	return b32.EncodeToString(secret)
+}
+
+// otpauth:// URI для QR-кода (формат Google Authenticator Key URI)
+func URI(issuer, account string, secret []byte) string {
+	label := url.PathEscape(issuer + ":" + account)
+	q := url.Values{}
+	q.Set("secret", EncodeSecret(secret))
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period.Seconds())))
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Номер шага для момента t
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Код для шага step
+func Code(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1_000_000)
+}
+
+// Проверяет код на момент now с учётом Skew и возвращает шаг, которому он соответствует.
+// Чтобы код нельзя было использовать повторно, вызывающий запоминает шаг
+// и не принимает коды с тем же или более ранним шагом
+func Validate(secret []byte, code string, now time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+	current := Step(now)
+	for d := int64(-Skew); d <= Skew; d++ {
+		if subtle.ConstantTimeCompare([]byte(Code(secret, current+d)), []byte(code)) == 1 {
+			return current + d, true
+		}
+	}
+	return 0, false
+}
+
+// Секрет из base32, как его показывают пользователю
+func Secret(encoded string) ([]byte, error) {
+	return b32.DecodeString(strings.ToUpper(strings.ReplaceAll(encoded, " ", "")))
+}
@@ -1,17 +1,27 @@
 package main
 
 import (
+	"context"
 	"net"
 	"os"
+	"time"
 
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/crypt"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/grpcclients"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/handlers"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/keys"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/mailer"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/outbox"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/repo"
 	"github.com/khaldeezal/Finplan-structure/services/auth-service/internal/services"
+	"github.com/khaldeezal/Finplan-structure/services/auth-service/migrations"
 	"go.uber.org/zap"
 
 	"github.com/joho/godotenv"
 	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -36,16 +46,111 @@ func main() {
 	}
 
 	// Запуск миграции
-	if err := repo.RunMigrations(db, logger); err != nil {
+	if err := repo.RunMigrations(db, migrations.Files, logger); err != nil {
 		logger.Fatal("Ошибка применения миграций", zap.Error(err))
 	}
 
 	userRepo := repo.NewPostgresUserRepository(db, logger)
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		logger.Fatal("JWT_SECRET is not set in environment")
+	tokenRepo := repo.NewPostgresRefreshTokenRepository(db, logger)
+	revokedRepo := repo.NewPostgresRevokedTokenRepository(db, logger)
+
+	// Сроки жизни токенов, например ACCESS_TOKEN_TTL=15m, REFRESH_TOKEN_TTL=720h
+	ttl := services.TokenTTL{
+		Access:  durationEnv(logger, "ACCESS_TOKEN_TTL", services.DefaultAccessTTL),
+		Refresh: durationEnv(logger, "REFRESH_TOKEN_TTL", services.DefaultRefreshTTL),
+	}
+
+	// Ключи подписи: JWT_SIGNING_ALG=EdDSA|RS256, новый ключ раз в JWT_KEY_ROTATION.
+	// Заменённый ключ публикуется, пока не истекут подписанные им токены
+	keySet, err := keys.NewKeySet(repo.NewPostgresSigningKeyRepository(db, logger), keys.Options{
+		Algorithm: os.Getenv("JWT_SIGNING_ALG"),
+		Rotation:  durationEnv(logger, "JWT_KEY_ROTATION", keys.DefaultRotation),
+		Retention: ttl.Access + time.Minute,
+	}, logger)
+	if err != nil {
+		logger.Fatal("invalid signing key settings", zap.Error(err))
+	}
+	if err := keySet.Refresh(context.Background()); err != nil {
+		logger.Fatal("failed to load signing keys", zap.Error(err))
+	}
+	go keySet.Run(context.Background(), time.Minute)
+
+	// Письма подтверждения email и сброса пароля
+	email := services.EmailConfig{
+		Tokens:         repo.NewPostgresEmailTokenRepository(db, logger),
+		Mailer:         newMailer(logger),
+		VerifyURL:      os.Getenv("EMAIL_VERIFY_URL"),
+		ResetURL:       os.Getenv("PASSWORD_RESET_URL"),
+		ChangeEmailURL: os.Getenv("CHANGE_EMAIL_URL"),
+	}
+
+	// Защита входа от перебора паролей
+	loginAttempts := repo.NewPostgresLoginAttemptRepository(db, logger)
+	lockout := services.LockoutConfig{Attempts: loginAttempts}
+
+	// Второй фактор: секреты TOTP шифруются ключом MFA_ENCRYPTION_KEY (32 байта в base64).
+	// Ключа по умолчанию нет: без него сервис не запускается
+	mfaKey := os.Getenv("MFA_ENCRYPTION_KEY")
+	if mfaKey == "" {
+		logger.Fatal("MFA_ENCRYPTION_KEY is not set; generate one with `openssl rand -base64 32`")
+	}
+	sealer, err := crypt.NewSealerFromBase64(mfaKey)
+	if err != nil {
+		logger.Fatal("invalid MFA_ENCRYPTION_KEY", zap.Error(err))
+	}
+	mfa := services.MFAConfig{
+		Store:  repo.NewPostgresMFARepository(db, logger),
+		Sealer: sealer,
+		Issuer: os.Getenv("MFA_ISSUER"),
+	}
+
+	userService := services.NewAuthService(userRepo, tokenRepo, revokedRepo, repo.NewPostgresAuditRepository(db, logger), email, lockout, mfa, keySet, ttl, logger)
+
+	// Профили в user-service создаются и получают новый email по событиям из outbox.
+	// Эти вызовы user-service принимает только с общим токеном сервиса
+	userServiceToken := os.Getenv("USER_SERVICE_TOKEN")
+	if userServiceToken == "" {
+		logger.Fatal("USER_SERVICE_TOKEN is not set; it must match SERVICE_TOKEN of user-service")
+	}
+	userConn, err := grpc.Dial(os.Getenv("USER_SERVICE_ADDR"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("failed to connect to user-service", zap.Error(err))
 	}
-	userService := services.NewAuthService(userRepo, jwtSecret, logger)
+	defer userConn.Close()
+	dispatcher := outbox.NewDispatcher(repo.NewPostgresOutboxRepository(db, logger), logger)
+	userClient := grpcclients.NewUserClient(userConn, userServiceToken, logger)
+	dispatcher.Handle(model.TopicUserRegistered, userClient.HandleUserRegistered)
+	dispatcher.Handle(model.TopicUserEmailChanged, userClient.HandleUserEmailChanged)
+	go dispatcher.Run(context.Background(), durationEnv(logger, "OUTBOX_POLL_INTERVAL", time.Second))
+
+	// Записи об отозванных токенах нужны только до истечения их срока
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			n, err := revokedRepo.DeleteExpired(context.Background(), time.Now())
+			if err != nil {
+				logger.Error("failed to purge revoked tokens", zap.Error(err))
+				continue
+			}
+			logger.Info("purged expired revoked tokens", zap.Int64("count", n))
+		}
+	}()
+
+	// Счётчики неудачных входов старше окна блокировки аккаунта больше не влияют на вход
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			now := time.Now()
+			n, err := loginAttempts.DeleteStaleLoginAttempts(context.Background(), now.Add(-services.DefaultAccountLockout.Window), now)
+			if err != nil {
+				logger.Error("failed to purge login attempts", zap.Error(err))
+				continue
+			}
+			logger.Info("purged stale login attempts", zap.Int64("count", n))
+		}
+	}()
 
 	// Адрес из переменной окружения AUTH_SERVICE_ADDR
 	addr := os.Getenv("AUTH_SERVICE_ADDR")
@@ -74,3 +179,47 @@ func main() {
 	}
 
 }
+
+// Длительность из переменной окружения, например 15m или 720h
+func durationEnv(logger *zap.Logger, env string, def time.Duration) time.Duration {
+	v := os.Getenv(env)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		logger.Fatal("invalid duration", zap.String("env", env), zap.Error(err))
+	}
+	return d
+}
+
+// Способ отправки писем из MAIL_DRIVER: smtp (SMTP_HOST, SMTP_PORT, SMTP_USERNAME,
+// SMTP_PASSWORD, MAIL_FROM), file (MAIL_FILE) или log — по умолчанию, только для разработки
+func newMailer(logger *zap.Logger) mailer.Mailer {
+	switch driver := os.Getenv("MAIL_DRIVER"); driver {
+	case "smtp":
+		m, err := mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("MAIL_FROM"),
+		})
+		if err != nil {
+			logger.Fatal("invalid smtp settings", zap.Error(err))
+		}
+		return m
+	case "file":
+		path := os.Getenv("MAIL_FILE")
+		if path == "" {
+			path = "mail.log"
+		}
+		return mailer.NewFileMailer(path)
+	case "", "log":
+		logger.Warn("emails are written to the log, set MAIL_DRIVER=smtp in production")
+		return mailer.NewLogMailer(logger)
+	default:
+		logger.Fatal("unknown MAIL_DRIVER", zap.String("driver", driver))
+		return nil
+	}
+}
@@ -0,0 +1,7 @@
+-- Ключи подписи access-токенов. Открытые части публикуются через JWKS
+CREATE TABLE IF NOT EXISTS signing_keys (
+    kid TEXT PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
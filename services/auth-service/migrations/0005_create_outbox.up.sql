@@ -0,0 +1,24 @@
+-- Исходящие события. Пишутся в одной транзакции с изменением данных
+-- и доставляются фоновым обработчиком с повторами
+DO $$
+BEGIN
+    IF to_regclass('outbox') IS NULL THEN
+        CREATE TABLE outbox (
+            id BIGSERIAL PRIMARY KEY,
+            topic TEXT NOT NULL,
+            payload JSONB NOT NULL,
+            attempts INT NOT NULL DEFAULT 0,
+            next_attempt_at TIMESTAMP NOT NULL DEFAULT now(),
+            last_error TEXT,
+            created_at TIMESTAMP NOT NULL DEFAULT now(),
+            processed_at TIMESTAMP
+        );
+        CREATE INDEX idx_outbox_pending ON outbox (next_attempt_at) WHERE processed_at IS NULL;
+
+        -- Профили для пользователей, зарегистрированных до появления outbox.
+        -- Только при создании таблицы, чтобы повторный прогон не дублировал события
+        INSERT INTO outbox (topic, payload)
+        SELECT 'user.registered', jsonb_build_object('user_id', id, 'email', email, 'name', name)
+        FROM users ORDER BY created_at;
+    END IF;
+END $$;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS email_tokens;
+DROP INDEX IF EXISTS idx_refresh_tokens_user;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
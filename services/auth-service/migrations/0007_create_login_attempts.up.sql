@@ -0,0 +1,7 @@
+-- Неудачные попытки входа по аккаунту (account:<email>) и IP (ip:<адрес>)
+CREATE TABLE IF NOT EXISTS login_attempts (
+    key TEXT PRIMARY KEY,
+    failures INT NOT NULL,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP
+);
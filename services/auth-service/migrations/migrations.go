@@ -0,0 +1,8 @@
+// Package migrations — SQL-миграции схемы auth-service, встроенные в бинарник.
+// Применяются при старте сервиса (repo.RunMigrations)
+package migrations
+
+import "embed"
+
+//go:embed *.up.sql
+var Files embed.FS
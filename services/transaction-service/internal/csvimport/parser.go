@@ -0,0 +1,216 @@
+// Разбор банковских выписок в формате CSV
+package csvimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// Файл нельзя разобрать целиком: нет заголовка, нужной колонки или слишком много строк
+var ErrInvalidFile = errors.New("invalid import file")
+
+// Колонки по умолчанию, если в настройках не заданы свои названия
+var defaultColumns = model.ImportColumns{
+	Date:        "date",
+	Amount:      "amount",
+	Description: "description",
+	Type:        "type",
+	CategoryID:  "category_id",
+	Currency:    "currency",
+}
+
+// Индексы колонок в заголовке; -1 — колонки нет
+type columnIndex struct {
+	date, amount, description, txType, categoryID, currency int
+}
+
+// Разбирает CSV с заголовком. Ошибки отдельных строк не прерывают разбор,
+// а возвращаются в ImportRow.Err; error — только для файла в целом
+func Parse(data []byte, opts model.ImportOptions) ([]model.ImportRow, error) {
+	layout := dateLayout(opts.DateFormat)
+	if opts.DecimalSeparator != "" && opts.DecimalSeparator != "." && opts.DecimalSeparator != "," {
+		return nil, fmt.Errorf("%w: decimal separator must be \".\" or \",\"", ErrInvalidFile)
+	}
+
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	if opts.Delimiter != 0 {
+		r.Comma = opts.Delimiter
+	}
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: empty file", ErrInvalidFile)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	idx, err := indexColumns(header, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []model.ImportRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+			}
+			rows = append(rows, model.ImportRow{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		if isBlank(record) {
+			continue
+		}
+		if len(rows) == model.MaxImportRows {
+			return nil, fmt.Errorf("%w: more than %d rows", ErrInvalidFile, model.MaxImportRows)
+		}
+		tx, err := parseRecord(record, idx, layout, opts)
+		rows = append(rows, model.ImportRow{Line: line, Transaction: tx, Err: err})
+	}
+	return rows, nil
+}
+
+// Ищет колонки в заголовке без учёта регистра. Дата и сумма обязательны,
+// остальные колонки по умолчанию необязательны, но заданные явно должны быть в файле
+func indexColumns(header []string, columns model.ImportColumns) (columnIndex, error) {
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	find := func(name, fallback string, required bool) (int, error) {
+		explicit := name != ""
+		if !explicit {
+			name = fallback
+		}
+		if i, ok := positions[strings.ToLower(strings.TrimSpace(name))]; ok {
+			return i, nil
+		}
+		if required || explicit {
+			return -1, fmt.Errorf("%w: column %q not found", ErrInvalidFile, name)
+		}
+		return -1, nil
+	}
+
+	var idx columnIndex
+	var err error
+	for _, c := range []struct {
+		dst      *int
+		name     string
+		fallback string
+		required bool
+	}{
+		{&idx.date, columns.Date, defaultColumns.Date, true},
+		{&idx.amount, columns.Amount, defaultColumns.Amount, true},
+		{&idx.description, columns.Description, defaultColumns.Description, false},
+		{&idx.txType, columns.Type, defaultColumns.Type, false},
+		{&idx.categoryID, columns.CategoryID, defaultColumns.CategoryID, false},
+		{&idx.currency, columns.Currency, defaultColumns.Currency, false},
+	} {
+		if *c.dst, err = find(c.name, c.fallback, c.required); err != nil {
+			return columnIndex{}, err
+		}
+	}
+	return idx, nil
+}
+
+// Собирает транзакцию из строки. Без колонки type тип определяется по знаку суммы:
+// отрицательная — расход, положительная — доход
+func parseRecord(record []string, idx columnIndex, layout string, opts model.ImportOptions) (*model.Transaction, error) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date, err := time.Parse(layout, field(idx.date))
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q", field(idx.date))
+	}
+	amount, err := parseAmount(field(idx.amount), opts.DecimalSeparator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount %q", field(idx.amount))
+	}
+
+	tx := &model.Transaction{
+		Amount:      amount,
+		Description: field(idx.description),
+		Date:        date,
+		CategoryID:  field(idx.categoryID),
+		Currency:    field(idx.currency),
+	}
+	if amount < 0 {
+		tx.Amount = -amount
+	}
+
+	switch t := strings.ToUpper(field(idx.txType)); {
+	case idx.txType >= 0 && t != "":
+		tx.Type = model.TransactionType(t)
+	case amount < 0:
+		tx.Type = model.Expense
+	default:
+		tx.Type = model.Income
+	}
+
+	if tx.CategoryID == "" {
+		switch tx.Type {
+		case model.Income:
+			tx.CategoryID = opts.DefaultIncomeCategoryID
+		case model.Expense:
+			tx.CategoryID = opts.DefaultExpenseCategoryID
+		}
+	}
+	if tx.Currency == "" {
+		tx.Currency = opts.Currency
+	}
+	return tx, nil
+}
+
+// Приводит сумму к виду "-1234.56": убирает разделители разрядов
+// и заменяет десятичную запятую на точку
+func parseAmount(s, decimalSeparator string) (model.Money, error) {
+	s = strings.NewReplacer(" ", "", " ", "", "'", "").Replace(s)
+	if decimalSeparator == "," {
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.ReplaceAll(s, ",", ".")
+	} else {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+	return model.ParseMoney(s)
+}
+
+// Переводит формат вида "DD.MM.YYYY" в layout для time.Parse.
+// Формат, уже записанный как Go layout, используется как есть
+func dateLayout(format string) string {
+	if format == "" {
+		return "2006-01-02"
+	}
+	if strings.Contains(format, "2006") {
+		return format
+	}
+	return strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(format)
+}
+
+func isBlank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// Нет курса для пары валют
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// Источник курсов валют. Реализации можно подменять: файл, таблица, внешний API
+type RateProvider interface {
+	// Сколько единиц валюты to стоит одна единица валюты from
+	Rate(ctx context.Context, from, to string) (*big.Rat, error)
+}
+
+// Пересчитывает сумму из одной валюты в другую с округлением до копеек
+func Convert(ctx context.Context, p RateProvider, amount model.Money, from, to string) (model.Money, error) {
+	if from == to {
+		return amount, nil
+	}
+	rate, err := p.Rate(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(int64(amount)), rate)
+	return roundRat(v)
+}
+
+// Округляет до целого по правилу «половина — от нуля»
+func roundRat(v *big.Rat) (model.Money, error) {
+	num := new(big.Int).Abs(v.Num())
+	den := v.Denom()
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Lsh(r, 1).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if !q.IsInt64() {
+		return 0, fmt.Errorf("%w: converted amount overflows", model.ErrInvalidMoney)
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	return model.Money(q.Int64()), nil
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Курсы из локального JSON-файла вида
+//
+//	{"base": "RUB", "rates": {"RUB": "1", "USD": "92.50", "EUR": "100.10"}}
+//
+// где rates — стоимость единицы валюты в базовой валюте.
+// Кросс-курс считается через базовую валюту
+type StaticProvider struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+type ratesFile struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+// Загружает курсы из файла
+func NewFileProvider(path string) (*StaticProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read rates file: %w", err)
+	}
+	var f ratesFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse rates file: %w", err)
+	}
+	return NewStaticProvider(f.Base, f.Rates)
+}
+
+// Создаёт провайдер из готовой таблицы курсов (десятичные строки)
+func NewStaticProvider(base string, rates map[string]string) (*StaticProvider, error) {
+	if base == "" {
+		return nil, fmt.Errorf("base currency is required")
+	}
+	p := &StaticProvider{base: base, rates: map[string]*big.Rat{base: big.NewRat(1, 1)}}
+	for code, s := range rates {
+		r, ok := new(big.Rat).SetString(s)
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate for %s: %q", code, s)
+		}
+		p.rates[code] = r
+	}
+	return p, nil
+}
+
+func (p *StaticProvider) Rate(_ context.Context, from, to string) (*big.Rat, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+	return new(big.Rat).Quo(fromRate, toRate), nil
+}
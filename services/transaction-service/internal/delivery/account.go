@@ -0,0 +1,99 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Типы счетов из protobuf в доменные значения
+var accountTypes = map[transactionpb.AccountType]model.AccountType{
+	transactionpb.AccountType_ACCOUNT_TYPE_CASH:    model.AccountCash,
+	transactionpb.AccountType_ACCOUNT_TYPE_CARD:    model.AccountCard,
+	transactionpb.AccountType_ACCOUNT_TYPE_SAVINGS: model.AccountSavings,
+	transactionpb.AccountType_ACCOUNT_TYPE_CREDIT:  model.AccountCredit,
+	transactionpb.AccountType_ACCOUNT_TYPE_OTHER:   model.AccountOther,
+}
+
+// Создаёт счёт пользователя
+func (h *TransactionHandler) CreateAccount(ctx context.Context, req *transactionpb.CreateAccountRequest) (*transactionpb.CreateAccountResponse, error) {
+	a := &model.Account{
+		UserID:   req.GetUserId(),
+		Name:     req.GetName(),
+		Type:     accountTypes[req.GetType()],
+		Currency: req.GetCurrency(),
+	}
+	if req.GetOpeningBalanceDecimal() != "" {
+		m, err := model.ParseMoney(req.GetOpeningBalanceDecimal())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		a.OpeningBalance = m
+	}
+
+	account, err := h.accounts.CreateAccount(ctx, a)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.CreateAccountResponse{Account: accountToProto(account)}, nil
+}
+
+// Возвращает счета пользователя с текущими остатками
+func (h *TransactionHandler) ListAccounts(ctx context.Context, req *transactionpb.ListAccountsRequest) (*transactionpb.ListAccountsResponse, error) {
+	accounts, err := h.accounts.ListAccounts(ctx, req.GetUserId(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.ListAccountsResponse{Accounts: []*transactionpb.Account{}}
+	for _, a := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(a))
+	}
+	return resp, nil
+}
+
+// Архивирует счёт
+func (h *TransactionHandler) ArchiveAccount(ctx context.Context, req *transactionpb.ArchiveAccountRequest) (*transactionpb.ArchiveAccountResponse, error) {
+	err := h.accounts.ArchiveAccount(ctx, req.GetAccountId(), req.GetUserId())
+	if errors.Is(err, repo.ErrAccountNotFound) {
+		return &transactionpb.ArchiveAccountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		// Попытка архивировать основной счёт — ошибка запроса, а не «не найдено»
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.ArchiveAccountResponse{
+		Success: true,
+		Message: "Account archived",
+	}, nil
+}
+
+func accountToProto(a *model.AccountBalance) *transactionpb.Account {
+	accountType := transactionpb.AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	for k, v := range accountTypes {
+		if v == a.Type {
+			accountType = k
+		}
+	}
+	return &transactionpb.Account{
+		Id:                    a.ID,
+		UserId:                a.UserID,
+		Name:                  a.Name,
+		Type:                  accountType,
+		Currency:              a.Currency,
+		OpeningBalanceDecimal: a.OpeningBalance.String(),
+		BalanceDecimal:        a.Balance.String(),
+		IsDefault:             a.IsDefault,
+		Archived:              a.Archived,
+		CreatedAt:             timestamppb.New(a.CreatedAt),
+	}
+}
@@ -0,0 +1,88 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Устанавливает месячный лимит по категории
+func (h *TransactionHandler) SetBudget(ctx context.Context, req *transactionpb.SetBudgetRequest) (*transactionpb.SetBudgetResponse, error) {
+	limit, err := model.ParseMoney(req.GetLimitDecimal())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	b := &model.Budget{
+		UserID:     req.GetUserId(),
+		CategoryID: req.GetCategoryId(),
+		Limit:      limit,
+		Currency:   req.GetCurrency(),
+	}
+
+	saved, err := h.budgets.SetBudget(ctx, b)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.SetBudgetResponse{Budget: budgetToProto(saved)}, nil
+}
+
+// Возвращает бюджеты с тратами за месяц
+func (h *TransactionHandler) ListBudgets(ctx context.Context, req *transactionpb.ListBudgetsRequest) (*transactionpb.ListBudgetsResponse, error) {
+	month := time.Now()
+	if req.GetMonth() != nil {
+		month = req.GetMonth().AsTime()
+	}
+
+	statuses, err := h.budgets.ListBudgets(ctx, req.GetUserId(), month)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &transactionpb.ListBudgetsResponse{
+		Budgets: []*transactionpb.BudgetStatus{},
+		Month:   timestamppb.New(model.GranularityMonth.Truncate(month)),
+	}
+	for _, s := range statuses {
+		resp.Budgets = append(resp.Budgets, &transactionpb.BudgetStatus{
+			Budget:           budgetToProto(s.Budget),
+			SpentDecimal:     s.Spent.String(),
+			RemainingDecimal: s.Remaining().String(),
+			Overspent:        s.Overspent(),
+		})
+	}
+	return resp, nil
+}
+
+// Удаляет бюджет
+func (h *TransactionHandler) DeleteBudget(ctx context.Context, req *transactionpb.DeleteBudgetRequest) (*transactionpb.DeleteBudgetResponse, error) {
+	err := h.budgets.DeleteBudget(ctx, req.GetBudgetId(), req.GetUserId())
+	if err != nil {
+		return &transactionpb.DeleteBudgetResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &transactionpb.DeleteBudgetResponse{
+		Success: true,
+		Message: "Budget deleted",
+	}, nil
+}
+
+func budgetToProto(b *model.Budget) *transactionpb.Budget {
+	return &transactionpb.Budget{
+		Id:           b.ID,
+		UserId:       b.UserID,
+		CategoryId:   b.CategoryID,
+		LimitDecimal: b.Limit.String(),
+		Currency:     b.Currency,
+		CreatedAt:    timestamppb.New(b.CreatedAt),
+		UpdatedAt:    timestamppb.New(b.UpdatedAt),
+	}
+}
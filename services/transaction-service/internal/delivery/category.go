@@ -0,0 +1,86 @@
+package delivery
+
+import (
+	"context"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Создаёт пользовательскую категорию
+func (h *TransactionHandler) CreateCategory(ctx context.Context, req *transactionpb.CreateCategoryRequest) (*transactionpb.CreateCategoryResponse, error) {
+	c := &model.Category{
+		UserID:      req.GetUserId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Type:        model.TransactionType(req.GetType().String()),
+	}
+
+	id, err := h.categories.CreateCategory(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transactionpb.CreateCategoryResponse{CategoryId: id}, nil
+}
+
+// Возвращает категории пользователя вместе с системными
+func (h *TransactionHandler) ListCategories(ctx context.Context, req *transactionpb.ListCategoriesRequest) (*transactionpb.ListCategoriesResponse, error) {
+	var txType model.TransactionType
+	if req.GetType() != transactionpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		txType = model.TransactionType(req.GetType().String())
+	}
+
+	categories, err := h.categories.ListCategories(ctx, req.GetUserId(), txType, req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &transactionpb.ListCategoriesResponse{Categories: []*transactionpb.Category{}}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, &transactionpb.Category{
+			Id:          c.ID,
+			UserId:      c.UserID,
+			Name:        c.Name,
+			Description: c.Description,
+			Type:        transactionpb.TransactionType(transactionpb.TransactionType_value[string(c.Type)]),
+			Archived:    c.Archived,
+			CreatedAt:   timestamppb.New(c.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// Переименовывает категорию пользователя
+func (h *TransactionHandler) RenameCategory(ctx context.Context, req *transactionpb.RenameCategoryRequest) (*transactionpb.RenameCategoryResponse, error) {
+	err := h.categories.RenameCategory(ctx, req.GetCategoryId(), req.GetUserId(), req.GetName())
+	if err != nil {
+		return &transactionpb.RenameCategoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &transactionpb.RenameCategoryResponse{
+		Success: true,
+		Message: "Category renamed",
+	}, nil
+}
+
+// Архивирует категорию пользователя
+func (h *TransactionHandler) ArchiveCategory(ctx context.Context, req *transactionpb.ArchiveCategoryRequest) (*transactionpb.ArchiveCategoryResponse, error) {
+	err := h.categories.ArchiveCategory(ctx, req.GetCategoryId(), req.GetUserId())
+	if err != nil {
+		return &transactionpb.ArchiveCategoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &transactionpb.ArchiveCategoryResponse{
+		Success: true,
+		Message: "Category archived",
+	}, nil
+}
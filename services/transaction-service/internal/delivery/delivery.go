@@ -7,38 +7,80 @@ import (
 	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Реализует transactionpb.TransactionServiceServer
 type TransactionHandler struct {
 	transactionpb.UnimplementedTransactionServiceServer
-	service service.TransactionService
+	service    service.TransactionService
+	categories service.CategoryService
+	balances   service.BalanceService
+	budgets    service.BudgetService
+	recurring  service.RecurringService
+	households service.HouseholdService
+	accounts   service.AccountService
+	goals      service.GoalService
+	loans      service.LoanService
 }
 
 // Создаёт новый gRPC handler
-func NewTransactionHandler(s service.TransactionService) *TransactionHandler {
+func NewTransactionHandler(s service.TransactionService, categories service.CategoryService, balances service.BalanceService, budgets service.BudgetService, recurring service.RecurringService, households service.HouseholdService, accounts service.AccountService, goals service.GoalService, loans service.LoanService) *TransactionHandler {
 	return &TransactionHandler{
-		service: s,
+		service:    s,
+		categories: categories,
+		balances:   balances,
+		budgets:    budgets,
+		recurring:  recurring,
+		households: households,
+		accounts:   accounts,
+		goals:      goals,
+		loans:      loans,
 	}
 }
 
 // Обрабатывает добавление новой транзакции
 func (h *TransactionHandler) AddTransaction(ctx context.Context, req *transactionpb.AddTransactionRequest) (*transactionpb.AddTransactionResponse, error) {
+	amount, err := amountFromRequest(req.GetAmountDecimal(), req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
 	tx := &model.Transaction{
-		ID:          "", // генерируется в сервисе
-		UserID:      req.GetUserId(),
-		CategoryID:  req.GetCategoryId(),
-		Type:        model.TransactionType(req.GetType().String()),
-		Amount:      req.GetAmount(),
-		Description: req.GetDescription(),
-		Date:        req.GetDate().AsTime(),
-		CreatedAt:   time.Now(),
+		ID:                "", // генерируется в сервисе
+		UserID:            req.GetUserId(),
+		CategoryID:        req.GetCategoryId(),
+		Type:              model.TransactionType(req.GetType().String()),
+		Amount:            amount,
+		Currency:          req.GetCurrency(),
+		Description:       req.GetDescription(),
+		Date:              req.GetDate().AsTime(),
+		CreatedAt:         time.Now(),
+		HouseholdID:       req.GetHouseholdId(),
+		AccountID:         req.GetAccountId(),
+		TransferAccountID: req.GetTransferAccountId(),
+		GoalID:            req.GetGoalId(),
+		LoanID:            req.GetLoanId(),
+	}
+	// Сумма зачисления нужна только переводу между счетами в разных валютах
+	if req.GetTransferAmountDecimal() != "" {
+		if tx.TransferAmount, err = amountFromRequest(req.GetTransferAmountDecimal(), 0); err != nil {
+			return nil, err
+		}
 	}
 
-	id, err := h.service.AddTransaction(ctx, tx)
+	// Взнос в цель и погашение займа сначала проверяют, что цель или займ принадлежат пользователю
+	add := h.service.AddTransaction
+	switch {
+	case tx.GoalID != "":
+		add = h.goals.AddContribution
+	case tx.LoanID != "":
+		add = h.loans.AddRepayment
+	}
+	id, err := add(ctx, tx)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &transactionpb.AddTransactionResponse{TransactionId: id}, nil
@@ -46,41 +88,190 @@ func (h *TransactionHandler) AddTransaction(ctx context.Context, req *transactio
 
 // Возвращает список транзакций пользователя
 func (h *TransactionHandler) ListTransactions(ctx context.Context, req *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error) {
-	transactions, err := h.service.ListTransactions(ctx, req.GetUserId(), int(req.GetLimit()), int(req.GetOffset()))
+	filter, err := filterFromRequest(req)
 	if err != nil {
 		return nil, err
 	}
+	page, err := h.service.ListTransactions(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
 
 	var resp transactionpb.ListTransactionsResponse
+	resp.TotalCount = page.TotalCount
+	resp.NextPageToken = page.NextPageToken
 	resp.Transactions = []*transactionpb.Transaction{}
-	for _, t := range transactions {
-		enumValue, ok := transactionpb.TransactionType_value[string(t.Type)]
-		if !ok {
-			enumValue = 0
-		}
-		resp.Transactions = append(resp.Transactions, &transactionpb.Transaction{
-			Id:          t.ID,
-			UserId:      t.UserID,
-			CategoryId:  t.CategoryID,
-			Type:        transactionpb.TransactionType(enumValue),
-			Amount:      t.Amount,
-			Description: t.Description,
-			Date:        timestamppb.New(t.Date),
-			CreatedAt:   timestamppb.New(t.CreatedAt),
-		})
+	for _, t := range page.Transactions {
+		resp.Transactions = append(resp.Transactions, transactionToProto(t))
 	}
 
 	return &resp, nil
 }
 
+// Собирает доменный фильтр из запроса списка транзакций
+func filterFromRequest(req *transactionpb.ListTransactionsRequest) (model.TransactionFilter, error) {
+	filter := model.TransactionFilter{
+		UserID:      req.GetUserId(),
+		CategoryIDs: req.GetCategoryIds(),
+		Search:      req.GetSearch(),
+		Ascending:   req.GetAscending(),
+		Limit:       int(req.GetLimit()),
+		Offset:      int(req.GetOffset()),
+		PageToken:   req.GetPageToken(),
+		HouseholdID: req.GetHouseholdId(),
+		AccountID:   req.GetAccountId(),
+		GoalID:      req.GetGoalId(),
+		LoanID:      req.GetLoanId(),
+	}
+	var err error
+	if filter.AmountMin, err = amountBound(req.GetAmountMinDecimal(), req.AmountMin); err != nil {
+		return filter, err
+	}
+	if filter.AmountMax, err = amountBound(req.GetAmountMaxDecimal(), req.AmountMax); err != nil {
+		return filter, err
+	}
+	if req.GetDateFrom() != nil {
+		d := req.GetDateFrom().AsTime()
+		filter.DateFrom = &d
+	}
+	if req.GetDateTo() != nil {
+		d := req.GetDateTo().AsTime()
+		filter.DateTo = &d
+	}
+	if req.GetType() != transactionpb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		filter.Type = model.TransactionType(req.GetType().String())
+	}
+	switch req.GetSortBy() {
+	case transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_AMOUNT:
+		filter.SortBy = model.SortByAmount
+	case transactionpb.TransactionSortField_TRANSACTION_SORT_FIELD_CREATED_AT:
+		filter.SortBy = model.SortByCreatedAt
+	default:
+		filter.SortBy = model.SortByDate
+	}
+	return filter, nil
+}
+
+// Частично обновляет транзакцию по update_mask
+func (h *TransactionHandler) UpdateTransaction(ctx context.Context, req *transactionpb.UpdateTransactionRequest) (*transactionpb.UpdateTransactionResponse, error) {
+	patch, err := patchFromMask(req.GetTransaction(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := h.service.UpdateTransaction(ctx, req.GetTransactionId(), req.GetUserId(), patch)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.UpdateTransactionResponse{Transaction: transactionToProto(tx)}, nil
+}
+
+// Собирает частичное обновление из значений и списка полей маски
+func patchFromMask(src *transactionpb.Transaction, paths []string) (*model.TransactionPatch, error) {
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	patch := &model.TransactionPatch{}
+	for _, path := range paths {
+		switch path {
+		case "category_id":
+			v := src.GetCategoryId()
+			patch.CategoryID = &v
+		case "account_id":
+			v := src.GetAccountId()
+			patch.AccountID = &v
+		case "type":
+			v := model.TransactionType(src.GetType().String())
+			patch.Type = &v
+		case "amount", "amount_decimal":
+			v, err := amountFromRequest(src.GetAmountDecimal(), src.GetAmount())
+			if err != nil {
+				return nil, err
+			}
+			patch.Amount = &v
+		case "currency":
+			v := src.GetCurrency()
+			patch.Currency = &v
+		case "description":
+			v := src.GetDescription()
+			patch.Description = &v
+		case "date":
+			if src.GetDate() == nil {
+				return nil, status.Error(codes.InvalidArgument, "date is required when listed in update_mask")
+			}
+			v := src.GetDate().AsTime()
+			patch.Date = &v
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %q cannot be updated", path)
+		}
+	}
+	return patch, nil
+}
+
+// Переводит доменную транзакцию в protobuf
+func transactionToProto(t *model.Transaction) *transactionpb.Transaction {
+	enumValue, ok := transactionpb.TransactionType_value[string(t.Type)]
+	if !ok {
+		enumValue = 0
+	}
+	pb := &transactionpb.Transaction{
+		Id:                t.ID,
+		UserId:            t.UserID,
+		CategoryId:        t.CategoryID,
+		Type:              transactionpb.TransactionType(enumValue),
+		Amount:            t.Amount.Float64(),
+		Description:       t.Description,
+		Date:              timestamppb.New(t.Date),
+		CreatedAt:         timestamppb.New(t.CreatedAt),
+		UpdatedAt:         timestamppb.New(t.UpdatedAt),
+		AmountDecimal:     t.Amount.String(),
+		Currency:          t.Currency,
+		HouseholdId:       t.HouseholdID,
+		AccountId:         t.AccountID,
+		TransferAccountId: t.TransferAccountID,
+		GoalId:            t.GoalID,
+		LoanId:            t.LoanID,
+	}
+	if t.Type == model.Transfer {
+		pb.TransferAmountDecimal = t.TransferAmount.String()
+	}
+	return pb
+}
+
+// Граница суммы в фильтре; nil, если не задана ни строкой, ни double
+func amountBound(decimal string, amount *float64) (*model.Money, error) {
+	if decimal == "" && amount == nil {
+		return nil, nil
+	}
+	var legacy float64
+	if amount != nil {
+		legacy = *amount
+	}
+	m, err := amountFromRequest(decimal, legacy)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Точная сумма берётся из строкового поля, double — только для старых клиентов
+func amountFromRequest(decimal string, amount float64) (model.Money, error) {
+	if decimal == "" {
+		return model.MoneyFromFloat(amount), nil
+	}
+	m, err := model.ParseMoney(decimal)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return m, nil
+}
+
 // Удаляет транзакцию по ID
 func (h *TransactionHandler) DeleteTransaction(ctx context.Context, req *transactionpb.DeleteTransactionRequest) (*transactionpb.DeleteTransactionResponse, error) {
 	err := h.service.DeleteTransaction(ctx, req.GetTransactionId(), req.GetUserId())
 	if err != nil {
-		return &transactionpb.DeleteTransactionResponse{
-			Success: false,
-			Message: err.Error(),
-		}, nil
+		return nil, toStatus(err)
 	}
 
 	return &transactionpb.DeleteTransactionResponse{
@@ -89,16 +280,66 @@ func (h *TransactionHandler) DeleteTransaction(ctx context.Context, req *transac
 	}, nil
 }
 
-// Возвращает текущий баланс пользователя
+// Возвращает баланс пользователя в валюте профиля, по каждой валюте отдельно
+// и (для личного баланса) остатки по счетам
 func (h *TransactionHandler) GetBalance(ctx context.Context, req *transactionpb.GetBalanceRequest) (*transactionpb.GetBalanceResponse, error) {
-	income, expense, balance, err := h.service.GetBalance(ctx, req.GetUserId())
+	report, err := h.balances.GetBalance(ctx, req.GetUserId(), req.GetHouseholdId())
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+	var accounts []*model.AccountBalance
+	if req.GetHouseholdId() == "" {
+		if accounts, err = h.accounts.ListAccounts(ctx, req.GetUserId(), false); err != nil {
+			return nil, toStatus(err)
+		}
 	}
 
-	return &transactionpb.GetBalanceResponse{
-		IncomeTotal:  income,
-		ExpenseTotal: expense,
-		Balance:      balance,
-	}, nil
+	resp := &transactionpb.GetBalanceResponse{
+		IncomeTotal:         report.Income.Float64(),
+		ExpenseTotal:        report.Expense.Float64(),
+		Balance:             report.Balance().Float64(),
+		IncomeTotalDecimal:  report.Income.String(),
+		ExpenseTotalDecimal: report.Expense.String(),
+		BalanceDecimal:      report.Balance().String(),
+		Currency:            report.Currency,
+		ByCurrency:          []*transactionpb.CurrencyBalance{},
+	}
+	for _, b := range report.ByCurrency {
+		resp.ByCurrency = append(resp.ByCurrency, &transactionpb.CurrencyBalance{
+			Currency:            b.Currency,
+			IncomeTotalDecimal:  b.Income.String(),
+			ExpenseTotalDecimal: b.Expense.String(),
+			BalanceDecimal:      b.Balance().String(),
+		})
+	}
+	resp.Accounts = []*transactionpb.Account{}
+	for _, a := range accounts {
+		resp.Accounts = append(resp.Accounts, accountToProto(a))
+	}
+	return resp, nil
+}
+
+// Возвращает чистую стоимость в валюте профиля: счета и непогашенные займы
+func (h *TransactionHandler) GetNetWorth(ctx context.Context, req *transactionpb.GetNetWorthRequest) (*transactionpb.GetNetWorthResponse, error) {
+	nw, err := h.balances.GetNetWorth(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &transactionpb.GetNetWorthResponse{
+		Currency:        nw.Currency,
+		AccountsDecimal: nw.Accounts.String(),
+		LentDecimal:     nw.Lent.String(),
+		BorrowedDecimal: nw.Borrowed.String(),
+		NetWorthDecimal: nw.Total().String(),
+		Accounts:        []*transactionpb.Account{},
+		Loans:           []*transactionpb.Loan{},
+	}
+	for _, a := range nw.AccountList {
+		resp.Accounts = append(resp.Accounts, accountToProto(a))
+	}
+	for _, l := range nw.Loans {
+		resp.Loans = append(resp.Loans, loanToProto(l))
+	}
+	return resp, nil
 }
@@ -0,0 +1,47 @@
+package delivery
+
+import (
+	"errors"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/csvimport"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Переводит ошибки бизнес-логики в gRPC-статусы
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, repo.ErrNotFound), errors.Is(err, repo.ErrCategoryNotFound), errors.Is(err, repo.ErrBudgetNotFound),
+		errors.Is(err, repo.ErrRecurringNotFound), errors.Is(err, repo.ErrMemberNotFound), errors.Is(err, repo.ErrInvitationNotFound),
+		errors.Is(err, repo.ErrAccountNotFound), errors.Is(err, repo.ErrGoalNotFound), errors.Is(err, repo.ErrLoanNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repo.ErrAlreadyMember):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrCategoryForbidden), errors.Is(err, service.ErrHouseholdForbidden),
+		errors.Is(err, service.ErrAccountForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrInvalidTransaction),
+		errors.Is(err, service.ErrInvalidFilter),
+		errors.Is(err, service.ErrInvalidBudget),
+		errors.Is(err, service.ErrInvalidRecurring),
+		errors.Is(err, service.ErrInvalidHousehold),
+		errors.Is(err, service.ErrCategoryTypeMismatch),
+		errors.Is(err, service.ErrCategoryArchived),
+		errors.Is(err, service.ErrInvalidAccount),
+		errors.Is(err, service.ErrAccountArchived),
+		errors.Is(err, service.ErrAccountCurrencyMismatch),
+		errors.Is(err, service.ErrInvalidGoal),
+		errors.Is(err, service.ErrInvalidLoan),
+		errors.Is(err, csvimport.ErrInvalidFile):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, currency.ErrRateNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrProfileUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
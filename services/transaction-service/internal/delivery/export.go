@@ -0,0 +1,49 @@
+package delivery
+
+import (
+	"bufio"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/export"
+)
+
+// Размер части файла в одном сообщении потока
+const exportChunkSize = 32 << 10
+
+var exportFormats = map[transactionpb.ExportFormat]export.Format{
+	transactionpb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED: export.FormatCSV,
+	transactionpb.ExportFormat_EXPORT_FORMAT_CSV:         export.FormatCSV,
+	transactionpb.ExportFormat_EXPORT_FORMAT_JSON:        export.FormatJSON,
+	transactionpb.ExportFormat_EXPORT_FORMAT_OFX:         export.FormatOFX,
+}
+
+// Выгружает транзакции по фильтру списка, отправляя файл частями
+func (h *TransactionHandler) ExportTransactions(req *transactionpb.ExportTransactionsRequest, stream transactionpb.TransactionService_ExportTransactionsServer) error {
+	filter := req.GetFilter()
+	if filter == nil {
+		filter = &transactionpb.ListTransactionsRequest{}
+	}
+	format := exportFormats[req.GetFormat()]
+
+	domainFilter, err := filterFromRequest(filter)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(chunkWriter{stream}, exportChunkSize)
+	if err := h.service.ExportTransactions(stream.Context(), domainFilter, format, w); err != nil {
+		return toStatus(err)
+	}
+	return w.Flush()
+}
+
+// Отправляет каждую запись в поток отдельным сообщением
+type chunkWriter struct {
+	stream transactionpb.TransactionService_ExportTransactionsServer
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&transactionpb.ExportChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,170 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Создаёт цель накоплений
+func (h *TransactionHandler) CreateGoal(ctx context.Context, req *transactionpb.CreateGoalRequest) (*transactionpb.CreateGoalResponse, error) {
+	target, err := model.ParseMoney(req.GetTargetAmountDecimal())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	g := &model.Goal{
+		UserID:       req.GetUserId(),
+		Name:         req.GetName(),
+		TargetAmount: target,
+		Currency:     req.GetCurrency(),
+	}
+	if req.GetDeadline() != nil {
+		d := model.GranularityDay.Truncate(req.GetDeadline().AsTime())
+		g.Deadline = &d
+	}
+
+	progress, err := h.goals.CreateGoal(ctx, g)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.CreateGoalResponse{Goal: goalToProto(progress)}, nil
+}
+
+// Возвращает цели пользователя с прогрессом
+func (h *TransactionHandler) ListGoals(ctx context.Context, req *transactionpb.ListGoalsRequest) (*transactionpb.ListGoalsResponse, error) {
+	goals, err := h.goals.ListGoals(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.ListGoalsResponse{Goals: []*transactionpb.Goal{}}
+	for _, g := range goals {
+		resp.Goals = append(resp.Goals, goalToProto(g))
+	}
+	return resp, nil
+}
+
+// Возвращает цель с прогрессом
+func (h *TransactionHandler) GetGoal(ctx context.Context, req *transactionpb.GetGoalRequest) (*transactionpb.GetGoalResponse, error) {
+	progress, err := h.goals.GetGoal(ctx, req.GetGoalId(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.GetGoalResponse{Goal: goalToProto(progress)}, nil
+}
+
+// Частично обновляет цель по update_mask
+func (h *TransactionHandler) UpdateGoal(ctx context.Context, req *transactionpb.UpdateGoalRequest) (*transactionpb.UpdateGoalResponse, error) {
+	patch, err := goalPatchFromMask(req.GetGoal(), req.GetUpdateMask().GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := h.goals.UpdateGoal(ctx, req.GetGoalId(), req.GetUserId(), patch)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.UpdateGoalResponse{Goal: goalToProto(progress)}, nil
+}
+
+// Удаляет цель; её взносы остаются обычными транзакциями
+func (h *TransactionHandler) DeleteGoal(ctx context.Context, req *transactionpb.DeleteGoalRequest) (*transactionpb.DeleteGoalResponse, error) {
+	err := h.goals.DeleteGoal(ctx, req.GetGoalId(), req.GetUserId())
+	if errors.Is(err, repo.ErrGoalNotFound) {
+		return &transactionpb.DeleteGoalResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.DeleteGoalResponse{
+		Success: true,
+		Message: "Goal deleted",
+	}, nil
+}
+
+// Засчитывает существующую транзакцию взносом в цель
+func (h *TransactionHandler) LinkGoalTransaction(ctx context.Context, req *transactionpb.LinkGoalTransactionRequest) (*transactionpb.LinkGoalTransactionResponse, error) {
+	progress, err := h.goals.LinkTransaction(ctx, req.GetGoalId(), req.GetUserId(), req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.LinkGoalTransactionResponse{Goal: goalToProto(progress)}, nil
+}
+
+// Отвязывает транзакцию от цели
+func (h *TransactionHandler) UnlinkGoalTransaction(ctx context.Context, req *transactionpb.LinkGoalTransactionRequest) (*transactionpb.LinkGoalTransactionResponse, error) {
+	progress, err := h.goals.UnlinkTransaction(ctx, req.GetGoalId(), req.GetUserId(), req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.LinkGoalTransactionResponse{Goal: goalToProto(progress)}, nil
+}
+
+// Собирает частичное обновление цели; deadline без значения снимает срок
+func goalPatchFromMask(src *transactionpb.Goal, paths []string) (*model.GoalPatch, error) {
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	patch := &model.GoalPatch{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			v := src.GetName()
+			patch.Name = &v
+		case "target_amount_decimal":
+			v, err := model.ParseMoney(src.GetTargetAmountDecimal())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			patch.TargetAmount = &v
+		case "currency":
+			v := src.GetCurrency()
+			patch.Currency = &v
+		case "deadline":
+			if src.GetDeadline() == nil {
+				patch.ClearDeadline = true
+				continue
+			}
+			v := model.GranularityDay.Truncate(src.GetDeadline().AsTime())
+			patch.Deadline = &v
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %q cannot be updated", path)
+		}
+	}
+	return patch, nil
+}
+
+func goalToProto(p *model.GoalProgress) *transactionpb.Goal {
+	g := p.Goal
+	pb := &transactionpb.Goal{
+		Id:                      g.ID,
+		UserId:                  g.UserID,
+		Name:                    g.Name,
+		TargetAmountDecimal:     g.TargetAmount.String(),
+		Currency:                g.Currency,
+		CreatedAt:               timestamppb.New(g.CreatedAt),
+		ContributedDecimal:      p.Contributed.String(),
+		RemainingDecimal:        p.Remaining().String(),
+		ProgressPercent:         p.Percent(),
+		RequiredMonthlyDecimal:  p.RequiredMonthly.String(),
+		AverageNetIncomeDecimal: p.AverageNetIncome.String(),
+		Completed:               p.Completed(),
+		OnTrack:                 p.OnTrack(),
+	}
+	if g.Deadline != nil {
+		pb.Deadline = timestamppb.New(*g.Deadline)
+	}
+	if p.ProjectedDate != nil {
+		pb.ProjectedCompletion = timestamppb.New(*p.ProjectedDate)
+	}
+	return pb
+}
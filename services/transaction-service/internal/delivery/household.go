@@ -0,0 +1,132 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Роли участников из protobuf в доменные значения
+var householdRoles = map[transactionpb.HouseholdRole]model.HouseholdRole{
+	transactionpb.HouseholdRole_HOUSEHOLD_ROLE_OWNER:  model.HouseholdOwner,
+	transactionpb.HouseholdRole_HOUSEHOLD_ROLE_EDITOR: model.HouseholdEditor,
+	transactionpb.HouseholdRole_HOUSEHOLD_ROLE_VIEWER: model.HouseholdViewer,
+}
+
+// Создаёт общий бюджет; вызывающий становится владельцем
+func (h *TransactionHandler) CreateHousehold(ctx context.Context, req *transactionpb.CreateHouseholdRequest) (*transactionpb.CreateHouseholdResponse, error) {
+	household, err := h.households.CreateHousehold(ctx, &model.Household{OwnerID: req.GetUserId(), Name: req.GetName()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.CreateHouseholdResponse{Household: householdToProto(household)}, nil
+}
+
+// Возвращает общие бюджеты, в которых участвует пользователь
+func (h *TransactionHandler) ListHouseholds(ctx context.Context, req *transactionpb.ListHouseholdsRequest) (*transactionpb.ListHouseholdsResponse, error) {
+	households, err := h.households.ListHouseholds(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.ListHouseholdsResponse{Households: []*transactionpb.Household{}}
+	for _, household := range households {
+		resp.Households = append(resp.Households, householdToProto(household))
+	}
+	return resp, nil
+}
+
+// Возвращает участников общего бюджета
+func (h *TransactionHandler) ListHouseholdMembers(ctx context.Context, req *transactionpb.ListHouseholdMembersRequest) (*transactionpb.ListHouseholdMembersResponse, error) {
+	members, err := h.households.ListMembers(ctx, req.GetHouseholdId(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.ListHouseholdMembersResponse{Members: []*transactionpb.HouseholdMember{}}
+	for _, m := range members {
+		resp.Members = append(resp.Members, householdMemberToProto(m))
+	}
+	return resp, nil
+}
+
+// Создаёт приглашение; код возвращается один раз
+func (h *TransactionHandler) InviteHouseholdMember(ctx context.Context, req *transactionpb.InviteHouseholdMemberRequest) (*transactionpb.InviteHouseholdMemberResponse, error) {
+	inv, err := h.households.InviteMember(ctx, req.GetHouseholdId(), req.GetUserId(), householdRoles[req.GetRole()])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.InviteHouseholdMemberResponse{
+		InvitationId: inv.ID,
+		Code:         inv.Code,
+		ExpiresAt:    timestamppb.New(inv.ExpiresAt),
+	}, nil
+}
+
+// Принимает приглашение по коду
+func (h *TransactionHandler) AcceptHouseholdInvitation(ctx context.Context, req *transactionpb.AcceptHouseholdInvitationRequest) (*transactionpb.AcceptHouseholdInvitationResponse, error) {
+	m, err := h.households.AcceptInvitation(ctx, req.GetUserId(), req.GetCode())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.AcceptHouseholdInvitationResponse{Member: householdMemberToProto(m)}, nil
+}
+
+// Меняет роль участника
+func (h *TransactionHandler) UpdateHouseholdMemberRole(ctx context.Context, req *transactionpb.UpdateHouseholdMemberRoleRequest) (*transactionpb.UpdateHouseholdMemberRoleResponse, error) {
+	m, err := h.households.UpdateMemberRole(ctx, req.GetHouseholdId(), req.GetUserId(), req.GetMemberUserId(), householdRoles[req.GetRole()])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.UpdateHouseholdMemberRoleResponse{Member: householdMemberToProto(m)}, nil
+}
+
+// Исключает участника или выводит пользователя из бюджета
+func (h *TransactionHandler) RemoveHouseholdMember(ctx context.Context, req *transactionpb.RemoveHouseholdMemberRequest) (*transactionpb.RemoveHouseholdMemberResponse, error) {
+	err := h.households.RemoveMember(ctx, req.GetHouseholdId(), req.GetUserId(), req.GetMemberUserId())
+	if errors.Is(err, repo.ErrMemberNotFound) {
+		return &transactionpb.RemoveHouseholdMemberResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		// Нехватка прав и попытка владельца выйти — ошибки, а не «не найдено»
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.RemoveHouseholdMemberResponse{
+		Success: true,
+		Message: "Household member removed",
+	}, nil
+}
+
+func householdRoleToProto(role model.HouseholdRole) transactionpb.HouseholdRole {
+	for k, v := range householdRoles {
+		if v == role {
+			return k
+		}
+	}
+	return transactionpb.HouseholdRole_HOUSEHOLD_ROLE_UNSPECIFIED
+}
+
+func householdToProto(h *model.Household) *transactionpb.Household {
+	return &transactionpb.Household{
+		Id:        h.ID,
+		Name:      h.Name,
+		OwnerId:   h.OwnerID,
+		Role:      householdRoleToProto(h.Role),
+		CreatedAt: timestamppb.New(h.CreatedAt),
+	}
+}
+
+func householdMemberToProto(m *model.HouseholdMember) *transactionpb.HouseholdMember {
+	return &transactionpb.HouseholdMember{
+		HouseholdId: m.HouseholdID,
+		UserId:      m.UserID,
+		Role:        householdRoleToProto(m.Role),
+		JoinedAt:    timestamppb.New(m.JoinedAt),
+	}
+}
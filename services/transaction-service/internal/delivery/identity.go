@@ -0,0 +1,126 @@
+package delivery
+
+import (
+	"context"
+	"strings"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключ метаданных с access-токеном ("Bearer <token>")
+const AuthorizationMetadataKey = "authorization"
+
+// Роли, которым доступно чтение данных любого пользователя
+var readAnyRoles = map[string]bool{"support": true, "admin": true}
+
+// Методы, которые поддержка и администраторы могут вызывать для чужих данных.
+// Административное API шлюза читает только список транзакций; остальное — лишь владельцу
+var readOnlyMethods = map[string]bool{
+	transactionpb.TransactionService_ListTransactions_FullMethodName: true,
+}
+
+var serviceMethodPrefix = "/" + transactionpb.TransactionService_ServiceDesc.ServiceName + "/"
+
+// Запросы к данным одного пользователя
+type userScoped interface {
+	GetUserId() string
+}
+
+// Владелец данных, к которым обращается запрос; у экспорта он задан в фильтре
+func requestOwner(req interface{}) (string, bool) {
+	switch r := req.(type) {
+	case *transactionpb.ExportTransactionsRequest:
+		return r.GetFilter().GetUserId(), true
+	case userScoped:
+		return r.GetUserId(), true
+	}
+	return "", false
+}
+
+// Проверка владельца данных в вызовах TransactionService.
+// Пользователь и роль берутся только из проверенного access-токена, заголовкам вроде
+// x-user-id и x-user-role веры нет
+type Ownership struct {
+	tokens auth.TokenVerifier
+	logger *zap.Logger
+}
+
+func NewOwnership(tokens auth.TokenVerifier, logger *zap.Logger) *Ownership {
+	return &Ownership{tokens: tokens, logger: logger}
+}
+
+// Пользователь, от имени которого выполняется вызов, и его роль
+func (o *Ownership) caller(ctx context.Context) (*auth.Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(AuthorizationMetadataKey)
+	if len(values) != 1 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "access token required")
+	}
+	claims, err := o.tokens.Verify(ctx, values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	return claims, nil
+}
+
+// Запрос должен касаться только данных вызывающего пользователя.
+// Исключение — чтение чужих данных поддержкой и администраторами, каждое такое чтение журналируется
+func (o *Ownership) checkOwner(ctx context.Context, method string, req interface{}) error {
+	caller, err := o.caller(ctx)
+	if err != nil {
+		return err
+	}
+	owner, ok := requestOwner(req)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "request is not scoped to a user")
+	}
+	if owner != caller.UserID {
+		if readOnlyMethods[method] && readAnyRoles[caller.Role] {
+			o.logger.Info("cross-user read",
+				zap.String("caller_id", caller.UserID),
+				zap.String("role", caller.Role),
+				zap.String("user_id", owner),
+				zap.String("method", method))
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "user_id does not match caller")
+	}
+	return nil
+}
+
+// Отклоняет вызовы TransactionService, в которых user_id не совпадает с пользователем из токена
+func (o *Ownership) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, serviceMethodPrefix) {
+		if err := o.checkOwner(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// То же для потоковых вызовов: проверяется каждое входящее сообщение
+func (o *Ownership) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, serviceMethodPrefix) {
+		return handler(srv, ss)
+	}
+	return handler(srv, &ownedStream{ServerStream: ss, owner: o, method: info.FullMethod})
+}
+
+type ownedStream struct {
+	grpc.ServerStream
+	owner  *Ownership
+	method string
+}
+
+func (s *ownedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.owner.checkOwner(s.Context(), s.method, m)
+}
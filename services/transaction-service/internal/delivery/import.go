@@ -0,0 +1,65 @@
+package delivery
+
+import (
+	"context"
+	"unicode/utf8"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Импортирует транзакции из CSV-выписки и возвращает результат по каждой строке
+func (h *TransactionHandler) ImportTransactions(ctx context.Context, req *transactionpb.ImportTransactionsRequest) (*transactionpb.ImportTransactionsResponse, error) {
+	opts, err := importOptionsFromProto(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.service.ImportTransactions(ctx, req.GetUserId(), req.GetCsv(), opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &transactionpb.ImportTransactionsResponse{
+		Accepted: int32(report.Accepted()),
+		Rejected: int32(report.Rejected()),
+		Rows:     []*transactionpb.ImportRowResult{},
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, &transactionpb.ImportRowResult{
+			Line:          int32(row.Line),
+			Accepted:      row.Accepted,
+			TransactionId: row.TransactionID,
+			Error:         row.Error,
+		})
+	}
+	return resp, nil
+}
+
+func importOptionsFromProto(o *transactionpb.ImportOptions) (model.ImportOptions, error) {
+	opts := model.ImportOptions{
+		DateFormat:               o.GetDateFormat(),
+		DecimalSeparator:         o.GetDecimalSeparator(),
+		DefaultIncomeCategoryID:  o.GetDefaultIncomeCategoryId(),
+		DefaultExpenseCategoryID: o.GetDefaultExpenseCategoryId(),
+		Currency:                 o.GetCurrency(),
+		Columns: model.ImportColumns{
+			Date:        o.GetColumns().GetDate(),
+			Amount:      o.GetColumns().GetAmount(),
+			Description: o.GetColumns().GetDescription(),
+			Type:        o.GetColumns().GetType(),
+			CategoryID:  o.GetColumns().GetCategoryId(),
+			Currency:    o.GetColumns().GetCurrency(),
+		},
+	}
+	if d := o.GetDelimiter(); d != "" {
+		r, size := utf8.DecodeRuneInString(d)
+		if size != len(d) {
+			return model.ImportOptions{}, status.Error(codes.InvalidArgument, "delimiter must be a single character")
+		}
+		opts.Delimiter = r
+	}
+	return opts, nil
+}
@@ -0,0 +1,159 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Направления и графики займов из protobuf в доменные значения
+var (
+	loanDirections = map[transactionpb.LoanDirection]model.LoanDirection{
+		transactionpb.LoanDirection_LOAN_DIRECTION_LENT:     model.LoanLent,
+		transactionpb.LoanDirection_LOAN_DIRECTION_BORROWED: model.LoanBorrowed,
+	}
+	loanSchedules = map[transactionpb.LoanSchedule]model.LoanSchedule{
+		transactionpb.LoanSchedule_LOAN_SCHEDULE_ANNUITY:        model.ScheduleAnnuity,
+		transactionpb.LoanSchedule_LOAN_SCHEDULE_DIFFERENTIATED: model.ScheduleDifferentiated,
+	}
+)
+
+// Создаёт займ или долг
+func (h *TransactionHandler) CreateLoan(ctx context.Context, req *transactionpb.CreateLoanRequest) (*transactionpb.CreateLoanResponse, error) {
+	principal, err := model.ParseMoney(req.GetPrincipalDecimal())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	l := &model.Loan{
+		UserID:       req.GetUserId(),
+		Counterparty: req.GetCounterparty(),
+		Direction:    loanDirections[req.GetDirection()],
+		Schedule:     loanSchedules[req.GetSchedule()],
+		Principal:    principal,
+		Currency:     req.GetCurrency(),
+		AnnualRate:   req.GetAnnualRatePercent(),
+		TermMonths:   int(req.GetTermMonths()),
+	}
+	if req.GetStartDate() != nil {
+		l.StartDate = req.GetStartDate().AsTime()
+	}
+
+	loan, err := h.loans.CreateLoan(ctx, l)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.CreateLoanResponse{Loan: loanToProto(loan)}, nil
+}
+
+// Возвращает займы пользователя с остатками
+func (h *TransactionHandler) ListLoans(ctx context.Context, req *transactionpb.ListLoansRequest) (*transactionpb.ListLoansResponse, error) {
+	loans, err := h.loans.ListLoans(ctx, req.GetUserId(), req.GetIncludeClosed())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.ListLoansResponse{Loans: []*transactionpb.Loan{}}
+	for _, l := range loans {
+		resp.Loans = append(resp.Loans, loanToProto(l))
+	}
+	return resp, nil
+}
+
+// Возвращает займ с графиком платежей
+func (h *TransactionHandler) GetLoan(ctx context.Context, req *transactionpb.GetLoanRequest) (*transactionpb.GetLoanResponse, error) {
+	loan, err := h.loans.GetLoan(ctx, req.GetLoanId(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &transactionpb.GetLoanResponse{Loan: loanToProto(loan), Schedule: []*transactionpb.LoanPayment{}}
+	for i := range loan.Schedule {
+		resp.Schedule = append(resp.Schedule, loanPaymentToProto(&loan.Schedule[i]))
+	}
+	return resp, nil
+}
+
+// Удаляет займ; его погашения остаются обычными транзакциями
+func (h *TransactionHandler) DeleteLoan(ctx context.Context, req *transactionpb.DeleteLoanRequest) (*transactionpb.DeleteLoanResponse, error) {
+	err := h.loans.DeleteLoan(ctx, req.GetLoanId(), req.GetUserId())
+	if errors.Is(err, repo.ErrLoanNotFound) {
+		return &transactionpb.DeleteLoanResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &transactionpb.DeleteLoanResponse{
+		Success: true,
+		Message: "Loan deleted",
+	}, nil
+}
+
+// Засчитывает существующую транзакцию погашением займа
+func (h *TransactionHandler) LinkLoanTransaction(ctx context.Context, req *transactionpb.LinkLoanTransactionRequest) (*transactionpb.LinkLoanTransactionResponse, error) {
+	loan, err := h.loans.LinkTransaction(ctx, req.GetLoanId(), req.GetUserId(), req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.LinkLoanTransactionResponse{Loan: loanToProto(loan)}, nil
+}
+
+// Отвязывает погашение от займа
+func (h *TransactionHandler) UnlinkLoanTransaction(ctx context.Context, req *transactionpb.LinkLoanTransactionRequest) (*transactionpb.LinkLoanTransactionResponse, error) {
+	loan, err := h.loans.UnlinkTransaction(ctx, req.GetLoanId(), req.GetUserId(), req.GetTransactionId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.LinkLoanTransactionResponse{Loan: loanToProto(loan)}, nil
+}
+
+func loanToProto(s *model.LoanStatus) *transactionpb.Loan {
+	l := s.Loan
+	pb := &transactionpb.Loan{
+		Id:                   l.ID,
+		UserId:               l.UserID,
+		Counterparty:         l.Counterparty,
+		PrincipalDecimal:     l.Principal.String(),
+		Currency:             l.Currency,
+		AnnualRatePercent:    l.AnnualRate,
+		TermMonths:           int32(l.TermMonths),
+		StartDate:            timestamppb.New(l.StartDate),
+		CreatedAt:            timestamppb.New(l.CreatedAt),
+		PaidDecimal:          s.Paid.String(),
+		OutstandingDecimal:   s.Outstanding.String(),
+		TotalInterestDecimal: s.TotalInterest().String(),
+		Closed:               s.Closed(),
+	}
+	for k, v := range loanDirections {
+		if v == l.Direction {
+			pb.Direction = k
+		}
+	}
+	for k, v := range loanSchedules {
+		if v == l.Schedule {
+			pb.Schedule = k
+		}
+	}
+	if s.NextPayment != nil {
+		pb.NextPayment = loanPaymentToProto(s.NextPayment)
+	}
+	return pb
+}
+
+func loanPaymentToProto(p *model.LoanPayment) *transactionpb.LoanPayment {
+	return &transactionpb.LoanPayment{
+		Number:           int32(p.Number),
+		Date:             timestamppb.New(p.Date),
+		PaymentDecimal:   p.Payment.String(),
+		PrincipalDecimal: p.Principal.String(),
+		InterestDecimal:  p.Interest.String(),
+		BalanceDecimal:   p.Balance.String(),
+	}
+}
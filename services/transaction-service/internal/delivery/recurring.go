@@ -0,0 +1,136 @@
+package delivery
+
+import (
+	"context"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Периодичность из protobuf в доменные значения
+var recurrenceFrequencies = map[transactionpb.RecurrenceFrequency]model.Frequency{
+	transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:   model.FrequencyDaily,
+	transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:  model.FrequencyWeekly,
+	transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY: model.FrequencyMonthly,
+	transactionpb.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY:  model.FrequencyYearly,
+}
+
+// Создаёт шаблон повторяющейся транзакции
+func (h *TransactionHandler) CreateRecurringTransaction(ctx context.Context, req *transactionpb.CreateRecurringTransactionRequest) (*transactionpb.CreateRecurringTransactionResponse, error) {
+	amount, err := model.ParseMoney(req.GetAmountDecimal())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetStartDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date is required")
+	}
+	r := &model.RecurringTransaction{
+		UserID:      req.GetUserId(),
+		CategoryID:  req.GetCategoryId(),
+		Type:        model.TransactionType(req.GetType().String()),
+		Amount:      amount,
+		Currency:    req.GetCurrency(),
+		Description: req.GetDescription(),
+		Frequency:   recurrenceFrequencies[req.GetFrequency()],
+		Interval:    int(req.GetInterval()),
+		StartDate:   req.GetStartDate().AsTime(),
+	}
+	if req.GetEndDate() != nil {
+		end := req.GetEndDate().AsTime()
+		r.EndDate = &end
+	}
+	if req.GetCount() > 0 {
+		count := int(req.GetCount())
+		r.MaxCount = &count
+	}
+
+	saved, err := h.recurring.CreateRecurring(ctx, r)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.CreateRecurringTransactionResponse{Recurring: recurringToProto(saved)}, nil
+}
+
+// Возвращает шаблоны пользователя
+func (h *TransactionHandler) ListRecurringTransactions(ctx context.Context, req *transactionpb.ListRecurringTransactionsRequest) (*transactionpb.ListRecurringTransactionsResponse, error) {
+	list, err := h.recurring.ListRecurring(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &transactionpb.ListRecurringTransactionsResponse{Recurring: []*transactionpb.RecurringTransaction{}}
+	for _, r := range list {
+		resp.Recurring = append(resp.Recurring, recurringToProto(r))
+	}
+	return resp, nil
+}
+
+// Ставит шаблон на паузу или возобновляет его
+func (h *TransactionHandler) PauseRecurringTransaction(ctx context.Context, req *transactionpb.PauseRecurringTransactionRequest) (*transactionpb.PauseRecurringTransactionResponse, error) {
+	r, err := h.recurring.SetPaused(ctx, req.GetRecurringId(), req.GetUserId(), req.GetPaused())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.PauseRecurringTransactionResponse{Recurring: recurringToProto(r)}, nil
+}
+
+// Пропускает ближайшее повторение
+func (h *TransactionHandler) SkipRecurringOccurrence(ctx context.Context, req *transactionpb.SkipRecurringOccurrenceRequest) (*transactionpb.SkipRecurringOccurrenceResponse, error) {
+	r, err := h.recurring.SkipNext(ctx, req.GetRecurringId(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &transactionpb.SkipRecurringOccurrenceResponse{Recurring: recurringToProto(r)}, nil
+}
+
+// Удаляет шаблон. Уже созданные по нему транзакции остаются
+func (h *TransactionHandler) DeleteRecurringTransaction(ctx context.Context, req *transactionpb.DeleteRecurringTransactionRequest) (*transactionpb.DeleteRecurringTransactionResponse, error) {
+	err := h.recurring.DeleteRecurring(ctx, req.GetRecurringId(), req.GetUserId())
+	if err != nil {
+		return &transactionpb.DeleteRecurringTransactionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &transactionpb.DeleteRecurringTransactionResponse{
+		Success: true,
+		Message: "Recurring transaction deleted",
+	}, nil
+}
+
+func recurringToProto(r *model.RecurringTransaction) *transactionpb.RecurringTransaction {
+	var frequency transactionpb.RecurrenceFrequency
+	for k, v := range recurrenceFrequencies {
+		if v == r.Frequency {
+			frequency = k
+		}
+	}
+	resp := &transactionpb.RecurringTransaction{
+		Id:            r.ID,
+		UserId:        r.UserID,
+		CategoryId:    r.CategoryID,
+		Type:          transactionpb.TransactionType(transactionpb.TransactionType_value[string(r.Type)]),
+		AmountDecimal: r.Amount.String(),
+		Currency:      r.Currency,
+		Description:   r.Description,
+		Frequency:     frequency,
+		Interval:      int32(r.Interval),
+		StartDate:     timestamppb.New(r.StartDate),
+		NextDate:      timestamppb.New(r.NextDate),
+		Occurrences:   int32(r.Occurrences),
+		Paused:        r.Paused,
+		Finished:      r.Finished(),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+	}
+	if r.EndDate != nil {
+		resp.EndDate = timestamppb.New(*r.EndDate)
+	}
+	if r.MaxCount != nil {
+		resp.Count = int32(*r.MaxCount)
+	}
+	return resp
+}
@@ -0,0 +1,62 @@
+package delivery
+
+import (
+	"context"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Шаги отчёта из protobuf в доменные значения
+var reportGranularities = map[transactionpb.ReportGranularity]model.ReportGranularity{
+	transactionpb.ReportGranularity_REPORT_GRANULARITY_DAY:   model.GranularityDay,
+	transactionpb.ReportGranularity_REPORT_GRANULARITY_WEEK:  model.GranularityWeek,
+	transactionpb.ReportGranularity_REPORT_GRANULARITY_MONTH: model.GranularityMonth,
+	transactionpb.ReportGranularity_REPORT_GRANULARITY_YEAR:  model.GranularityYear,
+}
+
+// Возвращает доходы, расходы и остатки по периодам
+func (h *TransactionHandler) GetReport(ctx context.Context, req *transactionpb.GetReportRequest) (*transactionpb.GetReportResponse, error) {
+	filter := model.ReportFilter{
+		UserID:      req.GetUserId(),
+		Granularity: reportGranularities[req.GetGranularity()],
+		ByCategory:  req.GetByCategory(),
+	}
+	if req.GetDateFrom() != nil {
+		filter.DateFrom = req.GetDateFrom().AsTime()
+	}
+	if req.GetDateTo() != nil {
+		filter.DateTo = req.GetDateTo().AsTime()
+	}
+
+	report, err := h.balances.GetReport(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &transactionpb.GetReportResponse{
+		Currency: report.Currency,
+		Buckets:  []*transactionpb.ReportBucket{},
+	}
+	for _, b := range report.Buckets {
+		bucket := &transactionpb.ReportBucket{
+			PeriodStart:           timestamppb.New(b.Start),
+			PeriodEnd:             timestamppb.New(b.End),
+			IncomeDecimal:         b.Income.String(),
+			ExpenseDecimal:        b.Expense.String(),
+			NetDecimal:            b.Net().String(),
+			OpeningBalanceDecimal: b.Opening.String(),
+			ClosingBalanceDecimal: b.Closing.String(),
+		}
+		for _, c := range b.Categories {
+			bucket.Categories = append(bucket.Categories, &transactionpb.CategoryTotal{
+				CategoryId:     c.CategoryID,
+				IncomeDecimal:  c.Income.String(),
+				ExpenseDecimal: c.Expense.String(),
+			})
+		}
+		resp.Buckets = append(resp.Buckets, bucket)
+	}
+	return resp, nil
+}
@@ -0,0 +1,114 @@
+// Запись транзакций в файлы выгрузки: CSV, JSON и OFX
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Формат файла выгрузки
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	FormatOFX  Format = "ofx"
+)
+
+// Сведения о выгрузке, нужные форматам с заголовком (OFX)
+type Meta struct {
+	UserID      string
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	GeneratedAt time.Time
+}
+
+// Пишет транзакции по одной; Close дописывает окончание файла
+type Encoder interface {
+	Encode(tx *model.Transaction) error
+	Close() error
+}
+
+func NewEncoder(format Format, w io.Writer, meta Meta) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(w)
+	case FormatJSON:
+		return &jsonEncoder{w: w}, nil
+	case FormatOFX:
+		return &ofxEncoder{w: w, meta: meta}, nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// Колонки совпадают с колонками импорта по умолчанию, поэтому выгрузку можно загрузить обратно
+var csvHeader = []string{"date", "type", "amount", "currency", "category_id", "description", "id"}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) (*csvEncoder, error) {
+	e := &csvEncoder{w: csv.NewWriter(w)}
+	if err := e.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *csvEncoder) Encode(tx *model.Transaction) error {
+	return e.w.Write([]string{
+		tx.Date.Format(model.DateLayout),
+		string(tx.Type),
+		tx.Amount.String(),
+		tx.Currency,
+		tx.CategoryID,
+		tx.Description,
+		tx.ID,
+	})
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// JSON-массив в том же виде, что отдаёт Transaction.MarshalJSON
+type jsonEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonEncoder) Encode(tx *model.Transaction) error {
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	sep := ",\n"
+	if e.count == 0 {
+		sep = "[\n"
+	}
+	e.count++
+	if _, err := io.WriteString(e.w, sep); err != nil {
+		return err
+	}
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonEncoder) Close() error {
+	end := "\n]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
@@ -0,0 +1,143 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// Форматы дат OFX: дата операции и момент времени
+const (
+	ofxDateLayout     = "20060102"
+	ofxDateTimeLayout = "20060102150405"
+)
+
+// OFX 2.1 (XML). В одной выписке (STMTRS) может быть только одна валюта,
+// поэтому при смене валюты текущая выписка закрывается и открывается новая
+type ofxEncoder struct {
+	w        io.Writer
+	meta     Meta
+	started  bool
+	open     bool   // Открыта ли выписка
+	currency string // Валюта открытой выписки
+	trnUID   int
+	balance  model.Money
+}
+
+func (e *ofxEncoder) Encode(tx *model.Transaction) error {
+	// Выписка ведётся по валюте, а не по счёту, поэтому переводы между своими
+	// счетами в неё не попадают
+	if tx.Type == model.Transfer {
+		return nil
+	}
+	if !e.started {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
+	if !e.open || tx.Currency != e.currency {
+		if err := e.closeStatement(); err != nil {
+			return err
+		}
+		if err := e.openStatement(tx.Currency); err != nil {
+			return err
+		}
+	}
+
+	trnType, amount := "CREDIT", tx.Amount
+	if tx.Type == model.Expense {
+		trnType, amount = "DEBIT", -tx.Amount
+	}
+	e.balance += amount
+
+	var b strings.Builder
+	b.WriteString("<STMTTRN>")
+	fmt.Fprintf(&b, "<TRNTYPE>%s</TRNTYPE>", trnType)
+	fmt.Fprintf(&b, "<DTPOSTED>%s</DTPOSTED>", tx.Date.Format(ofxDateLayout))
+	fmt.Fprintf(&b, "<TRNAMT>%s</TRNAMT>", amount)
+	fmt.Fprintf(&b, "<FITID>%s</FITID>", escape(tx.ID))
+	if tx.Description != "" {
+		// NAME ограничен 32 символами, полное описание идёт в MEMO
+		fmt.Fprintf(&b, "<NAME>%s</NAME>", escape(truncate(tx.Description, 32)))
+		fmt.Fprintf(&b, "<MEMO>%s</MEMO>", escape(tx.Description))
+	}
+	b.WriteString("</STMTTRN>\n")
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+func (e *ofxEncoder) Close() error {
+	if !e.started {
+		if err := e.writeHeader(); err != nil {
+			return err
+		}
+	}
+	if err := e.closeStatement(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "</BANKMSGSRSV1>\n</OFX>\n")
+	return err
+}
+
+func (e *ofxEncoder) writeHeader() error {
+	e.started = true
+	_, err := fmt.Fprintf(e.w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS><DTSERVER>%s</DTSERVER><LANGUAGE>RUS</LANGUAGE></SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+`, e.meta.GeneratedAt.UTC().Format(ofxDateTimeLayout))
+	return err
+}
+
+func (e *ofxEncoder) openStatement(currency string) error {
+	e.open = true
+	e.currency = currency
+	e.trnUID++
+	e.balance = 0
+	from, to := time.Unix(0, 0).UTC(), e.meta.GeneratedAt.UTC()
+	if e.meta.DateFrom != nil {
+		from = *e.meta.DateFrom
+	}
+	if e.meta.DateTo != nil {
+		to = *e.meta.DateTo
+	}
+	_, err := fmt.Fprintf(e.w, `<STMTTRNRS><TRNUID>%d</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<STMTRS><CURDEF>%s</CURDEF>
+<BANKACCTFROM><BANKID>FINPLAN</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>
+<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>
+`, e.trnUID, escape(currency), escape(e.meta.UserID), from.Format(ofxDateLayout), to.Format(ofxDateLayout))
+	return err
+}
+
+// Закрывает открытую выписку; LEDGERBAL — сумма операций этой выписки
+func (e *ofxEncoder) closeStatement() error {
+	if !e.open {
+		return nil
+	}
+	e.open = false
+	_, err := fmt.Fprintf(e.w, `</BANKTRANLIST>
+<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>
+</STMTRS>
+</STMTTRNRS>
+`, e.balance, e.meta.GeneratedAt.UTC().Format(ofxDateTimeLayout))
+	return err
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
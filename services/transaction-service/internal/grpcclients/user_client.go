@@ -0,0 +1,42 @@
+package grpcclients
+
+import (
+	"context"
+	"fmt"
+
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ключ метаданных с access-токеном пользователя, от имени которого идёт вызов
+const authorizationMetadataKey = "authorization"
+
+// Клиент user-service
+type UserClient struct {
+	client userpb.UserServiceClient
+	logger *zap.Logger
+}
+
+func NewUserClient(conn *grpc.ClientConn, logger *zap.Logger) *UserClient {
+	return &UserClient{
+		client: userpb.NewUserServiceClient(conn),
+		logger: logger,
+	}
+}
+
+// Валюта из профиля пользователя
+func (c *UserClient) ProfileCurrency(ctx context.Context, userID string) (string, error) {
+	// user-service отдаёт профиль только самому пользователю: передаём его же access-токен
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md.Get(authorizationMetadataKey); len(token) == 1 {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, token[0])
+	}
+	resp, err := c.client.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserId: userID})
+	if err != nil {
+		c.logger.Error("failed to get user profile", zap.String("user_id", userID), zap.Error(err))
+		return "", fmt.Errorf("failed to get user profile: %w", err)
+	}
+	return resp.GetCurrency(), nil
+}
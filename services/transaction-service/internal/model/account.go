@@ -0,0 +1,75 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Тип счёта
+type AccountType string
+
+const (
+	AccountCash    AccountType = "CASH"
+	AccountCard    AccountType = "CARD"
+	AccountSavings AccountType = "SAVINGS"
+	AccountCredit  AccountType = "CREDIT"
+	AccountOther   AccountType = "OTHER"
+)
+
+// Название основного счёта, который заводится для транзакций без явного счёта
+const DefaultAccountName = "Основной счёт"
+
+func (t AccountType) Valid() bool {
+	switch t {
+	case AccountCash, AccountCard, AccountSavings, AccountCredit, AccountOther:
+		return true
+	}
+	return false
+}
+
+// Счёт пользователя. Основной счёт (IsDefault) — один на валюту
+type Account struct {
+	ID             string      `db:"id"`
+	UserID         string      `db:"user_id"`
+	Name           string      `db:"name"`
+	Type           AccountType `db:"type"`
+	Currency       string      `db:"currency"`
+	OpeningBalance Money       `db:"opening_balance"`
+	IsDefault      bool        `db:"is_default"`
+	Archived       bool        `db:"archived"`
+	CreatedAt      time.Time   `db:"created_at"`
+}
+
+// Проверяет счёт; начальный остаток может быть отрицательным (кредитная карта)
+func (a *Account) Validate() error {
+	if a.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	a.Name = strings.TrimSpace(a.Name)
+	if a.Name == "" {
+		return errors.New("account name is required")
+	}
+	if len(a.Name) > 100 {
+		return errors.New("account name is too long")
+	}
+	if a.Type == "" {
+		a.Type = AccountCash
+	}
+	if !a.Type.Valid() {
+		return errors.New("invalid account type")
+	}
+	currency, err := NormalizeCurrency(a.Currency)
+	if err != nil {
+		return err
+	}
+	a.Currency = currency
+	return nil
+}
+
+// Счёт с текущим остатком: начальный остаток, плюс доходы и входящие переводы,
+// минус расходы и исходящие переводы
+type AccountBalance struct {
+	Account
+	Balance Money `db:"balance"`
+}
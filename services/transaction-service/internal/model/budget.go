@@ -0,0 +1,59 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Месячный лимит расходов по категории
+type Budget struct {
+	ID         string    `db:"id" json:"id"`
+	UserID     string    `db:"user_id" json:"user_id"`
+	CategoryID string    `db:"category_id" json:"category_id"`
+	Limit      Money     `db:"amount" json:"limit"`
+	Currency   string    `db:"currency" json:"currency"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
+}
+
+func (b *Budget) Validate() error {
+	if b.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	if b.CategoryID == "" {
+		return errors.New("category_id is required")
+	}
+	if b.Limit <= 0 {
+		return errors.New("limit must be greater than zero")
+	}
+	currency, err := NormalizeCurrency(b.Currency)
+	if err != nil {
+		return err
+	}
+	b.Currency = currency
+	return nil
+}
+
+// Расходы по категории в одной валюте
+type CategorySpending struct {
+	CategoryID string `db:"category_id"`
+	Currency   string `db:"currency"`
+	Amount     Money  `db:"amount"`
+}
+
+// Исполнение бюджета за месяц
+type BudgetStatus struct {
+	Budget *Budget
+	Month  time.Time
+	Spent  Money
+}
+
+// Остаток лимита; отрицательный при перерасходе
+func (s *BudgetStatus) Remaining() Money {
+	return s.Budget.Limit - s.Spent
+}
+
+// Траты превысили лимит
+func (s *BudgetStatus) Overspent() bool {
+	return s.Spent > s.Budget.Limit
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Доменная модель категории. У системных категорий UserID пустой
+type Category struct {
+	ID          string          `db:"id" json:"id"`
+	UserID      string          `db:"user_id" json:"user_id"`
+	Name        string          `db:"name" json:"name"`
+	Description string          `db:"description" json:"description"`
+	Type        TransactionType `db:"type" json:"type"`
+	Archived    bool            `db:"archived" json:"archived"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+}
+
+// Системная категория доступна всем пользователям
+func (c *Category) IsSystem() bool {
+	return c.UserID == ""
+}
+
+// Категория доступна пользователю, если она системная или принадлежит ему
+func (c *Category) AvailableTo(userID string) bool {
+	return c.IsSystem() || c.UserID == userID
+}
+
+func (c *Category) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("category name is required")
+	}
+	if len(c.Name) > 100 {
+		return errors.New("category name is too long")
+	}
+	if c.Type != Income && c.Type != Expense {
+		return errors.New("invalid category type")
+	}
+	return nil
+}
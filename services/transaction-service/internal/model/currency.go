@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Валюта транзакций по умолчанию (и для записей, созданных до мультивалютности)
+const DefaultCurrency = "RUB"
+
+// Поддерживаемые валюты, как в профиле user-service
+var SupportedCurrencies = map[string]bool{
+	"RUB": true,
+	"USD": true,
+	"EUR": true,
+}
+
+// Приводит код валюты к виду "USD" и проверяет, что он поддерживается
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+	if !SupportedCurrencies[code] {
+		return "", fmt.Errorf("unsupported currency: %s", code)
+	}
+	return code, nil
+}
+
+// Итоги по одной валюте без пересчёта
+type CurrencyBalance struct {
+	Currency string `db:"currency"`
+	Income   Money  `db:"income"`
+	Expense  Money  `db:"expense"`
+}
+
+// Доходы минус расходы
+func (b CurrencyBalance) Balance() Money {
+	return b.Income - b.Expense
+}
+
+// Баланс пользователя: итоги в валюте профиля и разбивка по исходным валютам
+type BalanceReport struct {
+	Currency   string
+	Income     Money
+	Expense    Money
+	ByCurrency []CurrencyBalance
+}
+
+// Доходы минус расходы в валюте профиля
+func (r *BalanceReport) Balance() Money {
+	return r.Income - r.Expense
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Позиция последней записи страницы для keyset-пагинации.
+// Ключ — (поле сортировки, created_at, id), поэтому порядок стабилен
+// даже при вставке новых транзакций между запросами страниц
+type Cursor struct {
+	SortBy    SortField `json:"s"`
+	Ascending bool      `json:"a,omitempty"`
+	Date      time.Time `json:"d"`
+	Amount    Money     `json:"m,omitempty"`
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// Курсор, указывающий на позицию сразу после транзакции t
+func CursorAfter(t *Transaction, sortBy SortField, ascending bool) *Cursor {
+	return &Cursor{
+		SortBy:    sortBy,
+		Ascending: ascending,
+		Date:      t.Date,
+		Amount:    t.Amount,
+		CreatedAt: t.CreatedAt,
+		ID:        t.ID,
+	}
+}
+
+// Кодирует курсор в непрозрачный токен страницы
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Разбирает токен страницы, выданный Encode
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	return &c, nil
+}
+
+// Страница списка транзакций
+type TransactionPage struct {
+	Transactions  []*Transaction
+	TotalCount    int64
+	NextPageToken string
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Ограничения размера страницы списка транзакций
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// Поле сортировки списка транзакций
+type SortField string
+
+const (
+	SortByDate      SortField = "date"
+	SortByAmount    SortField = "amount"
+	SortByCreatedAt SortField = "created_at"
+)
+
+// Фильтр списка транзакций. Пустые поля не применяются
+type TransactionFilter struct {
+	UserID      string
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	Type        TransactionType
+	CategoryIDs []string
+	AmountMin   *Money
+	AmountMax   *Money
+	Search      string
+	SortBy      SortField
+	Ascending   bool
+	Limit       int
+	Offset      int
+	// Токен следующей страницы; при разборе заполняется Cursor
+	PageToken string
+	Cursor    *Cursor
+	// Общий бюджет: транзакции всех его участников вместо личных транзакций UserID
+	HouseholdID string
+	// Операции по счёту, включая входящие переводы
+	AccountID string
+	// Взносы в цель накоплений
+	GoalID string
+	// Погашения займа
+	LoanID string
+}
+
+// Подставляет значения по умолчанию для сортировки и пагинации
+func (f *TransactionFilter) Normalize() {
+	if f.SortBy == "" {
+		f.SortBy = SortByDate
+	}
+	if f.Limit <= 0 {
+		f.Limit = DefaultListLimit
+	}
+	if f.Limit > MaxListLimit {
+		f.Limit = MaxListLimit
+	}
+	if f.Offset < 0 {
+		f.Offset = 0
+	}
+}
+
+func (f *TransactionFilter) Validate() error {
+	if f.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	if f.Type != "" && f.Type != Income && f.Type != Expense && f.Type != Transfer {
+		return errors.New("invalid transaction type")
+	}
+	if f.DateFrom != nil && f.DateTo != nil && f.DateFrom.After(*f.DateTo) {
+		return errors.New("date_from must not be after date_to")
+	}
+	if f.AmountMin != nil && f.AmountMax != nil && *f.AmountMin > *f.AmountMax {
+		return errors.New("amount_min must not be greater than amount_max")
+	}
+	switch f.SortBy {
+	case "", SortByDate, SortByAmount, SortByCreatedAt:
+	default:
+		return errors.New("invalid sort field")
+	}
+	if f.Cursor != nil {
+		if f.Offset > 0 {
+			return errors.New("offset cannot be combined with page_token")
+		}
+		if f.Cursor.SortBy != f.SortBy || f.Cursor.Ascending != f.Ascending {
+			return errors.New("page_token does not match sort order")
+		}
+	}
+	return nil
+}
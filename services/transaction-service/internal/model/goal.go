@@ -0,0 +1,162 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Число полных месяцев, по которым считается средний чистый доход для прогноза цели
+const GoalIncomeMonths = 3
+
+// Цель накоплений. Взносы — транзакции и переводы с goal_id этой цели
+type Goal struct {
+	ID           string     `db:"id"`
+	UserID       string     `db:"user_id"`
+	Name         string     `db:"name"`
+	TargetAmount Money      `db:"target_amount"`
+	Currency     string     `db:"currency"`
+	Deadline     *time.Time `db:"deadline"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+}
+
+func (g *Goal) Validate() error {
+	if g.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	g.Name = strings.TrimSpace(g.Name)
+	if g.Name == "" {
+		return errors.New("goal name is required")
+	}
+	if len(g.Name) > 100 {
+		return errors.New("goal name is too long")
+	}
+	if g.TargetAmount <= 0 {
+		return errors.New("target amount must be greater than zero")
+	}
+	currency, err := NormalizeCurrency(g.Currency)
+	if err != nil {
+		return err
+	}
+	g.Currency = currency
+	return nil
+}
+
+// Частичное обновление цели: nil-поля не меняются.
+// ClearDeadline снимает срок
+type GoalPatch struct {
+	Name          *string
+	TargetAmount  *Money
+	Currency      *string
+	Deadline      *time.Time
+	ClearDeadline bool
+}
+
+func (p *GoalPatch) IsEmpty() bool {
+	return p.Name == nil && p.TargetAmount == nil && p.Currency == nil && p.Deadline == nil && !p.ClearDeadline
+}
+
+// Применяет изменения к цели
+func (p *GoalPatch) Apply(g *Goal) {
+	if p.Name != nil {
+		g.Name = *p.Name
+	}
+	if p.TargetAmount != nil {
+		g.TargetAmount = *p.TargetAmount
+	}
+	if p.Currency != nil {
+		g.Currency = *p.Currency
+	}
+	if p.Deadline != nil {
+		d := *p.Deadline
+		g.Deadline = &d
+	}
+	if p.ClearDeadline {
+		g.Deadline = nil
+	}
+}
+
+// Сумма взносов в цель в одной валюте. У перевода валюта — валюта счёта зачисления
+type GoalContribution struct {
+	GoalID   string `db:"goal_id"`
+	Currency string `db:"currency"`
+	Amount   Money  `db:"amount"`
+}
+
+// Прогресс цели в её валюте
+type GoalProgress struct {
+	Goal        *Goal
+	Contributed Money
+	// Средний чистый доход (доходы минус расходы) в месяц за последние GoalIncomeMonths месяцев
+	AverageNetIncome Money
+	// Ежемесячный взнос, нужный, чтобы успеть к сроку; 0 без срока или если цель достигнута
+	RequiredMonthly Money
+	// Дата достижения, если откладывать весь средний чистый доход; nil, если он не положительный
+	ProjectedDate *time.Time
+}
+
+// Считает прогресс цели на дату now
+func NewGoalProgress(g *Goal, contributed, averageNetIncome Money, now time.Time) *GoalProgress {
+	p := &GoalProgress{Goal: g, Contributed: contributed, AverageNetIncome: averageNetIncome}
+	today := GranularityDay.Truncate(now)
+	remaining := p.Remaining()
+	if remaining == 0 {
+		p.ProjectedDate = &today
+		return p
+	}
+	if g.Deadline != nil {
+		p.RequiredMonthly = divideUp(remaining, monthsUntil(today, *g.Deadline))
+	}
+	if averageNetIncome > 0 {
+		months := (int64(remaining) + int64(averageNetIncome) - 1) / int64(averageNetIncome)
+		projected := today.AddDate(0, int(months), 0)
+		p.ProjectedDate = &projected
+	}
+	return p
+}
+
+// Сколько осталось накопить; 0, если цель достигнута
+func (p *GoalProgress) Remaining() Money {
+	if p.Contributed >= p.Goal.TargetAmount {
+		return 0
+	}
+	return p.Goal.TargetAmount - p.Contributed
+}
+
+func (p *GoalProgress) Completed() bool {
+	return p.Contributed >= p.Goal.TargetAmount
+}
+
+// Процент выполнения; больше 100, если накоплено сверх цели
+func (p *GoalProgress) Percent() float64 {
+	return float64(p.Contributed) / float64(p.Goal.TargetAmount) * 100
+}
+
+// Цель достигнута или прогноз укладывается в срок (для цели без срока — прогноз есть)
+func (p *GoalProgress) OnTrack() bool {
+	if p.Completed() {
+		return true
+	}
+	if p.ProjectedDate == nil {
+		return false
+	}
+	return p.Goal.Deadline == nil || !p.ProjectedDate.After(*p.Goal.Deadline)
+}
+
+// Число ежемесячных взносов до срока, не меньше одного
+func monthsUntil(from, deadline time.Time) int64 {
+	months := (deadline.Year()-from.Year())*12 + int(deadline.Month()-from.Month())
+	if deadline.Day() < from.Day() {
+		months--
+	}
+	if months < 1 {
+		return 1
+	}
+	return int64(months)
+}
+
+// Деление с округлением вверх до копейки
+func divideUp(amount Money, n int64) Money {
+	return Money((int64(amount) + n - 1) / n)
+}
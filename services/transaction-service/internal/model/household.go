@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Роль участника общего бюджета
+type HouseholdRole string
+
+const (
+	HouseholdOwner  HouseholdRole = "owner"
+	HouseholdEditor HouseholdRole = "editor"
+	HouseholdViewer HouseholdRole = "viewer"
+)
+
+// Роль, которую можно выдать приглашением или сменой роли (владелец один)
+func (r HouseholdRole) Assignable() bool {
+	return r == HouseholdEditor || r == HouseholdViewer
+}
+
+// Участник может добавлять и менять свои транзакции в бюджете
+func (r HouseholdRole) CanEdit() bool {
+	return r == HouseholdOwner || r == HouseholdEditor
+}
+
+// Общий бюджет семьи. Role — роль пользователя, для которого он загружен
+type Household struct {
+	ID        string        `db:"id"`
+	Name      string        `db:"name"`
+	OwnerID   string        `db:"owner_id"`
+	Role      HouseholdRole `db:"role"`
+	CreatedAt time.Time     `db:"created_at"`
+}
+
+func (h *Household) Validate() error {
+	if h.OwnerID == "" {
+		return errors.New("user_id is required")
+	}
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.New("household name is required")
+	}
+	if len(h.Name) > 100 {
+		return errors.New("household name is too long")
+	}
+	return nil
+}
+
+// Участник общего бюджета
+type HouseholdMember struct {
+	HouseholdID string        `db:"household_id"`
+	UserID      string        `db:"user_id"`
+	Role        HouseholdRole `db:"role"`
+	JoinedAt    time.Time     `db:"joined_at"`
+}
+
+// Приглашение в общий бюджет. Code заполняется только при создании
+// и не сохраняется: в базе лежит CodeHash
+type HouseholdInvitation struct {
+	ID          string        `db:"id"`
+	HouseholdID string        `db:"household_id"`
+	InvitedBy   string        `db:"invited_by"`
+	Role        HouseholdRole `db:"role"`
+	CodeHash    string        `db:"code_hash"`
+	Code        string        `db:"-"`
+	ExpiresAt   time.Time     `db:"expires_at"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
@@ -0,0 +1,59 @@
+package model
+
+// Максимальное число строк в одном файле импорта
+const MaxImportRows = 5000
+
+// Названия колонок CSV для полей транзакции
+type ImportColumns struct {
+	Date        string
+	Amount      string
+	Description string
+	Type        string
+	CategoryID  string
+	Currency    string
+}
+
+// Настройки разбора выписки
+type ImportOptions struct {
+	Delimiter                rune
+	DateFormat               string
+	DecimalSeparator         string
+	Columns                  ImportColumns
+	DefaultIncomeCategoryID  string
+	DefaultExpenseCategoryID string
+	Currency                 string
+}
+
+// Строка выписки после разбора: либо транзакция, либо причина отказа
+type ImportRow struct {
+	Line        int
+	Transaction *Transaction
+	Err         error
+}
+
+// Итог обработки строки для ответа клиенту
+type ImportResult struct {
+	Line          int
+	Accepted      bool
+	TransactionID string
+	Error         string
+}
+
+// Отчёт об импорте по всем строкам файла
+type ImportReport struct {
+	Rows []ImportResult
+}
+
+func (r *ImportReport) Accepted() int {
+	n := 0
+	for _, row := range r.Rows {
+		if row.Accepted {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *ImportReport) Rejected() int {
+	return len(r.Rows) - r.Accepted()
+}
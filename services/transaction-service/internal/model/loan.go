@@ -0,0 +1,228 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// Направление займа
+type LoanDirection string
+
+const (
+	// Мы дали в долг: остаток — актив, погашения — доходы
+	LoanLent LoanDirection = "LENT"
+	// Мы взяли в долг: остаток — обязательство, погашения — расходы
+	LoanBorrowed LoanDirection = "BORROWED"
+)
+
+func (d LoanDirection) Valid() bool {
+	return d == LoanLent || d == LoanBorrowed
+}
+
+// Тип транзакции погашения для займа этого направления
+func (d LoanDirection) RepaymentType() TransactionType {
+	if d == LoanLent {
+		return Income
+	}
+	return Expense
+}
+
+// Вид графика платежей
+type LoanSchedule string
+
+const (
+	// Равные ежемесячные платежи
+	ScheduleAnnuity LoanSchedule = "ANNUITY"
+	// Равные доли основного долга, проценты на остаток
+	ScheduleDifferentiated LoanSchedule = "DIFFERENTIATED"
+)
+
+// Ограничения параметров займа
+const (
+	MaxLoanTermMonths = 600
+	MaxLoanRate       = 1000
+)
+
+// Займ или долг. Погашения — транзакции с loan_id этого займа
+type Loan struct {
+	ID           string        `db:"id"`
+	UserID       string        `db:"user_id"`
+	Counterparty string        `db:"counterparty"`
+	Direction    LoanDirection `db:"direction"`
+	Schedule     LoanSchedule  `db:"schedule"`
+	Principal    Money         `db:"principal"`
+	Currency     string        `db:"currency"`
+	// Годовая ставка в процентах
+	AnnualRate float64 `db:"annual_rate"`
+	TermMonths int     `db:"term_months"`
+	// Дата выдачи; платежи — ежемесячно начиная через месяц
+	StartDate time.Time `db:"start_date"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (l *Loan) Validate() error {
+	if l.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	l.Counterparty = strings.TrimSpace(l.Counterparty)
+	if l.Counterparty == "" {
+		return errors.New("counterparty is required")
+	}
+	if len(l.Counterparty) > 100 {
+		return errors.New("counterparty is too long")
+	}
+	if !l.Direction.Valid() {
+		return errors.New("invalid loan direction")
+	}
+	if l.Schedule == "" {
+		l.Schedule = ScheduleAnnuity
+	}
+	if l.Schedule != ScheduleAnnuity && l.Schedule != ScheduleDifferentiated {
+		return errors.New("invalid loan schedule")
+	}
+	if l.Principal <= 0 {
+		return errors.New("principal must be greater than zero")
+	}
+	if l.AnnualRate < 0 || l.AnnualRate > MaxLoanRate || math.IsNaN(l.AnnualRate) {
+		return errors.New("invalid annual rate")
+	}
+	if l.TermMonths <= 0 || l.TermMonths > MaxLoanTermMonths {
+		return errors.New("invalid loan term")
+	}
+	if l.StartDate.IsZero() {
+		return errors.New("start_date is required")
+	}
+	l.StartDate = GranularityDay.Truncate(l.StartDate)
+	currency, err := NormalizeCurrency(l.Currency)
+	if err != nil {
+		return err
+	}
+	l.Currency = currency
+	return nil
+}
+
+// Платёж по графику
+type LoanPayment struct {
+	Number    int
+	Date      time.Time
+	Payment   Money
+	Principal Money
+	Interest  Money
+	// Остаток основного долга после платежа
+	Balance Money
+}
+
+// Строит график платежей. Суммы округляются до копейки,
+// погрешность округления закрывает последний платёж
+func (l *Loan) PaymentSchedule() []LoanPayment {
+	rate := l.AnnualRate / 100 / 12
+	n := l.TermMonths
+
+	var annuity Money
+	if l.Schedule == ScheduleAnnuity {
+		if rate == 0 {
+			annuity = divideUp(l.Principal, int64(n))
+		} else {
+			annuity = MoneyFromFloat(float64(l.Principal) / 100 * rate / (1 - math.Pow(1+rate, -float64(n))))
+		}
+	}
+
+	schedule := make([]LoanPayment, 0, n)
+	balance := l.Principal
+	for i := 1; i <= n && balance > 0; i++ {
+		p := LoanPayment{
+			Number:   i,
+			Date:     addMonthsClamped(l.StartDate.Year(), l.StartDate.Month(), l.StartDate.Day(), i),
+			Interest: Money(math.Round(float64(balance) * rate)),
+		}
+		switch {
+		case i == n:
+			p.Principal = balance
+		case l.Schedule == ScheduleAnnuity:
+			p.Principal = annuity - p.Interest
+		default:
+			p.Principal = l.Principal / Money(n)
+		}
+		if p.Principal > balance {
+			p.Principal = balance
+		}
+		balance -= p.Principal
+		p.Payment = p.Principal + p.Interest
+		p.Balance = balance
+		schedule = append(schedule, p)
+	}
+	return schedule
+}
+
+// Сумма погашений займа в одной валюте
+type LoanRepayment struct {
+	LoanID   string `db:"loan_id"`
+	Currency string `db:"currency"`
+	Amount   Money  `db:"amount"`
+}
+
+// Состояние займа в его валюте
+type LoanStatus struct {
+	Loan     *Loan
+	Schedule []LoanPayment
+	// Сумма погашений
+	Paid Money
+	// Остаток основного долга
+	Outstanding Money
+	// Ближайший не покрытый погашениями платёж; nil у погашенного займа
+	NextPayment *LoanPayment
+}
+
+// Раскладывает погашения по графику: каждое сначала покрывает проценты
+// очередного платежа, затем основной долг
+func NewLoanStatus(l *Loan, paid Money) *LoanStatus {
+	s := &LoanStatus{Loan: l, Schedule: l.PaymentSchedule(), Paid: paid, Outstanding: l.Principal}
+	left := paid
+	for i := range s.Schedule {
+		p := s.Schedule[i]
+		if left >= p.Payment {
+			left -= p.Payment
+			s.Outstanding = p.Balance
+			continue
+		}
+		if left > p.Interest {
+			s.Outstanding -= left - p.Interest
+		}
+		s.NextPayment = &s.Schedule[i]
+		break
+	}
+	return s
+}
+
+func (s *LoanStatus) Closed() bool {
+	return s.Outstanding == 0
+}
+
+// Проценты за весь срок по графику
+func (s *LoanStatus) TotalInterest() Money {
+	var total Money
+	for _, p := range s.Schedule {
+		total += p.Interest
+	}
+	return total
+}
+
+// Чистая стоимость в валюте профиля
+type NetWorth struct {
+	Currency string
+	// Сумма остатков по открытым счетам
+	Accounts Money
+	// Остаток выданных займов
+	Lent Money
+	// Остаток полученных займов
+	Borrowed    Money
+	AccountList []*AccountBalance
+	// Непогашенные займы
+	Loans []*LoanStatus
+}
+
+func (n *NetWorth) Total() Money {
+	return n.Accounts + n.Lent - n.Borrowed
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Число знаков после запятой у сумм (колонки NUMERIC(14,2))
+const moneyScale = 2
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// Денежная сумма в минимальных единицах (копейках, центах).
+// Хранится целым числом, поэтому суммирование не накапливает ошибку округления
+type Money int64
+
+// Разбирает десятичную строку вида "-1234.5" или "10.05" без потери точности.
+// Допускается не больше двух знаков после точки
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalidMoney
+	}
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return 0, ErrInvalidMoney
+	}
+	if len(fracPart) > moneyScale || (hasDot && fracPart == "") {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	fracPart += strings.Repeat("0", moneyScale-len(fracPart))
+	if !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	v, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	if negative {
+		v = -v
+	}
+	return Money(v), nil
+}
+
+// Переводит float64 в сумму с округлением до копеек.
+// Нужен только для совместимости со старыми клиентами, передающими double
+func MoneyFromFloat(f float64) Money {
+	return Money(math.Round(f * 100))
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Десятичная запись суммы, например "1234.50"
+func (m Money) String() string {
+	v := int64(m)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// Приближённое значение для полей double (может терять точность)
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// Сериализуется в JSON точным десятичным числом: 1234.50
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Принимает как число, так и строку: 1234.5 или "1234.50"
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Читает NUMERIC из базы без промежуточного float64
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case int64:
+		*m = Money(v * 100)
+		return nil
+	case float64:
+		*m = MoneyFromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+}
+
+// NUMERIC(14,2) всегда приходит с двумя знаками, но агрегаты могут вернуть больше
+func (m *Money) scanString(s string) error {
+	if intPart, fracPart, ok := strings.Cut(s, "."); ok && len(fracPart) > moneyScale {
+		if strings.Trim(fracPart[moneyScale:], "0") != "" {
+			return fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+		}
+		s = intPart + "." + fracPart[:moneyScale]
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Передаётся в базу строкой, которую Postgres разбирает в NUMERIC точно
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Периодичность повторяющейся транзакции
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// Шаблон повторяющейся транзакции. Повторения нумеруются с нуля,
+// Occurrences — номер следующего повторения (созданные и пропущенные уже учтены)
+type RecurringTransaction struct {
+	ID          string          `db:"id"`
+	UserID      string          `db:"user_id"`
+	CategoryID  string          `db:"category_id"`
+	Type        TransactionType `db:"type"`
+	Amount      Money           `db:"amount"`
+	Currency    string          `db:"currency"`
+	Description string          `db:"description"`
+	Frequency   Frequency       `db:"frequency"`
+	Interval    int             `db:"repeat_interval"`
+	StartDate   time.Time       `db:"start_date"`
+	EndDate     *time.Time      `db:"end_date"`
+	MaxCount    *int            `db:"max_count"`
+	Occurrences int             `db:"occurrences"`
+	NextDate    time.Time       `db:"next_date"`
+	Paused      bool            `db:"paused"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+}
+
+func (r *RecurringTransaction) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("amount must be greater than zero")
+	}
+	if r.Type != Income && r.Type != Expense {
+		return errors.New("invalid transaction type")
+	}
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	default:
+		return errors.New("invalid frequency")
+	}
+	if r.Interval < 1 {
+		return errors.New("interval must be positive")
+	}
+	if r.StartDate.IsZero() {
+		return errors.New("start_date is required")
+	}
+	if r.EndDate != nil && r.EndDate.Before(r.StartDate) {
+		return errors.New("end_date must not be before start_date")
+	}
+	if r.MaxCount != nil && *r.MaxCount < 1 {
+		return errors.New("count must be positive")
+	}
+	currency, err := NormalizeCurrency(r.Currency)
+	if err != nil {
+		return err
+	}
+	r.Currency = currency
+	return nil
+}
+
+// Дата n-го повторения. Считается от даты начала, поэтому 31-е число
+// в коротких месяцах становится последним днём, но не сдвигает следующие даты
+func (r *RecurringTransaction) Occurrence(n int) time.Time {
+	y, m, d := r.StartDate.Date()
+	step := n * r.Interval
+	switch r.Frequency {
+	case FrequencyDaily:
+		return time.Date(y, m, d+step, 0, 0, 0, 0, time.UTC)
+	case FrequencyWeekly:
+		return time.Date(y, m, d+7*step, 0, 0, 0, 0, time.UTC)
+	case FrequencyYearly:
+		return addMonthsClamped(y, m, d, 12*step)
+	default:
+		return addMonthsClamped(y, m, d, step)
+	}
+}
+
+func addMonthsClamped(y int, m time.Month, d, months int) time.Time {
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, time.UTC)
+}
+
+// Переходит к следующему повторению
+func (r *RecurringTransaction) Advance() {
+	r.Occurrences++
+	r.NextDate = r.Occurrence(r.Occurrences)
+}
+
+// Повторений больше не будет: исчерпан счётчик или пройдена дата окончания
+func (r *RecurringTransaction) Finished() bool {
+	if r.MaxCount != nil && r.Occurrences >= *r.MaxCount {
+		return true
+	}
+	return r.EndDate != nil && r.NextDate.After(*r.EndDate)
+}
+
+// Транзакция для повторения на указанную дату
+func (r *RecurringTransaction) TransactionFor(date time.Time) *Transaction {
+	return &Transaction{
+		UserID:      r.UserID,
+		CategoryID:  r.CategoryID,
+		Type:        r.Type,
+		Amount:      r.Amount,
+		Currency:    r.Currency,
+		Description: r.Description,
+		Date:        date,
+		RecurringID: r.ID,
+	}
+}
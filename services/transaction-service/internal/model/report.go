@@ -0,0 +1,120 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Максимальное число периодов в одном отчёте
+const MaxReportBuckets = 1000
+
+// Шаг периодов отчёта
+type ReportGranularity string
+
+const (
+	GranularityDay   ReportGranularity = "day"
+	GranularityWeek  ReportGranularity = "week"
+	GranularityMonth ReportGranularity = "month"
+	GranularityYear  ReportGranularity = "year"
+)
+
+// Начало периода, в который попадает t (неделя — с понедельника), как date_trunc
+func (g ReportGranularity) Truncate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch g {
+	case GranularityDay:
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	case GranularityWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, time.UTC)
+	case GranularityYear:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Начало следующего периода
+func (g ReportGranularity) Next(start time.Time) time.Time {
+	switch g {
+	case GranularityDay:
+		return start.AddDate(0, 0, 1)
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// Параметры отчёта за период
+type ReportFilter struct {
+	UserID      string
+	DateFrom    time.Time
+	DateTo      time.Time
+	Granularity ReportGranularity
+	ByCategory  bool
+}
+
+func (f *ReportFilter) Validate() error {
+	if f.UserID == "" {
+		return errors.New("user_id is required")
+	}
+	if f.DateFrom.IsZero() || f.DateTo.IsZero() {
+		return errors.New("date_from and date_to are required")
+	}
+	if f.DateFrom.After(f.DateTo) {
+		return errors.New("date_from must not be after date_to")
+	}
+	switch f.Granularity {
+	case GranularityDay, GranularityWeek, GranularityMonth, GranularityYear:
+	default:
+		return errors.New("invalid granularity")
+	}
+	n := 0
+	for start := f.Granularity.Truncate(f.DateFrom); !start.After(f.DateTo); start = f.Granularity.Next(start) {
+		if n++; n > MaxReportBuckets {
+			return errors.New("too many periods in report, use a larger granularity")
+		}
+	}
+	return nil
+}
+
+// Строка агрегата из базы: суммы за период в одной валюте (и категории, если нужна разбивка)
+type ReportRow struct {
+	Period     time.Time `db:"period"`
+	Currency   string    `db:"currency"`
+	CategoryID string    `db:"category_id"`
+	Income     Money     `db:"income"`
+	Expense    Money     `db:"expense"`
+}
+
+// Суммы по категории за период
+type CategoryTotal struct {
+	CategoryID string
+	Income     Money
+	Expense    Money
+}
+
+// Итоги одного периода [Start, End)
+type ReportBucket struct {
+	Start      time.Time
+	End        time.Time
+	Income     Money
+	Expense    Money
+	Opening    Money
+	Closing    Money
+	Categories []CategoryTotal
+}
+
+// Доходы минус расходы за период
+func (b *ReportBucket) Net() Money {
+	return b.Income - b.Expense
+}
+
+// Отчёт за период в валюте профиля
+type Report struct {
+	Currency string
+	Buckets  []*ReportBucket
+}
@@ -8,9 +8,14 @@ import (
 
 type TransactionType string
 
+// Формат даты операции в JSON и файлах выгрузки
+const DateLayout = "2006-01-02"
+
 const (
 	Income  TransactionType = "INCOME"
 	Expense TransactionType = "EXPENSE"
+	// Перевод между своими счетами: не доход и не расход
+	Transfer TransactionType = "TRANSFER"
 )
 
 // Доменная модель транзакции
@@ -19,10 +24,71 @@ type Transaction struct {
 	UserID      string          `db:"user_id" json:"user_id"`
 	CategoryID  string          `db:"category_id" json:"category_id"`
 	Type        TransactionType `db:"type" json:"type"`
-	Amount      float64         `db:"amount" json:"amount"`
+	Amount      Money           `db:"amount" json:"amount"`
+	Currency    string          `db:"currency" json:"currency"`
 	Description string          `db:"description" json:"description"`
 	Date        time.Time       `db:"date" json:"date"`
 	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at" json:"updated_at"`
+	// Шаблон, по которому создана транзакция (пусто для ручных)
+	RecurringID string `db:"recurring_id" json:"recurring_id,omitempty"`
+	// Общий бюджет (пусто для личных); UserID — автор записи
+	HouseholdID string `db:"household_id" json:"household_id,omitempty"`
+	// Счёт списания (у дохода — зачисления)
+	AccountID string `db:"account_id" json:"account_id"`
+	// Для перевода: счёт и сумма зачисления в его валюте
+	TransferAccountID string `db:"transfer_account_id" json:"transfer_account_id,omitempty"`
+	TransferAmount    Money  `db:"transfer_amount" json:"transfer_amount,omitempty"`
+	// Цель накоплений, в которую засчитан взнос
+	GoalID string `db:"goal_id" json:"goal_id,omitempty"`
+	// Займ, который гасит транзакция
+	LoanID string `db:"loan_id" json:"loan_id,omitempty"`
+}
+
+// Частичное обновление транзакции: nil-поля не меняются
+type TransactionPatch struct {
+	CategoryID  *string
+	AccountID   *string
+	Type        *TransactionType
+	Amount      *Money
+	Currency    *string
+	Description *string
+	Date        *time.Time
+}
+
+// Нет ни одного изменяемого поля
+func (p *TransactionPatch) IsEmpty() bool {
+	return p.CategoryID == nil && p.AccountID == nil && p.Type == nil && p.Amount == nil && p.Currency == nil && p.Description == nil && p.Date == nil
+}
+
+// Меняется ли категория или тип (их нужно заново проверить)
+func (p *TransactionPatch) TouchesCategory() bool {
+	return p.CategoryID != nil || p.Type != nil
+}
+
+// Применяет изменения к транзакции
+func (p *TransactionPatch) Apply(t *Transaction) {
+	if p.CategoryID != nil {
+		t.CategoryID = *p.CategoryID
+	}
+	if p.AccountID != nil {
+		t.AccountID = *p.AccountID
+	}
+	if p.Type != nil {
+		t.Type = *p.Type
+	}
+	if p.Amount != nil {
+		t.Amount = *p.Amount
+	}
+	if p.Currency != nil {
+		t.Currency = *p.Currency
+	}
+	if p.Description != nil {
+		t.Description = *p.Description
+	}
+	if p.Date != nil {
+		t.Date = *p.Date
+	}
 }
 
 type transactionAlias Transaction
@@ -33,10 +99,12 @@ func (t *Transaction) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
 		Date      string `json:"date"`
 		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
 		*Alias
 	}{
-		Date:      t.Date.Format("2006-01-02"),
+		Date:      t.Date.Format(DateLayout),
 		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: t.UpdatedAt.Format(time.RFC3339),
 		Alias:     (*Alias)(t),
 	})
 }
@@ -46,6 +114,7 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		Date      string `json:"date"`
 		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
 		*Alias
 	}{
 		Alias: (*Alias)(t),
@@ -54,7 +123,7 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	var err error
-	t.Date, err = time.Parse("2006-01-02", aux.Date)
+	t.Date, err = time.Parse(DateLayout, aux.Date)
 	if err != nil {
 		return err
 	}
@@ -62,6 +131,13 @@ func (t *Transaction) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+	// updated_at может отсутствовать у старых клиентов
+	if aux.UpdatedAt != "" {
+		t.UpdatedAt, err = time.Parse(time.RFC3339, aux.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -69,11 +145,40 @@ func (t *Transaction) Validate() error {
 	if t.Amount <= 0 {
 		return errors.New("amount must be greater than zero")
 	}
-	if t.Type != Income && t.Type != Expense {
+	if t.Type != Income && t.Type != Expense && t.Type != Transfer {
 		return errors.New("invalid transaction type")
 	}
+	if t.GoalID != "" && t.LoanID != "" {
+		return errors.New("goal contribution cannot be a loan repayment")
+	}
+	if t.Type == Transfer {
+		if t.TransferAccountID == "" {
+			return errors.New("transfer_account_id is required for transfer")
+		}
+		if t.TransferAccountID == t.AccountID {
+			return errors.New("transfer accounts must differ")
+		}
+		if t.CategoryID != "" {
+			return errors.New("transfer cannot have a category")
+		}
+		if t.HouseholdID != "" {
+			return errors.New("transfer cannot belong to a household")
+		}
+		// 0 — сумма зачисления та же, что и списания; её подставляет сервис
+		if t.TransferAmount < 0 {
+			return errors.New("transfer amount must not be negative")
+		}
+	} else if t.TransferAccountID != "" || t.TransferAmount != 0 {
+		return errors.New("transfer fields are allowed only for transfer")
+	}
 	if t.Date.After(time.Now()) {
 		return errors.New("date cannot be in the future")
 	}
+	// Пустая валюта заменяется на валюту по умолчанию, код приводится к верхнему регистру
+	currency, err := NormalizeCurrency(t.Currency)
+	if err != nil {
+		return err
+	}
+	t.Currency = currency
 	return nil
 }
@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Если счёт не найден или недоступен для изменения
+var ErrAccountNotFound = errors.New("account not found")
+
+// Интерфейс для работы со счетами
+type AccountRepository interface {
+	Create(ctx context.Context, a *model.Account) error
+	GetByID(ctx context.Context, id string) (*model.Account, error)
+	EnsureDefault(ctx context.Context, userID, currency string, now time.Time) (*model.Account, error)
+	ListBalances(ctx context.Context, userID string, includeArchived bool) ([]*model.AccountBalance, error)
+	Archive(ctx context.Context, id, userID string) error
+}
+
+// Реализация AccountRepository
+type accountRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр accountRepo
+func NewAccountRepo(db *sqlx.DB, logger *zap.Logger) AccountRepository {
+	return &accountRepo{db: db, logger: logger}
+}
+
+const accountColumns = `id, user_id, name, type, currency, opening_balance, is_default, archived, created_at`
+
+func (r *accountRepo) Create(ctx context.Context, a *model.Account) error {
+	r.logger.Info("creating account", zap.String("user_id", a.UserID), zap.String("name", a.Name), zap.String("type", string(a.Type)))
+
+	query := `
+		INSERT INTO accounts (id, user_id, name, type, currency, opening_balance, is_default, archived, created_at)
+		VALUES (:id, :user_id, :name, :type, :currency, :opening_balance, :is_default, :archived, :created_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, a)
+	if err != nil {
+		r.logger.Error("failed to create account", zap.Error(err))
+	} else {
+		r.logger.Info("successfully created account", zap.String("id", a.ID))
+	}
+	return err
+}
+
+func (r *accountRepo) GetByID(ctx context.Context, id string) (*model.Account, error) {
+	r.logger.Info("getting account", zap.String("account_id", id))
+
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE id = $1`
+	var a model.Account
+	err := r.db.GetContext(ctx, &a, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAccountNotFound
+		}
+		r.logger.Error("failed to get account", zap.Error(err))
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Возвращает основной счёт пользователя в валюте, создавая его при первой транзакции.
+// Одновременное создание разрешается уникальным индексом ux_accounts_default
+func (r *accountRepo) EnsureDefault(ctx context.Context, userID, currency string, now time.Time) (*model.Account, error) {
+	query := `SELECT ` + accountColumns + ` FROM accounts WHERE user_id = $1 AND currency = $2 AND is_default`
+	var a model.Account
+	err := r.db.GetContext(ctx, &a, query, userID, currency)
+	if err == nil {
+		return &a, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		r.logger.Error("failed to get default account", zap.Error(err))
+		return nil, err
+	}
+
+	r.logger.Info("creating default account", zap.String("user_id", userID), zap.String("currency", currency))
+	insert := `
+		INSERT INTO accounts (id, user_id, name, type, currency, is_default, created_at)
+		VALUES ($1, $2, $3, $4, $5, TRUE, $6)
+		ON CONFLICT (user_id, currency) WHERE is_default DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, insert, uuid.New().String(), userID, model.DefaultAccountName, model.AccountCash, currency, now); err != nil {
+		r.logger.Error("failed to create default account", zap.Error(err))
+		return nil, err
+	}
+	if err := r.db.GetContext(ctx, &a, query, userID, currency); err != nil {
+		r.logger.Error("failed to get default account", zap.Error(err))
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Счета пользователя с текущими остатками. Перевод уменьшает остаток счёта
+// списания на amount и увеличивает остаток счёта зачисления на transfer_amount
+func (r *accountRepo) ListBalances(ctx context.Context, userID string, includeArchived bool) ([]*model.AccountBalance, error) {
+	r.logger.Info("listing account balances", zap.String("user_id", userID), zap.Bool("include_archived", includeArchived))
+
+	query := `
+		SELECT
+			a.id, a.user_id, a.name, a.type, a.currency, a.opening_balance, a.is_default, a.archived, a.created_at,
+			a.opening_balance + COALESCE(SUM(CASE
+				WHEN t.type = 'INCOME' THEN t.amount
+				WHEN t.type = 'EXPENSE' THEN -t.amount
+				WHEN t.account_id = a.id THEN -t.amount
+				ELSE t.transfer_amount
+			END), 0) AS balance
+		FROM accounts a
+		LEFT JOIN transactions t ON t.account_id = a.id OR t.transfer_account_id = a.id
+		WHERE a.user_id = $1 AND ($2 OR NOT a.archived)
+		GROUP BY a.id
+		ORDER BY a.is_default DESC, a.created_at, a.name
+	`
+	var accounts []*model.AccountBalance
+	if err := r.db.SelectContext(ctx, &accounts, query, userID, includeArchived); err != nil {
+		r.logger.Error("failed to list account balances", zap.Error(err))
+		return nil, err
+	}
+	r.logger.Info("found accounts", zap.Int("count", len(accounts)))
+	return accounts, nil
+}
+
+// Архивирует счёт пользователя. Основной счёт не архивируется
+func (r *accountRepo) Archive(ctx context.Context, id, userID string) error {
+	r.logger.Info("archiving account", zap.String("account_id", id), zap.String("user_id", userID))
+
+	query := `UPDATE accounts SET archived = TRUE WHERE id = $1 AND user_id = $2 AND NOT is_default`
+	res, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		r.logger.Error("failed to archive account", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no account archived (not found)", zap.String("account_id", id), zap.String("user_id", userID))
+		return ErrAccountNotFound
+	}
+	return nil
+}
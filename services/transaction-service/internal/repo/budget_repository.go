@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Если бюджет не найден или принадлежит другому пользователю
+var ErrBudgetNotFound = errors.New("budget not found")
+
+// Интерфейс для работы с бюджетами
+type BudgetRepository interface {
+	Upsert(ctx context.Context, b *model.Budget) error
+	ListByUserID(ctx context.Context, userID string) ([]*model.Budget, error)
+	Delete(ctx context.Context, id, userID string) error
+	SpentByCategory(ctx context.Context, userID string, from, to time.Time) ([]model.CategorySpending, error)
+}
+
+// Реализация BudgetRepository
+type budgetRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр budgetRepo
+func NewBudgetRepo(db *sqlx.DB, logger *zap.Logger) BudgetRepository {
+	return &budgetRepo{db: db, logger: logger}
+}
+
+const budgetColumns = `id, user_id, category_id, amount, currency, created_at, updated_at`
+
+// Создаёт бюджет или заменяет лимит у существующего бюджета категории.
+// В b возвращаются сохранённые id и created_at
+func (r *budgetRepo) Upsert(ctx context.Context, b *model.Budget) error {
+	r.logger.Info("setting budget", zap.String("user_id", b.UserID), zap.String("category_id", b.CategoryID), zap.Stringer("limit", b.Limit))
+
+	query := `
+		INSERT INTO budgets (id, user_id, category_id, amount, currency, created_at, updated_at)
+		VALUES (:id, :user_id, :category_id, :amount, :currency, :created_at, :updated_at)
+		ON CONFLICT (user_id, category_id)
+		DO UPDATE SET amount = EXCLUDED.amount, currency = EXCLUDED.currency, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+	rows, err := r.db.NamedQueryContext(ctx, query, b)
+	if err != nil {
+		r.logger.Error("failed to set budget", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		if err := rows.Scan(&b.ID, &b.CreatedAt); err != nil {
+			r.logger.Error("failed to read budget", zap.Error(err))
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *budgetRepo) ListByUserID(ctx context.Context, userID string) ([]*model.Budget, error) {
+	r.logger.Info("listing budgets", zap.String("user_id", userID))
+
+	query := `SELECT ` + budgetColumns + ` FROM budgets WHERE user_id = $1 ORDER BY created_at`
+	var budgets []*model.Budget
+	if err := r.db.SelectContext(ctx, &budgets, query, userID); err != nil {
+		r.logger.Error("failed to list budgets", zap.Error(err))
+		return nil, err
+	}
+	return budgets, nil
+}
+
+func (r *budgetRepo) Delete(ctx context.Context, id, userID string) error {
+	r.logger.Info("deleting budget", zap.String("budget_id", id), zap.String("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM budgets WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		r.logger.Error("failed to delete budget", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no budget deleted (not found)", zap.String("budget_id", id), zap.String("user_id", userID))
+		return ErrBudgetNotFound
+	}
+	return nil
+}
+
+// Личные расходы пользователя по категориям и валютам за период [from, to)
+func (r *budgetRepo) SpentByCategory(ctx context.Context, userID string, from, to time.Time) ([]model.CategorySpending, error) {
+	r.logger.Info("getting spending by category", zap.String("user_id", userID), zap.Time("from", from), zap.Time("to", to))
+
+	query := `
+		SELECT category_id, currency, COALESCE(SUM(amount), 0) AS amount
+		FROM transactions
+		WHERE user_id = $1 AND type = 'EXPENSE' AND date >= $2 AND date < $3 AND household_id IS NULL
+		GROUP BY category_id, currency
+	`
+	var spending []model.CategorySpending
+	if err := r.db.SelectContext(ctx, &spending, query, userID, from, to); err != nil {
+		r.logger.Error("failed to get spending", zap.Error(err))
+		return nil, err
+	}
+	return spending, nil
+}
@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Если категория не найдена или недоступна для изменения
+var ErrCategoryNotFound = errors.New("category not found")
+
+// Интерфейс для работы с категориями
+type CategoryRepository interface {
+	Create(ctx context.Context, c *model.Category) error
+	GetByID(ctx context.Context, id string) (*model.Category, error)
+	ListByUserID(ctx context.Context, userID string, txType model.TransactionType, includeArchived bool) ([]*model.Category, error)
+	Rename(ctx context.Context, id, userID, name string) error
+	Archive(ctx context.Context, id, userID string) error
+}
+
+// Реализация CategoryRepository
+type categoryRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр categoryRepo
+func NewCategoryRepo(db *sqlx.DB, logger *zap.Logger) CategoryRepository {
+	return &categoryRepo{db: db, logger: logger}
+}
+
+const categoryColumns = `id, COALESCE(user_id::text, '') AS user_id, name, COALESCE(description, '') AS description, type, archived, created_at`
+
+func (r *categoryRepo) Create(ctx context.Context, c *model.Category) error {
+	r.logger.Info("creating category", zap.String("user_id", c.UserID), zap.String("name", c.Name), zap.String("type", string(c.Type)))
+
+	query := `
+		INSERT INTO categories (id, user_id, name, description, type, archived, created_at)
+		VALUES (:id, CAST(NULLIF(:user_id, '') AS uuid), :name, :description, :type, :archived, :created_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, c)
+	if err != nil {
+		r.logger.Error("failed to create category", zap.Error(err))
+	} else {
+		r.logger.Info("successfully created category", zap.String("id", c.ID))
+	}
+	return err
+}
+
+func (r *categoryRepo) GetByID(ctx context.Context, id string) (*model.Category, error) {
+	r.logger.Info("getting category", zap.String("category_id", id))
+
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE id = $1`
+	var c model.Category
+	err := r.db.GetContext(ctx, &c, query, id)
+	if err != nil {
+		// ID не в формате UUID (например, название категории из выписки банка) — такой категории нет
+		var pqErr *pq.Error
+		if errors.Is(err, sql.ErrNoRows) || errors.As(err, &pqErr) && pqErr.Code == invalidTextRepresentation {
+			return nil, ErrCategoryNotFound
+		}
+		r.logger.Error("failed to get category", zap.Error(err))
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Возвращает категории пользователя вместе с системными
+func (r *categoryRepo) ListByUserID(ctx context.Context, userID string, txType model.TransactionType, includeArchived bool) ([]*model.Category, error) {
+	r.logger.Info("listing categories", zap.String("user_id", userID), zap.String("type", string(txType)), zap.Bool("include_archived", includeArchived))
+
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE (user_id = $1 OR user_id IS NULL)
+		  AND ($2 = '' OR type = $2)
+		  AND ($3 OR NOT archived)
+		ORDER BY user_id NULLS FIRST, name
+	`
+	var categories []*model.Category
+	err := r.db.SelectContext(ctx, &categories, query, userID, string(txType), includeArchived)
+	if err != nil {
+		r.logger.Error("failed to list categories", zap.Error(err))
+		return nil, err
+	}
+	r.logger.Info("found categories", zap.Int("count", len(categories)))
+	return categories, nil
+}
+
+// Переименовывает категорию пользователя. Системные категории не изменяются
+func (r *categoryRepo) Rename(ctx context.Context, id, userID, name string) error {
+	r.logger.Info("renaming category", zap.String("category_id", id), zap.String("user_id", userID))
+
+	query := `UPDATE categories SET name = $1 WHERE id = $2 AND user_id = $3`
+	res, err := r.db.ExecContext(ctx, query, name, id, userID)
+	if err != nil {
+		r.logger.Error("failed to rename category", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no category renamed (not found)", zap.String("category_id", id), zap.String("user_id", userID))
+		return ErrCategoryNotFound
+	}
+	return nil
+}
+
+// Архивирует категорию пользователя. Транзакции с ней остаются без изменений
+func (r *categoryRepo) Archive(ctx context.Context, id, userID string) error {
+	r.logger.Info("archiving category", zap.String("category_id", id), zap.String("user_id", userID))
+
+	query := `UPDATE categories SET archived = TRUE WHERE id = $1 AND user_id = $2`
+	res, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		r.logger.Error("failed to archive category", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no category archived (not found)", zap.String("category_id", id), zap.String("user_id", userID))
+		return ErrCategoryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Если цель не найдена или принадлежит другому пользователю
+var ErrGoalNotFound = errors.New("goal not found")
+
+// Интерфейс для работы с целями накоплений
+type GoalRepository interface {
+	Create(ctx context.Context, g *model.Goal) error
+	GetByID(ctx context.Context, id, userID string) (*model.Goal, error)
+	ListByUserID(ctx context.Context, userID string) ([]*model.Goal, error)
+	Update(ctx context.Context, g *model.Goal) error
+	Delete(ctx context.Context, id, userID string) error
+	Contributions(ctx context.Context, goalIDs []string) ([]model.GoalContribution, error)
+	LinkTransaction(ctx context.Context, transactionID, userID, goalID string) error
+	UnlinkTransaction(ctx context.Context, transactionID, userID, goalID string) error
+}
+
+// Реализация GoalRepository
+type goalRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр goalRepo
+func NewGoalRepo(db *sqlx.DB, logger *zap.Logger) GoalRepository {
+	return &goalRepo{db: db, logger: logger}
+}
+
+const goalColumns = `id, user_id, name, target_amount, currency, deadline, created_at, updated_at`
+
+func (r *goalRepo) Create(ctx context.Context, g *model.Goal) error {
+	r.logger.Info("creating goal", zap.String("user_id", g.UserID), zap.String("name", g.Name), zap.Stringer("target", g.TargetAmount))
+
+	query := `
+		INSERT INTO goals (` + goalColumns + `)
+		VALUES (:id, :user_id, :name, :target_amount, :currency, :deadline, :created_at, :updated_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, g)
+	if err != nil {
+		r.logger.Error("failed to create goal", zap.Error(err))
+	}
+	return err
+}
+
+func (r *goalRepo) GetByID(ctx context.Context, id, userID string) (*model.Goal, error) {
+	query := `SELECT ` + goalColumns + ` FROM goals WHERE id = $1 AND user_id = $2`
+	var g model.Goal
+	if err := r.db.GetContext(ctx, &g, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGoalNotFound
+		}
+		r.logger.Error("failed to get goal", zap.Error(err))
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Цели пользователя: сначала со сроком (ближайшие первыми), затем без срока
+func (r *goalRepo) ListByUserID(ctx context.Context, userID string) ([]*model.Goal, error) {
+	r.logger.Info("listing goals", zap.String("user_id", userID))
+
+	query := `SELECT ` + goalColumns + ` FROM goals WHERE user_id = $1 ORDER BY deadline NULLS LAST, created_at`
+	var goals []*model.Goal
+	if err := r.db.SelectContext(ctx, &goals, query, userID); err != nil {
+		r.logger.Error("failed to list goals", zap.Error(err))
+		return nil, err
+	}
+	return goals, nil
+}
+
+func (r *goalRepo) Update(ctx context.Context, g *model.Goal) error {
+	r.logger.Info("updating goal", zap.String("goal_id", g.ID), zap.String("user_id", g.UserID))
+
+	query := `
+		UPDATE goals
+		SET name = :name, target_amount = :target_amount, currency = :currency, deadline = :deadline, updated_at = :updated_at
+		WHERE id = :id AND user_id = :user_id
+	`
+	res, err := r.db.NamedExecContext(ctx, query, g)
+	if err != nil {
+		r.logger.Error("failed to update goal", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrGoalNotFound
+	}
+	return nil
+}
+
+// Удаляет цель; у привязанных транзакций goal_id обнуляется внешним ключом
+func (r *goalRepo) Delete(ctx context.Context, id, userID string) error {
+	r.logger.Info("deleting goal", zap.String("goal_id", id), zap.String("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM goals WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		r.logger.Error("failed to delete goal", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrGoalNotFound
+	}
+	return nil
+}
+
+// Суммы взносов по целям и валютам. Перевод засчитывается суммой зачисления
+// в валюте счёта зачисления, доход и расход — своей суммой
+func (r *goalRepo) Contributions(ctx context.Context, goalIDs []string) ([]model.GoalContribution, error) {
+	query := `
+		SELECT
+			t.goal_id,
+			CASE WHEN t.type = 'TRANSFER' THEN a.currency ELSE t.currency END AS currency,
+			SUM(CASE WHEN t.type = 'TRANSFER' THEN t.transfer_amount ELSE t.amount END) AS amount
+		FROM transactions t
+		LEFT JOIN accounts a ON a.id = t.transfer_account_id
+		WHERE t.goal_id = ANY($1)
+		GROUP BY 1, 2
+	`
+	var contributions []model.GoalContribution
+	if err := r.db.SelectContext(ctx, &contributions, query, pq.Array(goalIDs)); err != nil {
+		r.logger.Error("failed to get goal contributions", zap.Error(err))
+		return nil, err
+	}
+	return contributions, nil
+}
+
+// Засчитывает транзакцию пользователя взносом в цель (взнос в другую цель переносится)
+func (r *goalRepo) LinkTransaction(ctx context.Context, transactionID, userID, goalID string) error {
+	r.logger.Info("linking transaction to goal", zap.String("transaction_id", transactionID), zap.String("goal_id", goalID))
+
+	query := `UPDATE transactions SET goal_id = $3 WHERE id = $1 AND user_id = $2`
+	res, err := r.db.ExecContext(ctx, query, transactionID, userID, goalID)
+	if err != nil {
+		r.logger.Error("failed to link transaction to goal", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Отвязывает транзакцию от цели; транзакция остаётся
+func (r *goalRepo) UnlinkTransaction(ctx context.Context, transactionID, userID, goalID string) error {
+	r.logger.Info("unlinking transaction from goal", zap.String("transaction_id", transactionID), zap.String("goal_id", goalID))
+
+	query := `UPDATE transactions SET goal_id = NULL WHERE id = $1 AND user_id = $2 AND goal_id = $3`
+	res, err := r.db.ExecContext(ctx, query, transactionID, userID, goalID)
+	if err != nil {
+		r.logger.Error("failed to unlink transaction from goal", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
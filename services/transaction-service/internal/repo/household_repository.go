@@ -0,0 +1,222 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"go.uber.org/zap"
+)
+
+var (
+	// Пользователь не состоит в общем бюджете (или бюджета нет)
+	ErrMemberNotFound = errors.New("household member not found")
+	// Приглашение не найдено, уже принято или истекло
+	ErrInvitationNotFound = errors.New("household invitation not found")
+	// Пользователь уже состоит в общем бюджете
+	ErrAlreadyMember = errors.New("user is already a household member")
+)
+
+// Интерфейс для работы с общими бюджетами, участниками и приглашениями
+type HouseholdRepository interface {
+	Create(ctx context.Context, h *model.Household) error
+	ListByUserID(ctx context.Context, userID string) ([]*model.Household, error)
+	GetMember(ctx context.Context, householdID, userID string) (*model.HouseholdMember, error)
+	ListMembers(ctx context.Context, householdID string) ([]*model.HouseholdMember, error)
+	UpdateMemberRole(ctx context.Context, householdID, userID string, role model.HouseholdRole) error
+	RemoveMember(ctx context.Context, householdID, userID string) error
+	CreateInvitation(ctx context.Context, inv *model.HouseholdInvitation) error
+	AcceptInvitation(ctx context.Context, codeHash, userID string, now time.Time) (*model.HouseholdMember, error)
+}
+
+// Реализация HouseholdRepository
+type householdRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр householdRepo
+func NewHouseholdRepo(db *sqlx.DB, logger *zap.Logger) HouseholdRepository {
+	return &householdRepo{db: db, logger: logger}
+}
+
+const householdMemberColumns = `household_id, user_id, role, joined_at`
+
+// Создаёт бюджет и записывает создателя владельцем в одной транзакции БД
+func (r *householdRepo) Create(ctx context.Context, h *model.Household) error {
+	r.logger.Info("creating household", zap.String("owner_id", h.OwnerID))
+
+	dbTx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer dbTx.Rollback()
+
+	if _, err := dbTx.ExecContext(ctx,
+		`INSERT INTO households (id, name, owner_id, created_at) VALUES ($1, $2, $3, $4)`,
+		h.ID, h.Name, h.OwnerID, h.CreatedAt); err != nil {
+		r.logger.Error("failed to create household", zap.Error(err))
+		return err
+	}
+	if _, err := dbTx.ExecContext(ctx,
+		`INSERT INTO household_members (`+householdMemberColumns+`) VALUES ($1, $2, $3, $4)`,
+		h.ID, h.OwnerID, model.HouseholdOwner, h.CreatedAt); err != nil {
+		r.logger.Error("failed to add household owner", zap.Error(err))
+		return err
+	}
+	if err := dbTx.Commit(); err != nil {
+		r.logger.Error("failed to commit household", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Бюджеты, в которых участвует пользователь, с его ролью
+func (r *householdRepo) ListByUserID(ctx context.Context, userID string) ([]*model.Household, error) {
+	r.logger.Info("listing households", zap.String("user_id", userID))
+
+	query := `
+		SELECT h.id, h.name, h.owner_id, m.role, h.created_at
+		FROM households h
+		JOIN household_members m ON m.household_id = h.id
+		WHERE m.user_id = $1
+		ORDER BY h.created_at
+	`
+	var households []*model.Household
+	if err := r.db.SelectContext(ctx, &households, query, userID); err != nil {
+		r.logger.Error("failed to list households", zap.Error(err))
+		return nil, err
+	}
+	return households, nil
+}
+
+func (r *householdRepo) GetMember(ctx context.Context, householdID, userID string) (*model.HouseholdMember, error) {
+	query := `SELECT ` + householdMemberColumns + ` FROM household_members WHERE household_id = $1 AND user_id = $2`
+	var m model.HouseholdMember
+	if err := r.db.GetContext(ctx, &m, query, householdID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMemberNotFound
+		}
+		r.logger.Error("failed to get household member", zap.Error(err))
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *householdRepo) ListMembers(ctx context.Context, householdID string) ([]*model.HouseholdMember, error) {
+	r.logger.Info("listing household members", zap.String("household_id", householdID))
+
+	query := `SELECT ` + householdMemberColumns + ` FROM household_members WHERE household_id = $1 ORDER BY joined_at`
+	var members []*model.HouseholdMember
+	if err := r.db.SelectContext(ctx, &members, query, householdID); err != nil {
+		r.logger.Error("failed to list household members", zap.Error(err))
+		return nil, err
+	}
+	return members, nil
+}
+
+// Меняет роль участника. Роль владельца не меняется
+func (r *householdRepo) UpdateMemberRole(ctx context.Context, householdID, userID string, role model.HouseholdRole) error {
+	r.logger.Info("updating household member role", zap.String("household_id", householdID), zap.String("user_id", userID), zap.String("role", string(role)))
+
+	res, err := r.db.ExecContext(ctx,
+		`UPDATE household_members SET role = $1 WHERE household_id = $2 AND user_id = $3 AND role <> 'owner'`,
+		role, householdID, userID)
+	if err != nil {
+		r.logger.Error("failed to update household member role", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrMemberNotFound
+	}
+	return nil
+}
+
+// Удаляет участника. Владельца удалить нельзя
+func (r *householdRepo) RemoveMember(ctx context.Context, householdID, userID string) error {
+	r.logger.Info("removing household member", zap.String("household_id", householdID), zap.String("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx,
+		`DELETE FROM household_members WHERE household_id = $1 AND user_id = $2 AND role <> 'owner'`,
+		householdID, userID)
+	if err != nil {
+		r.logger.Error("failed to remove household member", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrMemberNotFound
+	}
+	return nil
+}
+
+func (r *householdRepo) CreateInvitation(ctx context.Context, inv *model.HouseholdInvitation) error {
+	r.logger.Info("creating household invitation", zap.String("household_id", inv.HouseholdID), zap.String("role", string(inv.Role)))
+
+	query := `
+		INSERT INTO household_invitations (id, household_id, invited_by, role, code_hash, expires_at, created_at)
+		VALUES (:id, :household_id, :invited_by, :role, :code_hash, :expires_at, :created_at)
+	`
+	if _, err := r.db.NamedExecContext(ctx, query, inv); err != nil {
+		r.logger.Error("failed to create household invitation", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Принимает приглашение: добавляет участника и гасит приглашение в одной транзакции БД.
+// Строка приглашения блокируется, поэтому один код нельзя принять дважды
+func (r *householdRepo) AcceptInvitation(ctx context.Context, codeHash, userID string, now time.Time) (*model.HouseholdMember, error) {
+	r.logger.Info("accepting household invitation", zap.String("user_id", userID))
+
+	dbTx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, err
+	}
+	defer dbTx.Rollback()
+
+	var inv model.HouseholdInvitation
+	err = dbTx.GetContext(ctx, &inv, `
+		SELECT id, household_id, invited_by, role, code_hash, expires_at, created_at
+		FROM household_invitations
+		WHERE code_hash = $1 AND accepted_at IS NULL AND expires_at > $2
+		FOR UPDATE
+	`, codeHash, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+		r.logger.Error("failed to get household invitation", zap.Error(err))
+		return nil, err
+	}
+
+	member := &model.HouseholdMember{HouseholdID: inv.HouseholdID, UserID: userID, Role: inv.Role, JoinedAt: now}
+	res, err := dbTx.ExecContext(ctx,
+		`INSERT INTO household_members (`+householdMemberColumns+`) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+		member.HouseholdID, member.UserID, member.Role, member.JoinedAt)
+	if err != nil {
+		r.logger.Error("failed to add household member", zap.Error(err))
+		return nil, err
+	}
+	if rows, _ := res.RowsAffected(); rows == 0 {
+		return nil, ErrAlreadyMember
+	}
+
+	if _, err := dbTx.ExecContext(ctx,
+		`UPDATE household_invitations SET accepted_by = $1, accepted_at = $2 WHERE id = $3`,
+		userID, now, inv.ID); err != nil {
+		r.logger.Error("failed to mark invitation accepted", zap.Error(err))
+		return nil, err
+	}
+	if err := dbTx.Commit(); err != nil {
+		r.logger.Error("failed to commit invitation", zap.Error(err))
+		return nil, err
+	}
+	return member, nil
+}
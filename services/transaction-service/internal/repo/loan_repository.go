@@ -0,0 +1,145 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Если займ не найден или принадлежит другому пользователю
+var ErrLoanNotFound = errors.New("loan not found")
+
+// Интерфейс для работы с займами
+type LoanRepository interface {
+	Create(ctx context.Context, l *model.Loan) error
+	GetByID(ctx context.Context, id, userID string) (*model.Loan, error)
+	ListByUserID(ctx context.Context, userID string) ([]*model.Loan, error)
+	Delete(ctx context.Context, id, userID string) error
+	Repayments(ctx context.Context, loanIDs []string) ([]model.LoanRepayment, error)
+	LinkTransaction(ctx context.Context, transactionID, userID, loanID string) error
+	UnlinkTransaction(ctx context.Context, transactionID, userID, loanID string) error
+}
+
+// Реализация LoanRepository
+type loanRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр loanRepo
+func NewLoanRepo(db *sqlx.DB, logger *zap.Logger) LoanRepository {
+	return &loanRepo{db: db, logger: logger}
+}
+
+const loanColumns = `id, user_id, counterparty, direction, schedule, principal, currency, annual_rate, term_months, start_date, created_at`
+
+func (r *loanRepo) Create(ctx context.Context, l *model.Loan) error {
+	r.logger.Info("creating loan", zap.String("user_id", l.UserID), zap.String("direction", string(l.Direction)), zap.Stringer("principal", l.Principal))
+
+	query := `
+		INSERT INTO loans (` + loanColumns + `)
+		VALUES (:id, :user_id, :counterparty, :direction, :schedule, :principal, :currency, :annual_rate, :term_months, :start_date, :created_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, l)
+	if err != nil {
+		r.logger.Error("failed to create loan", zap.Error(err))
+	}
+	return err
+}
+
+func (r *loanRepo) GetByID(ctx context.Context, id, userID string) (*model.Loan, error) {
+	query := `SELECT ` + loanColumns + ` FROM loans WHERE id = $1 AND user_id = $2`
+	var l model.Loan
+	if err := r.db.GetContext(ctx, &l, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLoanNotFound
+		}
+		r.logger.Error("failed to get loan", zap.Error(err))
+		return nil, err
+	}
+	return &l, nil
+}
+
+// Займы пользователя, новые первыми
+func (r *loanRepo) ListByUserID(ctx context.Context, userID string) ([]*model.Loan, error) {
+	r.logger.Info("listing loans", zap.String("user_id", userID))
+
+	query := `SELECT ` + loanColumns + ` FROM loans WHERE user_id = $1 ORDER BY start_date DESC, created_at DESC`
+	var loans []*model.Loan
+	if err := r.db.SelectContext(ctx, &loans, query, userID); err != nil {
+		r.logger.Error("failed to list loans", zap.Error(err))
+		return nil, err
+	}
+	return loans, nil
+}
+
+// Удаляет займ; у привязанных погашений loan_id обнуляется внешним ключом
+func (r *loanRepo) Delete(ctx context.Context, id, userID string) error {
+	r.logger.Info("deleting loan", zap.String("loan_id", id), zap.String("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM loans WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		r.logger.Error("failed to delete loan", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrLoanNotFound
+	}
+	return nil
+}
+
+// Суммы погашений по займам и валютам
+func (r *loanRepo) Repayments(ctx context.Context, loanIDs []string) ([]model.LoanRepayment, error) {
+	query := `
+		SELECT loan_id, currency, SUM(amount) AS amount
+		FROM transactions
+		WHERE loan_id = ANY($1)
+		GROUP BY 1, 2
+	`
+	var repayments []model.LoanRepayment
+	if err := r.db.SelectContext(ctx, &repayments, query, pq.Array(loanIDs)); err != nil {
+		r.logger.Error("failed to get loan repayments", zap.Error(err))
+		return nil, err
+	}
+	return repayments, nil
+}
+
+// Засчитывает транзакцию пользователя погашением займа
+func (r *loanRepo) LinkTransaction(ctx context.Context, transactionID, userID, loanID string) error {
+	r.logger.Info("linking transaction to loan", zap.String("transaction_id", transactionID), zap.String("loan_id", loanID))
+
+	query := `UPDATE transactions SET loan_id = $3 WHERE id = $1 AND user_id = $2`
+	res, err := r.db.ExecContext(ctx, query, transactionID, userID, loanID)
+	if err != nil {
+		r.logger.Error("failed to link transaction to loan", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Отвязывает погашение от займа; транзакция остаётся
+func (r *loanRepo) UnlinkTransaction(ctx context.Context, transactionID, userID, loanID string) error {
+	r.logger.Info("unlinking transaction from loan", zap.String("transaction_id", transactionID), zap.String("loan_id", loanID))
+
+	query := `UPDATE transactions SET loan_id = NULL WHERE id = $1 AND user_id = $2 AND loan_id = $3`
+	res, err := r.db.ExecContext(ctx, query, transactionID, userID, loanID)
+	if err != nil {
+		r.logger.Error("failed to unlink transaction from loan", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Если шаблон не найден, принадлежит другому пользователю или был изменён параллельно
+var ErrRecurringNotFound = errors.New("recurring transaction not found")
+
+// Интерфейс для работы с шаблонами повторяющихся транзакций
+type RecurringRepository interface {
+	Create(ctx context.Context, r *model.RecurringTransaction) error
+	GetByID(ctx context.Context, id, userID string) (*model.RecurringTransaction, error)
+	ListByUserID(ctx context.Context, userID string) ([]*model.RecurringTransaction, error)
+	ListDue(ctx context.Context, date time.Time) ([]*model.RecurringTransaction, error)
+	UpdateState(ctx context.Context, r *model.RecurringTransaction, prevOccurrences int) error
+	Delete(ctx context.Context, id, userID string) error
+}
+
+// Реализация RecurringRepository
+type recurringRepo struct {
+	db     *sqlx.DB
+	logger *zap.Logger
+}
+
+// Создает новый экземпляр recurringRepo
+func NewRecurringRepo(db *sqlx.DB, logger *zap.Logger) RecurringRepository {
+	return &recurringRepo{db: db, logger: logger}
+}
+
+const recurringColumns = `id, user_id, category_id, type, amount, currency, description, frequency, repeat_interval,
+	start_date, end_date, max_count, occurrences, next_date, paused, created_at, updated_at`
+
+func (r *recurringRepo) Create(ctx context.Context, rt *model.RecurringTransaction) error {
+	r.logger.Info("creating recurring transaction", zap.String("user_id", rt.UserID), zap.String("frequency", string(rt.Frequency)))
+
+	query := `
+		INSERT INTO recurring_transactions (` + recurringColumns + `)
+		VALUES (:id, :user_id, :category_id, :type, :amount, :currency, :description, :frequency, :repeat_interval,
+			:start_date, :end_date, :max_count, :occurrences, :next_date, :paused, :created_at, :updated_at)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, rt)
+	if err != nil {
+		r.logger.Error("failed to create recurring transaction", zap.Error(err))
+	}
+	return err
+}
+
+func (r *recurringRepo) GetByID(ctx context.Context, id, userID string) (*model.RecurringTransaction, error) {
+	query := `SELECT ` + recurringColumns + ` FROM recurring_transactions WHERE id = $1 AND user_id = $2`
+	var rt model.RecurringTransaction
+	if err := r.db.GetContext(ctx, &rt, query, id, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRecurringNotFound
+		}
+		r.logger.Error("failed to get recurring transaction", zap.Error(err))
+		return nil, err
+	}
+	return &rt, nil
+}
+
+func (r *recurringRepo) ListByUserID(ctx context.Context, userID string) ([]*model.RecurringTransaction, error) {
+	r.logger.Info("listing recurring transactions", zap.String("user_id", userID))
+
+	query := `SELECT ` + recurringColumns + ` FROM recurring_transactions WHERE user_id = $1 ORDER BY next_date, created_at`
+	var list []*model.RecurringTransaction
+	if err := r.db.SelectContext(ctx, &list, query, userID); err != nil {
+		r.logger.Error("failed to list recurring transactions", zap.Error(err))
+		return nil, err
+	}
+	return list, nil
+}
+
+// Активные шаблоны, у которых следующее повторение наступило к date
+func (r *recurringRepo) ListDue(ctx context.Context, date time.Time) ([]*model.RecurringTransaction, error) {
+	query := `
+		SELECT ` + recurringColumns + `
+		FROM recurring_transactions
+		WHERE NOT paused
+		  AND next_date <= $1
+		  AND (max_count IS NULL OR occurrences < max_count)
+		  AND (end_date IS NULL OR next_date <= end_date)
+		ORDER BY next_date
+	`
+	var list []*model.RecurringTransaction
+	if err := r.db.SelectContext(ctx, &list, query, date); err != nil {
+		r.logger.Error("failed to list due recurring transactions", zap.Error(err))
+		return nil, err
+	}
+	return list, nil
+}
+
+// Сохраняет счётчик, дату следующего повторения и паузу.
+// Обновление проходит, только если счётчик не менялся с момента чтения (prevOccurrences)
+func (r *recurringRepo) UpdateState(ctx context.Context, rt *model.RecurringTransaction, prevOccurrences int) error {
+	query := `
+		UPDATE recurring_transactions
+		SET occurrences = $1, next_date = $2, paused = $3, updated_at = $4
+		WHERE id = $5 AND user_id = $6 AND occurrences = $7
+	`
+	res, err := r.db.ExecContext(ctx, query, rt.Occurrences, rt.NextDate, rt.Paused, rt.UpdatedAt, rt.ID, rt.UserID, prevOccurrences)
+	if err != nil {
+		r.logger.Error("failed to update recurring transaction", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no recurring transaction updated", zap.String("recurring_id", rt.ID))
+		return ErrRecurringNotFound
+	}
+	return nil
+}
+
+func (r *recurringRepo) Delete(ctx context.Context, id, userID string) error {
+	r.logger.Info("deleting recurring transaction", zap.String("recurring_id", id), zap.String("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM recurring_transactions WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		r.logger.Error("failed to delete recurring transaction", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return ErrRecurringNotFound
+	}
+	return nil
+}
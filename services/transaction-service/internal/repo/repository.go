@@ -4,22 +4,46 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
 // Если не найдено ни одной строки
 var ErrNotFound = errors.New("transaction not found")
 
+// Транзакция для этого повторения шаблона уже создана
+var ErrAlreadyExists = errors.New("transaction already exists")
+
 // Интерфейс для работы с транзакциями
 type TransactionRepository interface {
 	Create(ctx context.Context, tx *model.Transaction) error
-	ListByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Transaction, error)
+	CreateBatch(ctx context.Context, txs []*model.Transaction) error
+	List(ctx context.Context, filter model.TransactionFilter) ([]*model.Transaction, int64, error)
+	Export(ctx context.Context, filter model.TransactionFilter, fn func(*model.Transaction) error) error
+	GetByID(ctx context.Context, transactionID, userID string) (*model.Transaction, error)
+	Update(ctx context.Context, tx *model.Transaction) error
 	Delete(ctx context.Context, transactionID, userID string) error
-	GetBalance(ctx context.Context, userID string) (income, expense, total float64, err error)
+	GetBalance(ctx context.Context, userID string) ([]model.CurrencyBalance, error)
+	GetBalanceBefore(ctx context.Context, userID string, before time.Time) ([]model.CurrencyBalance, error)
+	GetHouseholdBalance(ctx context.Context, householdID string) ([]model.CurrencyBalance, error)
+	Report(ctx context.Context, filter model.ReportFilter) ([]model.ReportRow, error)
 }
 
+const (
+	// Код ошибки Postgres unique_violation
+	uniqueViolation = "23505"
+	// Код ошибки Postgres invalid_text_representation: например, ID не в формате UUID
+	invalidTextRepresentation = "22P02"
+	// Уникальный индекс (recurring_id, date) из миграции 0007
+	recurringDateIndex = "ux_transactions_recurring_date"
+)
+
 // Реализация TransactionRepository
 type transactionRepo struct {
 	db     *sqlx.DB
@@ -31,15 +55,27 @@ func NewTransactionRepo(db *sqlx.DB, logger *zap.Logger) TransactionRepository {
 	return &transactionRepo{db: db, logger: logger}
 }
 
+// Колонки выборки транзакции; household_id пустой у личных транзакций,
+// category_id и поля перевода — у всех, кроме переводов, goal_id и loan_id — у всех, кроме взносов в цели и погашений займов
+const transactionColumns = `id, user_id, COALESCE(category_id::text, '') AS category_id, type, amount, currency, description, date, created_at, updated_at, ` +
+	`COALESCE(household_id::text, '') AS household_id, account_id, COALESCE(transfer_account_id::text, '') AS transfer_account_id, COALESCE(transfer_amount, 0) AS transfer_amount, ` +
+	`COALESCE(goal_id::text, '') AS goal_id, COALESCE(loan_id::text, '') AS loan_id`
+
+const insertTransactionQuery = `
+	INSERT INTO transactions (id, user_id, category_id, type, amount, currency, description, date, created_at, recurring_id, household_id, account_id, transfer_account_id, transfer_amount, goal_id, loan_id)
+	VALUES (:id, :user_id, CAST(NULLIF(:category_id, '') AS uuid), :type, :amount, :currency, :description, :date, :created_at, CAST(NULLIF(:recurring_id, '') AS uuid), CAST(NULLIF(:household_id, '') AS uuid),
+		:account_id, CAST(NULLIF(:transfer_account_id, '') AS uuid), NULLIF(CAST(:transfer_amount AS numeric), 0), CAST(NULLIF(:goal_id, '') AS uuid), CAST(NULLIF(:loan_id, '') AS uuid))
+`
+
 func (r *transactionRepo) Create(ctx context.Context, tx *model.Transaction) error {
-	r.logger.Info("creating model", zap.String("user_id", tx.UserID), zap.Float64("amount", tx.Amount), zap.String("type", string(tx.Type)))
+	r.logger.Info("creating model", zap.String("user_id", tx.UserID), zap.Stringer("amount", tx.Amount), zap.String("type", string(tx.Type)))
 
-	query := `
-		INSERT INTO transactions (id, user_id, category_id, type, amount, description, date, created_at)
-		VALUES (:id, :user_id, :category_id, :type, :amount, :description, :date, :created_at)
-	`
-	_, err := r.db.NamedExecContext(ctx, query, tx)
+	_, err := r.db.NamedExecContext(ctx, insertTransactionQuery, tx)
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == recurringDateIndex {
+			return ErrAlreadyExists
+		}
 		r.logger.Error("failed to create model", zap.Error(err))
 	} else {
 		r.logger.Info("successfully created transaction", zap.String("id", tx.ID))
@@ -47,33 +83,280 @@ func (r *transactionRepo) Create(ctx context.Context, tx *model.Transaction) err
 	return err
 }
 
-func (r *transactionRepo) ListByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Transaction, error) {
-	r.logger.Info("listing transactions", zap.String("user_id", userID), zap.Int("limit", limit), zap.Int("offset", offset))
+// Сохраняет транзакции в одной транзакции БД: либо все, либо ни одной
+func (r *transactionRepo) CreateBatch(ctx context.Context, txs []*model.Transaction) error {
+	r.logger.Info("creating transactions batch", zap.Int("count", len(txs)))
+
+	dbTx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer dbTx.Rollback()
+
+	stmt, err := dbTx.PrepareNamedContext(ctx, insertTransactionQuery)
+	if err != nil {
+		r.logger.Error("failed to prepare insert", zap.Error(err))
+		return err
+	}
+	defer stmt.Close()
+
+	for _, tx := range txs {
+		if _, err := stmt.ExecContext(ctx, tx); err != nil {
+			r.logger.Error("failed to create model in batch", zap.String("id", tx.ID), zap.Error(err))
+			return err
+		}
+	}
+	if err := dbTx.Commit(); err != nil {
+		r.logger.Error("failed to commit batch", zap.Error(err))
+		return err
+	}
+	r.logger.Info("successfully created transactions batch", zap.Int("count", len(txs)))
+	return nil
+}
+
+// Колонки сортировки; значения подставляются в запрос только из этого списка
+var sortColumns = map[model.SortField]string{
+	model.SortByDate:      "date",
+	model.SortByAmount:    "amount",
+	model.SortByCreatedAt: "created_at",
+}
+
+// Возвращает страницу транзакций по фильтру и общее число подходящих записей
+func (r *transactionRepo) List(ctx context.Context, filter model.TransactionFilter) ([]*model.Transaction, int64, error) {
+	r.logger.Info("listing transactions by filter", zap.String("user_id", filter.UserID), zap.Int("limit", filter.Limit), zap.Int("offset", filter.Offset))
+
+	where, args := transactionFilterWhere(filter)
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM transactions WHERE ` + where
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		r.logger.Error("failed to count transactions", zap.Error(err))
+		return nil, 0, err
+	}
+
+	keys, orderBy := transactionOrder(filter)
+
+	if filter.Cursor != nil {
+		keyArgs := cursorValues(filter.Cursor, filter.SortBy)
+		placeholders := make([]string, len(keyArgs))
+		for i, v := range keyArgs {
+			args = append(args, v)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		op := "<"
+		if filter.Ascending {
+			op = ">"
+		}
+		where += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(keys, ", "), op, strings.Join(placeholders, ", "))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM transactions
+		WHERE %s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, transactionColumns, where, orderBy, len(args)+1, len(args)+2)
+	args = append(args, filter.Limit, filter.Offset)
+
+	var transactions []*model.Transaction
+	if err := r.db.SelectContext(ctx, &transactions, query, args...); err != nil {
+		r.logger.Error("failed to list transactions", zap.Error(err))
+		return nil, 0, err
+	}
+	r.logger.Info("found transactions", zap.Int("count", len(transactions)), zap.Int64("total", total))
+	return transactions, total, nil
+}
+
+// Построчно передаёт в fn все транзакции по фильтру, не загружая их в память.
+// Пагинация фильтра не применяется
+func (r *transactionRepo) Export(ctx context.Context, filter model.TransactionFilter, fn func(*model.Transaction) error) error {
+	r.logger.Info("exporting transactions", zap.String("user_id", filter.UserID))
+
+	where, args := transactionFilterWhere(filter)
+	_, orderBy := transactionOrder(filter)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM transactions
+		WHERE %s
+		ORDER BY %s
+	`, transactionColumns, where, orderBy)
+
+	rows, err := r.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to export transactions", zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var tx model.Transaction
+		if err := rows.StructScan(&tx); err != nil {
+			r.logger.Error("failed to scan transaction", zap.Error(err))
+			return err
+		}
+		if err := fn(&tx); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to export transactions", zap.Error(err))
+		return err
+	}
+	r.logger.Info("exported transactions", zap.Int("count", count))
+	return nil
+}
+
+// Колонки ключа сортировки и готовое выражение ORDER BY.
+// Ключ (поле, created_at, id) задаёт однозначный порядок и годится для курсора
+func transactionOrder(filter model.TransactionFilter) ([]string, string) {
+	column, ok := sortColumns[filter.SortBy]
+	if !ok {
+		column = sortColumns[model.SortByDate]
+	}
+	direction := "DESC"
+	if filter.Ascending {
+		direction = "ASC"
+	}
+
+	keys := []string{column, "created_at", "id"}
+	if column == "created_at" {
+		keys = []string{"created_at", "id"}
+	}
+	orderBy := make([]string, len(keys))
+	for i, k := range keys {
+		orderBy[i] = k + " " + direction
+	}
+	return keys, strings.Join(orderBy, ", ")
+}
+
+// Значения ключа сортировки из курсора в порядке колонок ORDER BY
+func cursorValues(c *model.Cursor, sortBy model.SortField) []interface{} {
+	switch sortBy {
+	case model.SortByAmount:
+		return []interface{}{c.Amount, c.CreatedAt, c.ID}
+	case model.SortByCreatedAt:
+		return []interface{}{c.CreatedAt, c.ID}
+	default:
+		return []interface{}{c.Date, c.CreatedAt, c.ID}
+	}
+}
+
+// Собирает условие WHERE и аргументы для фильтра транзакций.
+// Без HouseholdID выбираются только личные транзакции пользователя
+func transactionFilterWhere(filter model.TransactionFilter) (string, []interface{}) {
+	conds := []string{"user_id = $1"}
+	args := []interface{}{filter.UserID}
+	if filter.HouseholdID != "" {
+		conds = []string{"household_id = $1"}
+		args = []interface{}{filter.HouseholdID}
+	}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.DateFrom != nil {
+		add("date >= $%d", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		add("date <= $%d", *filter.DateTo)
+	}
+	if filter.Type != "" {
+		add("type = $%d", string(filter.Type))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		add("category_id = ANY($%d)", pq.Array(filter.CategoryIDs))
+	}
+	if filter.AmountMin != nil {
+		add("amount >= $%d", *filter.AmountMin)
+	}
+	if filter.AmountMax != nil {
+		add("amount <= $%d", *filter.AmountMax)
+	}
+	if filter.Search != "" {
+		add(`description ILIKE '%%' || $%d || '%%'`, escapeLike(filter.Search))
+	}
+	if filter.AccountID != "" {
+		add("(account_id = $%[1]d OR transfer_account_id = $%[1]d)", filter.AccountID)
+	}
+	if filter.GoalID != "" {
+		add("goal_id = $%d", filter.GoalID)
+	}
+	if filter.LoanID != "" {
+		add("loan_id = $%d", filter.LoanID)
+	}
+	if filter.HouseholdID == "" {
+		conds = append(conds, "household_id IS NULL")
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// Экранирует спецсимволы LIKE, чтобы поиск шёл по подстроке буквально
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (r *transactionRepo) GetByID(ctx context.Context, transactionID, userID string) (*model.Transaction, error) {
+	r.logger.Info("getting transaction", zap.String("transaction_id", transactionID), zap.String("user_id", userID))
 
 	query := `
-		SELECT id, user_id, category_id, type, amount, description, date, created_at
+		SELECT ` + transactionColumns + `
 		FROM transactions
-		WHERE user_id = $1
-		ORDER BY date DESC
-		LIMIT $2 OFFSET $3
+		WHERE id = $1 AND user_id = $2
 	`
-	var transactions []*model.Transaction
-	err := r.db.SelectContext(ctx, &transactions, query, userID, limit, offset)
+	var tx model.Transaction
+	err := r.db.GetContext(ctx, &tx, query, transactionID, userID)
 	if err != nil {
-		if err == sql.ErrNoRows {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNotFound
 		}
-		r.logger.Error("failed to list transactions", zap.Error(err))
-	} else {
-		r.logger.Info("found transactions", zap.Int("count", len(transactions)))
+		r.logger.Error("failed to get transaction", zap.Error(err))
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (r *transactionRepo) Update(ctx context.Context, tx *model.Transaction) error {
+	r.logger.Info("updating model", zap.String("transaction_id", tx.ID), zap.String("user_id", tx.UserID))
+
+	// Запись общего бюджета автор меняет, только пока у него есть право на изменения — как в Delete
+	query := `
+		UPDATE transactions t
+		SET category_id = :category_id, account_id = :account_id, type = :type, amount = :amount, currency = :currency, description = :description, date = :date, updated_at = :updated_at
+		WHERE t.id = :id AND t.user_id = :user_id AND (t.household_id IS NULL OR EXISTS (
+			SELECT 1 FROM household_members m
+			WHERE m.household_id = t.household_id AND m.user_id = t.user_id AND m.role IN ('owner', 'editor')
+		))
+	`
+	res, err := r.db.NamedExecContext(ctx, query, tx)
+	if err != nil {
+		r.logger.Error("failed to update model", zap.Error(err))
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		r.logger.Warn("no transaction updated (not found)", zap.String("transaction_id", tx.ID), zap.String("user_id", tx.UserID))
+		return ErrNotFound
 	}
-	return transactions, err
+	r.logger.Info("transaction updated", zap.String("transaction_id", tx.ID))
+	return nil
 }
 
 func (r *transactionRepo) Delete(ctx context.Context, transactionID, userID string) error {
 	r.logger.Info("deleting model", zap.String("transaction_id", transactionID), zap.String("user_id", userID))
 
-	query := `DELETE FROM transactions WHERE id = $1 AND user_id = $2`
+	// Из общего бюджета автор удаляет запись, только пока у него есть право на изменения
+	query := `
+		DELETE FROM transactions t
+		WHERE t.id = $1 AND t.user_id = $2 AND (t.household_id IS NULL OR EXISTS (
+			SELECT 1 FROM household_members m
+			WHERE m.household_id = t.household_id AND m.user_id = t.user_id AND m.role IN ('owner', 'editor')
+		))
+	`
 	res, err := r.db.ExecContext(ctx, query, transactionID, userID)
 	if err != nil {
 		r.logger.Error("failed to delete model", zap.Error(err))
@@ -89,25 +372,113 @@ func (r *transactionRepo) Delete(ctx context.Context, transactionID, userID stri
 	return err
 }
 
-func (r *transactionRepo) GetBalance(ctx context.Context, userID string) (float64, float64, float64, error) {
+// Итоги доходов и расходов по каждой валюте пользователя; переводы не учитываются
+func (r *transactionRepo) GetBalance(ctx context.Context, userID string) ([]model.CurrencyBalance, error) {
 	r.logger.Info("getting balance", zap.String("user_id", userID))
 
 	query := `
 		SELECT
+			currency,
 			COALESCE(SUM(CASE WHEN type = 'INCOME' THEN amount ELSE 0 END), 0) AS income,
 			COALESCE(SUM(CASE WHEN type = 'EXPENSE' THEN amount ELSE 0 END), 0) AS expense
 		FROM transactions
-		WHERE user_id = $1
+		WHERE user_id = $1 AND household_id IS NULL AND type <> 'TRANSFER'
+		GROUP BY currency
+		ORDER BY currency
 	`
-	var income, expense float64
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(&income, &expense)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return 0, 0, 0, ErrNotFound
-		}
+	var balances []model.CurrencyBalance
+	if err := r.db.SelectContext(ctx, &balances, query, userID); err != nil {
 		r.logger.Error("failed to get balance", zap.Error(err))
-	} else {
-		r.logger.Info("balance calculated", zap.Float64("income", income), zap.Float64("expense", expense), zap.Float64("total", income-expense))
+		return nil, err
+	}
+	r.logger.Info("balance calculated", zap.Int("currencies", len(balances)))
+	return balances, nil
+}
+
+// Итоги доходов и расходов по каждой валюте общего бюджета (все участники)
+func (r *transactionRepo) GetHouseholdBalance(ctx context.Context, householdID string) ([]model.CurrencyBalance, error) {
+	r.logger.Info("getting household balance", zap.String("household_id", householdID))
+
+	query := `
+		SELECT
+			currency,
+			COALESCE(SUM(CASE WHEN type = 'INCOME' THEN amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN type = 'EXPENSE' THEN amount ELSE 0 END), 0) AS expense
+		FROM transactions
+		WHERE household_id = $1
+		GROUP BY currency
+		ORDER BY currency
+	`
+	var balances []model.CurrencyBalance
+	if err := r.db.SelectContext(ctx, &balances, query, householdID); err != nil {
+		r.logger.Error("failed to get household balance", zap.Error(err))
+		return nil, err
+	}
+	return balances, nil
+}
+
+// Итоги по валютам за всё время до указанной даты (не включая её) — остаток на начало отчёта
+func (r *transactionRepo) GetBalanceBefore(ctx context.Context, userID string, before time.Time) ([]model.CurrencyBalance, error) {
+	r.logger.Info("getting balance before date", zap.String("user_id", userID), zap.Time("before", before))
+
+	query := `
+		SELECT
+			currency,
+			COALESCE(SUM(CASE WHEN type = 'INCOME' THEN amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN type = 'EXPENSE' THEN amount ELSE 0 END), 0) AS expense
+		FROM transactions
+		WHERE user_id = $1 AND date < $2 AND household_id IS NULL AND type <> 'TRANSFER'
+		GROUP BY currency
+		ORDER BY currency
+	`
+	var balances []model.CurrencyBalance
+	if err := r.db.SelectContext(ctx, &balances, query, userID, before); err != nil {
+		r.logger.Error("failed to get balance before date", zap.Error(err))
+		return nil, err
+	}
+	return balances, nil
+}
+
+// Аргументы date_trunc; в запрос подставляются только значения из этого списка
+var reportTruncUnits = map[model.ReportGranularity]string{
+	model.GranularityDay:   "day",
+	model.GranularityWeek:  "week",
+	model.GranularityMonth: "month",
+	model.GranularityYear:  "year",
+}
+
+// Суммы доходов и расходов по периодам (и категориям) в исходных валютах
+func (r *transactionRepo) Report(ctx context.Context, filter model.ReportFilter) ([]model.ReportRow, error) {
+	r.logger.Info("building report", zap.String("user_id", filter.UserID), zap.String("granularity", string(filter.Granularity)), zap.Bool("by_category", filter.ByCategory))
+
+	unit, ok := reportTruncUnits[filter.Granularity]
+	if !ok {
+		unit = reportTruncUnits[model.GranularityMonth]
+	}
+	category := `'' AS category_id`
+	groupBy := "period, currency"
+	if filter.ByCategory {
+		category = "category_id::text AS category_id"
+		groupBy += ", category_id"
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			date_trunc('%s', date::timestamp) AS period,
+			currency,
+			%s,
+			COALESCE(SUM(CASE WHEN type = 'INCOME' THEN amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN type = 'EXPENSE' THEN amount ELSE 0 END), 0) AS expense
+		FROM transactions
+		WHERE user_id = $1 AND date >= $2 AND date <= $3 AND household_id IS NULL AND type <> 'TRANSFER'
+		GROUP BY %s
+		ORDER BY %s
+	`, unit, category, groupBy, groupBy)
+
+	var rows []model.ReportRow
+	if err := r.db.SelectContext(ctx, &rows, query, filter.UserID, filter.DateFrom, filter.DateTo); err != nil {
+		r.logger.Error("failed to build report", zap.Error(err))
+		return nil, err
 	}
-	return income, expense, income - expense, err
+	r.logger.Info("report rows", zap.Int("count", len(rows)))
+	return rows, nil
 }
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Ошибки проверки счёта
+var (
+	ErrInvalidAccount          = errors.New("ошибка валидации счёта")
+	ErrAccountForbidden        = errors.New("account belongs to another user")
+	ErrAccountArchived         = errors.New("account is archived")
+	ErrAccountCurrencyMismatch = errors.New("account currency does not match transaction currency")
+)
+
+// Интерфейс бизнес-логики счетов
+type AccountService interface {
+	CreateAccount(ctx context.Context, a *model.Account) (*model.AccountBalance, error)
+	ListAccounts(ctx context.Context, userID string, includeArchived bool) ([]*model.AccountBalance, error)
+	ArchiveAccount(ctx context.Context, accountID, userID string) error
+}
+
+// Реализует бизнес-логику счетов
+type accountService struct {
+	repo   repo.AccountRepository
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса счетов
+func NewAccountService(r repo.AccountRepository, logger *zap.Logger) AccountService {
+	return &accountService{
+		repo:   r,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Создаёт счёт; остаток нового счёта равен начальному
+func (s *accountService) CreateAccount(ctx context.Context, a *model.Account) (*model.AccountBalance, error) {
+	if err := a.Validate(); err != nil {
+		s.logger.Error("invalid account", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccount, err)
+	}
+	s.logger.Info("adding account", zap.String("user_id", a.UserID), zap.String("name", a.Name))
+	a.ID = uuid.New().String()
+	a.IsDefault = false
+	a.Archived = false
+	a.CreatedAt = s.now()
+	if err := s.repo.Create(ctx, a); err != nil {
+		s.logger.Error("failed to add account", zap.Error(err))
+		return nil, err
+	}
+	return &model.AccountBalance{Account: *a, Balance: a.OpeningBalance}, nil
+}
+
+func (s *accountService) ListAccounts(ctx context.Context, userID string, includeArchived bool) ([]*model.AccountBalance, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user_id is required", ErrInvalidAccount)
+	}
+	s.logger.Info("listing accounts", zap.String("user_id", userID))
+	return s.repo.ListBalances(ctx, userID, includeArchived)
+}
+
+// Архивирует счёт. Основной счёт валюты архивировать нельзя:
+// на него попадают транзакции без явного счёта
+func (s *accountService) ArchiveAccount(ctx context.Context, accountID, userID string) error {
+	s.logger.Info("archiving account", zap.String("account_id", accountID), zap.String("user_id", userID))
+	a, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repo.ErrAccountNotFound) {
+			return fmt.Errorf("счёт не найден: %w", err)
+		}
+		return err
+	}
+	if a.UserID != userID {
+		return fmt.Errorf("счёт не найден: %w", repo.ErrAccountNotFound)
+	}
+	if a.IsDefault {
+		return fmt.Errorf("%w: default account cannot be archived", ErrInvalidAccount)
+	}
+	if err := s.repo.Archive(ctx, accountID, userID); err != nil {
+		if errors.Is(err, repo.ErrAccountNotFound) {
+			return fmt.Errorf("счёт не найден: %w", err)
+		}
+		s.logger.Error("failed to archive account", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Проверяет, что счёт принадлежит пользователю и не в архиве
+func checkAccount(ctx context.Context, accounts repo.AccountRepository, accountID, userID string) (*model.Account, error) {
+	a, err := accounts.GetByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, repo.ErrAccountNotFound) {
+			return nil, fmt.Errorf("счёт не найден: %w", err)
+		}
+		return nil, err
+	}
+	if a.UserID != userID {
+		return nil, ErrAccountForbidden
+	}
+	if a.Archived {
+		return nil, ErrAccountArchived
+	}
+	return a, nil
+}
+
+// Привязывает транзакцию к счетам: без AccountID — к основному счёту её валюты.
+// Валюта транзакции должна совпадать с валютой счёта списания. У перевода без
+// суммы зачисления она равна сумме списания, если валюты счетов совпадают
+func attachAccounts(ctx context.Context, accounts repo.AccountRepository, tx *model.Transaction, now time.Time) error {
+	var from *model.Account
+	var err error
+	if tx.AccountID == "" {
+		if from, err = accounts.EnsureDefault(ctx, tx.UserID, tx.Currency, now); err != nil {
+			return err
+		}
+		tx.AccountID = from.ID
+	} else if from, err = checkAccount(ctx, accounts, tx.AccountID, tx.UserID); err != nil {
+		return err
+	}
+	if from.Currency != tx.Currency {
+		return ErrAccountCurrencyMismatch
+	}
+	if tx.Type != model.Transfer {
+		return nil
+	}
+
+	if tx.TransferAccountID == tx.AccountID {
+		return fmt.Errorf("%w: transfer accounts must differ", ErrInvalidTransaction)
+	}
+	to, err := checkAccount(ctx, accounts, tx.TransferAccountID, tx.UserID)
+	if err != nil {
+		return err
+	}
+	if tx.TransferAmount == 0 {
+		if to.Currency != from.Currency {
+			return fmt.Errorf("%w: transfer_amount is required for transfer between currencies", ErrInvalidTransaction)
+		}
+		tx.TransferAmount = tx.Amount
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Не удалось получить валюту профиля из user-service
+var ErrProfileUnavailable = errors.New("профиль пользователя недоступен")
+
+// Источник валюты профиля пользователя (user-service)
+type ProfileCurrencySource interface {
+	ProfileCurrency(ctx context.Context, userID string) (string, error)
+}
+
+// Интерфейс расчёта баланса
+type BalanceService interface {
+	GetBalance(ctx context.Context, userID, householdID string) (*model.BalanceReport, error)
+	GetReport(ctx context.Context, filter model.ReportFilter) (*model.Report, error)
+	GetNetWorth(ctx context.Context, userID string) (*model.NetWorth, error)
+}
+
+// Считает баланс по валютам и пересчитывает его в валюту профиля
+type balanceService struct {
+	repo       repo.TransactionRepository
+	households repo.HouseholdRepository
+	accounts   repo.AccountRepository
+	loans      repo.LoanRepository
+	rates      currency.RateProvider
+	profiles   ProfileCurrencySource
+	logger     *zap.Logger
+}
+
+// Создаёт новый экземпляр сервиса баланса
+func NewBalanceService(r repo.TransactionRepository, households repo.HouseholdRepository, accounts repo.AccountRepository, loans repo.LoanRepository, rates currency.RateProvider, profiles ProfileCurrencySource, logger *zap.Logger) BalanceService {
+	return &balanceService{
+		repo:       r,
+		households: households,
+		accounts:   accounts,
+		loans:      loans,
+		rates:      rates,
+		profiles:   profiles,
+		logger:     logger,
+	}
+}
+
+// Личный баланс пользователя или, если задан householdID, баланс общего бюджета
+// по транзакциям всех участников. Итоги пересчитываются в валюту профиля пользователя
+func (s *balanceService) GetBalance(ctx context.Context, userID, householdID string) (*model.BalanceReport, error) {
+	s.logger.Info("getting balance", zap.String("user_id", userID), zap.String("household_id", householdID))
+	var (
+		balances []model.CurrencyBalance
+		err      error
+	)
+	if householdID != "" {
+		if _, err := checkHouseholdAccess(ctx, s.households, householdID, userID, false); err != nil {
+			return nil, err
+		}
+		balances, err = s.repo.GetHouseholdBalance(ctx, householdID)
+	} else {
+		balances, err = s.repo.GetBalance(ctx, userID)
+	}
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		s.logger.Error("failed to get balance", zap.Error(err))
+		return nil, err
+	}
+
+	target, err := s.profileCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.BalanceReport{Currency: target, ByCurrency: balances}
+	for _, b := range balances {
+		income, err := s.convert(ctx, b.Income, b.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		expense, err := s.convert(ctx, b.Expense, b.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		report.Income += income
+		report.Expense += expense
+	}
+	return report, nil
+}
+
+// Чистая стоимость в валюте профиля: остатки открытых счетов плюс остатки
+// выданных займов минус остатки полученных
+func (s *balanceService) GetNetWorth(ctx context.Context, userID string) (*model.NetWorth, error) {
+	s.logger.Info("getting net worth", zap.String("user_id", userID))
+	target, err := s.profileCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accounts.ListBalances(ctx, userID, false)
+	if err != nil {
+		s.logger.Error("failed to list account balances", zap.Error(err))
+		return nil, err
+	}
+	loans, err := s.loans.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := loanStatuses(ctx, s.loans, s.rates, loans)
+	if err != nil {
+		return nil, err
+	}
+
+	nw := &model.NetWorth{Currency: target, AccountList: accounts}
+	for _, a := range accounts {
+		v, err := s.convert(ctx, a.Balance, a.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		nw.Accounts += v
+	}
+	for _, st := range statuses {
+		if st.Closed() {
+			continue
+		}
+		v, err := s.convert(ctx, st.Outstanding, st.Loan.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		if st.Loan.Direction == model.LoanLent {
+			nw.Lent += v
+		} else {
+			nw.Borrowed += v
+		}
+		nw.Loans = append(nw.Loans, st)
+	}
+	return nw, nil
+}
+
+// Строит отчёт по периодам в валюте профиля. Периоды без операций тоже попадают в ряд,
+// чтобы остатки на начало и конец шли без разрывов.
+// Крайние периоды обрезаются по границам запроса
+func (s *balanceService) GetReport(ctx context.Context, filter model.ReportFilter) (*model.Report, error) {
+	if filter.Granularity == "" {
+		filter.Granularity = model.GranularityMonth
+	}
+	filter.DateFrom = model.GranularityDay.Truncate(filter.DateFrom)
+	filter.DateTo = model.GranularityDay.Truncate(filter.DateTo)
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	s.logger.Info("building report", zap.String("user_id", filter.UserID), zap.String("granularity", string(filter.Granularity)))
+
+	target, err := s.profileCurrency(ctx, filter.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.repo.GetBalanceBefore(ctx, filter.UserID, filter.DateFrom)
+	if err != nil {
+		s.logger.Error("failed to get opening balance", zap.Error(err))
+		return nil, err
+	}
+	var opening model.Money
+	for _, b := range before {
+		v, err := s.convert(ctx, b.Balance(), b.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		opening += v
+	}
+
+	rows, err := s.repo.Report(ctx, filter)
+	if err != nil {
+		s.logger.Error("failed to build report", zap.Error(err))
+		return nil, err
+	}
+
+	report := &model.Report{Currency: target}
+	byStart := map[int64]*model.ReportBucket{}
+	end := filter.DateTo.AddDate(0, 0, 1)
+	for start := filter.Granularity.Truncate(filter.DateFrom); start.Before(end); start = filter.Granularity.Next(start) {
+		b := &model.ReportBucket{Start: start, End: filter.Granularity.Next(start)}
+		if b.Start.Before(filter.DateFrom) {
+			b.Start = filter.DateFrom
+		}
+		if b.End.After(end) {
+			b.End = end
+		}
+		byStart[start.Unix()] = b
+		report.Buckets = append(report.Buckets, b)
+	}
+
+	// Индекс категории в b.Categories для каждого периода
+	categories := map[int64]map[string]int{}
+	for _, row := range rows {
+		key := filter.Granularity.Truncate(row.Period).Unix()
+		b, ok := byStart[key]
+		if !ok {
+			continue
+		}
+		income, err := s.convert(ctx, row.Income, row.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		expense, err := s.convert(ctx, row.Expense, row.Currency, target)
+		if err != nil {
+			return nil, err
+		}
+		b.Income += income
+		b.Expense += expense
+
+		if filter.ByCategory {
+			if categories[key] == nil {
+				categories[key] = map[string]int{}
+			}
+			i, ok := categories[key][row.CategoryID]
+			if !ok {
+				i = len(b.Categories)
+				b.Categories = append(b.Categories, model.CategoryTotal{CategoryID: row.CategoryID})
+				categories[key][row.CategoryID] = i
+			}
+			b.Categories[i].Income += income
+			b.Categories[i].Expense += expense
+		}
+	}
+
+	balance := opening
+	for _, b := range report.Buckets {
+		b.Opening = balance
+		balance += b.Net()
+		b.Closing = balance
+	}
+	return report, nil
+}
+
+// Пересчитывает сумму в валюту профиля
+func (s *balanceService) convert(ctx context.Context, amount model.Money, from, to string) (model.Money, error) {
+	v, err := currency.Convert(ctx, s.rates, amount, from, to)
+	if err != nil {
+		s.logger.Error("failed to convert amount", zap.String("from", from), zap.String("to", to), zap.Error(err))
+	}
+	return v, err
+}
+
+// Валюта профиля; если в профиле она не указана, используется валюта по умолчанию
+func (s *balanceService) profileCurrency(ctx context.Context, userID string) (string, error) {
+	code, err := s.profiles.ProfileCurrency(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get profile currency", zap.String("user_id", userID), zap.Error(err))
+		return "", fmt.Errorf("%w: %w", ErrProfileUnavailable, err)
+	}
+	return model.NormalizeCurrency(code)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Бюджет не прошёл валидацию
+var ErrInvalidBudget = errors.New("ошибка валидации бюджета")
+
+// Интерфейс бизнес-логики бюджетов
+type BudgetService interface {
+	SetBudget(ctx context.Context, b *model.Budget) (*model.Budget, error)
+	ListBudgets(ctx context.Context, userID string, month time.Time) ([]*model.BudgetStatus, error)
+	DeleteBudget(ctx context.Context, budgetID, userID string) error
+}
+
+// Реализует бизнес-логику бюджетов
+type budgetService struct {
+	repo       repo.BudgetRepository
+	categories repo.CategoryRepository
+	rates      currency.RateProvider
+	logger     *zap.Logger
+	now        func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса бюджетов
+func NewBudgetService(r repo.BudgetRepository, categories repo.CategoryRepository, rates currency.RateProvider, logger *zap.Logger) BudgetService {
+	return &budgetService{
+		repo:       r,
+		categories: categories,
+		rates:      rates,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Устанавливает месячный лимит для категории расходов
+func (s *budgetService) SetBudget(ctx context.Context, b *model.Budget) (*model.Budget, error) {
+	if err := b.Validate(); err != nil {
+		s.logger.Error("invalid budget", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBudget, err)
+	}
+	// Бюджет ставится только на доступную категорию расходов
+	tx := &model.Transaction{UserID: b.UserID, CategoryID: b.CategoryID, Type: model.Expense}
+	if err := checkCategory(ctx, s.categories, tx); err != nil {
+		s.logger.Error("invalid budget category", zap.String("category_id", b.CategoryID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("setting budget", zap.String("user_id", b.UserID), zap.String("category_id", b.CategoryID), zap.Stringer("limit", b.Limit))
+	b.ID = uuid.New().String()
+	b.CreatedAt = s.now()
+	b.UpdatedAt = b.CreatedAt
+	if err := s.repo.Upsert(ctx, b); err != nil {
+		s.logger.Error("failed to set budget", zap.Error(err))
+		return nil, err
+	}
+	return b, nil
+}
+
+// Возвращает бюджеты пользователя с тратами за месяц, в который попадает month
+func (s *budgetService) ListBudgets(ctx context.Context, userID string, month time.Time) ([]*model.BudgetStatus, error) {
+	if month.IsZero() {
+		month = s.now()
+	}
+	from := model.GranularityMonth.Truncate(month)
+	to := model.GranularityMonth.Next(from)
+	s.logger.Info("listing budgets", zap.String("user_id", userID), zap.Time("month", from))
+
+	budgets, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to list budgets", zap.Error(err))
+		return nil, err
+	}
+	statuses := make([]*model.BudgetStatus, 0, len(budgets))
+	if len(budgets) == 0 {
+		return statuses, nil
+	}
+
+	spending, err := s.repo.SpentByCategory(ctx, userID, from, to)
+	if err != nil {
+		s.logger.Error("failed to get spending", zap.Error(err))
+		return nil, err
+	}
+
+	for _, b := range budgets {
+		status := &model.BudgetStatus{Budget: b, Month: from}
+		for _, sp := range spending {
+			if sp.CategoryID != b.CategoryID {
+				continue
+			}
+			v, err := currency.Convert(ctx, s.rates, sp.Amount, sp.Currency, b.Currency)
+			if err != nil {
+				s.logger.Error("failed to convert spending", zap.String("from", sp.Currency), zap.String("to", b.Currency), zap.Error(err))
+				return nil, err
+			}
+			status.Spent += v
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (s *budgetService) DeleteBudget(ctx context.Context, budgetID, userID string) error {
+	s.logger.Info("deleting budget", zap.String("budget_id", budgetID), zap.String("user_id", userID))
+	err := s.repo.Delete(ctx, budgetID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrBudgetNotFound) {
+			return fmt.Errorf("бюджет не найден: %w", err)
+		}
+		s.logger.Error("failed to delete budget", zap.Error(err))
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Ошибки проверки категории транзакции
+var (
+	ErrCategoryTypeMismatch = errors.New("category type does not match transaction type")
+	ErrCategoryForbidden    = errors.New("category belongs to another user")
+	ErrCategoryArchived     = errors.New("category is archived")
+)
+
+// Интерфейс бизнес-логики категорий
+type CategoryService interface {
+	CreateCategory(ctx context.Context, c *model.Category) (string, error)
+	ListCategories(ctx context.Context, userID string, txType model.TransactionType, includeArchived bool) ([]*model.Category, error)
+	RenameCategory(ctx context.Context, categoryID, userID, name string) error
+	ArchiveCategory(ctx context.Context, categoryID, userID string) error
+}
+
+// Реализует бизнес-логику категорий
+type categoryService struct {
+	repo   repo.CategoryRepository
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса категорий
+func NewCategoryService(r repo.CategoryRepository, logger *zap.Logger) CategoryService {
+	return &categoryService{
+		repo:   r,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+func (s *categoryService) CreateCategory(ctx context.Context, c *model.Category) (string, error) {
+	if c.UserID == "" {
+		return "", errors.New("user_id is required")
+	}
+	c.Name = strings.TrimSpace(c.Name)
+	if err := c.Validate(); err != nil {
+		s.logger.Error("invalid category", zap.Error(err))
+		return "", fmt.Errorf("ошибка валидации категории: %w", err)
+	}
+	s.logger.Info("adding category", zap.String("user_id", c.UserID), zap.String("name", c.Name))
+	c.ID = uuid.New().String()
+	c.Archived = false
+	c.CreatedAt = s.now()
+	err := s.repo.Create(ctx, c)
+	if err != nil {
+		s.logger.Error("failed to add category", zap.Error(err))
+	}
+	return c.ID, err
+}
+
+func (s *categoryService) ListCategories(ctx context.Context, userID string, txType model.TransactionType, includeArchived bool) ([]*model.Category, error) {
+	s.logger.Info("listing categories", zap.String("user_id", userID))
+	return s.repo.ListByUserID(ctx, userID, txType, includeArchived)
+}
+
+func (s *categoryService) RenameCategory(ctx context.Context, categoryID, userID, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("category name is required")
+	}
+	s.logger.Info("renaming category", zap.String("category_id", categoryID), zap.String("user_id", userID))
+	err := s.repo.Rename(ctx, categoryID, userID, name)
+	if err != nil {
+		if errors.Is(err, repo.ErrCategoryNotFound) {
+			return fmt.Errorf("категория не найдена: %w", err)
+		}
+		s.logger.Error("failed to rename category", zap.Error(err))
+	}
+	return err
+}
+
+func (s *categoryService) ArchiveCategory(ctx context.Context, categoryID, userID string) error {
+	s.logger.Info("archiving category", zap.String("category_id", categoryID), zap.String("user_id", userID))
+	err := s.repo.Archive(ctx, categoryID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrCategoryNotFound) {
+			return fmt.Errorf("категория не найдена: %w", err)
+		}
+		s.logger.Error("failed to archive category", zap.Error(err))
+	}
+	return err
+}
+
+// Проверяет, что категория доступна пользователю и подходит по типу транзакции
+func checkCategory(ctx context.Context, categories repo.CategoryRepository, tx *model.Transaction) error {
+	c, err := categories.GetByID(ctx, tx.CategoryID)
+	if err != nil {
+		if errors.Is(err, repo.ErrCategoryNotFound) {
+			return fmt.Errorf("категория не найдена: %w", err)
+		}
+		return err
+	}
+	if !c.AvailableTo(tx.UserID) {
+		return ErrCategoryForbidden
+	}
+	if c.Archived {
+		return ErrCategoryArchived
+	}
+	if c.Type != tx.Type {
+		return ErrCategoryTypeMismatch
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/export"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"go.uber.org/zap"
+)
+
+// Пишет в w все транзакции по фильтру в выбранном формате.
+// Пагинация фильтра не применяется: выгружается вся подходящая история
+func (s *transactionService) ExportTransactions(ctx context.Context, filter model.TransactionFilter, format export.Format, w io.Writer) error {
+	filter.Limit, filter.Offset, filter.PageToken, filter.Cursor = 0, 0, "", nil
+	if filter.SortBy == "" {
+		filter.SortBy = model.SortByDate
+	}
+	if err := filter.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	if filter.HouseholdID != "" {
+		if _, err := checkHouseholdAccess(ctx, s.households, filter.HouseholdID, filter.UserID, false); err != nil {
+			return err
+		}
+	}
+	s.logger.Info("exporting transactions", zap.String("user_id", filter.UserID), zap.String("format", string(format)))
+
+	enc, err := export.NewEncoder(format, w, export.Meta{
+		UserID:      filter.UserID,
+		DateFrom:    filter.DateFrom,
+		DateTo:      filter.DateTo,
+		GeneratedAt: s.now(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	if err := s.repo.Export(ctx, filter, enc.Encode); err != nil {
+		s.logger.Error("failed to export transactions", zap.Error(err))
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Цель не прошла валидацию
+var ErrInvalidGoal = errors.New("ошибка валидации цели")
+
+// Интерфейс бизнес-логики целей накоплений
+type GoalService interface {
+	CreateGoal(ctx context.Context, g *model.Goal) (*model.GoalProgress, error)
+	ListGoals(ctx context.Context, userID string) ([]*model.GoalProgress, error)
+	GetGoal(ctx context.Context, goalID, userID string) (*model.GoalProgress, error)
+	UpdateGoal(ctx context.Context, goalID, userID string, patch *model.GoalPatch) (*model.GoalProgress, error)
+	DeleteGoal(ctx context.Context, goalID, userID string) error
+	AddContribution(ctx context.Context, tx *model.Transaction) (string, error)
+	LinkTransaction(ctx context.Context, goalID, userID, transactionID string) (*model.GoalProgress, error)
+	UnlinkTransaction(ctx context.Context, goalID, userID, transactionID string) (*model.GoalProgress, error)
+}
+
+// Реализует бизнес-логику целей накоплений. Прогноз строится по среднему
+// чистому доходу пользователя за последние полные месяцы
+type goalService struct {
+	repo         repo.GoalRepository
+	history      repo.TransactionRepository
+	transactions TransactionService
+	rates        currency.RateProvider
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса целей
+func NewGoalService(r repo.GoalRepository, history repo.TransactionRepository, transactions TransactionService, rates currency.RateProvider, logger *zap.Logger) GoalService {
+	return &goalService{
+		repo:         r,
+		history:      history,
+		transactions: transactions,
+		rates:        rates,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+func (s *goalService) CreateGoal(ctx context.Context, g *model.Goal) (*model.GoalProgress, error) {
+	if err := s.validate(g); err != nil {
+		return nil, err
+	}
+	s.logger.Info("adding goal", zap.String("user_id", g.UserID), zap.String("name", g.Name), zap.Stringer("target", g.TargetAmount))
+	g.ID = uuid.New().String()
+	g.CreatedAt = s.now()
+	g.UpdatedAt = g.CreatedAt
+	if err := s.repo.Create(ctx, g); err != nil {
+		s.logger.Error("failed to add goal", zap.Error(err))
+		return nil, err
+	}
+	return s.progressOne(ctx, g)
+}
+
+func (s *goalService) ListGoals(ctx context.Context, userID string) ([]*model.GoalProgress, error) {
+	s.logger.Info("listing goals", zap.String("user_id", userID))
+	goals, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.progress(ctx, userID, goals)
+}
+
+func (s *goalService) GetGoal(ctx context.Context, goalID, userID string) (*model.GoalProgress, error) {
+	g, err := s.get(ctx, goalID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.progressOne(ctx, g)
+}
+
+// Частично обновляет цель; срок проверяется, только если он меняется
+func (s *goalService) UpdateGoal(ctx context.Context, goalID, userID string, patch *model.GoalPatch) (*model.GoalProgress, error) {
+	s.logger.Info("updating goal", zap.String("goal_id", goalID), zap.String("user_id", userID))
+	if patch.IsEmpty() {
+		return nil, fmt.Errorf("%w: nothing to update", ErrInvalidGoal)
+	}
+	g, err := s.get(ctx, goalID, userID)
+	if err != nil {
+		return nil, err
+	}
+	patch.Apply(g)
+	if err := g.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidGoal, err)
+	}
+	if patch.Deadline != nil {
+		if err := s.validateDeadline(g); err != nil {
+			return nil, err
+		}
+	}
+
+	g.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, g); err != nil {
+		if errors.Is(err, repo.ErrGoalNotFound) {
+			return nil, fmt.Errorf("цель не найдена: %w", err)
+		}
+		s.logger.Error("failed to update goal", zap.Error(err))
+		return nil, err
+	}
+	return s.progressOne(ctx, g)
+}
+
+func (s *goalService) DeleteGoal(ctx context.Context, goalID, userID string) error {
+	s.logger.Info("deleting goal", zap.String("goal_id", goalID), zap.String("user_id", userID))
+	err := s.repo.Delete(ctx, goalID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrGoalNotFound) {
+			return fmt.Errorf("цель не найдена: %w", err)
+		}
+		s.logger.Error("failed to delete goal", zap.Error(err))
+	}
+	return err
+}
+
+// Добавляет транзакцию или перевод сразу взносом в цель tx.GoalID
+func (s *goalService) AddContribution(ctx context.Context, tx *model.Transaction) (string, error) {
+	if _, err := s.get(ctx, tx.GoalID, tx.UserID); err != nil {
+		return "", err
+	}
+	s.logger.Info("adding goal contribution", zap.String("goal_id", tx.GoalID), zap.String("user_id", tx.UserID))
+	return s.transactions.AddTransaction(ctx, tx)
+}
+
+// Засчитывает существующую транзакцию пользователя взносом в цель
+func (s *goalService) LinkTransaction(ctx context.Context, goalID, userID, transactionID string) (*model.GoalProgress, error) {
+	g, err := s.get(ctx, goalID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.LinkTransaction(ctx, transactionID, userID, goalID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		return nil, err
+	}
+	return s.progressOne(ctx, g)
+}
+
+// Отвязывает транзакцию от цели; сама транзакция не удаляется
+func (s *goalService) UnlinkTransaction(ctx context.Context, goalID, userID, transactionID string) (*model.GoalProgress, error) {
+	g, err := s.get(ctx, goalID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UnlinkTransaction(ctx, transactionID, userID, goalID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		return nil, err
+	}
+	return s.progressOne(ctx, g)
+}
+
+func (s *goalService) get(ctx context.Context, goalID, userID string) (*model.Goal, error) {
+	g, err := s.repo.GetByID(ctx, goalID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrGoalNotFound) {
+			return nil, fmt.Errorf("цель не найдена: %w", err)
+		}
+		return nil, err
+	}
+	return g, nil
+}
+
+func (s *goalService) validate(g *model.Goal) error {
+	if err := g.Validate(); err != nil {
+		s.logger.Error("invalid goal", zap.Error(err))
+		return fmt.Errorf("%w: %w", ErrInvalidGoal, err)
+	}
+	if g.Deadline != nil {
+		return s.validateDeadline(g)
+	}
+	return nil
+}
+
+// Срок цели не может быть в прошлом
+func (s *goalService) validateDeadline(g *model.Goal) error {
+	if g.Deadline.Before(model.GranularityDay.Truncate(s.now())) {
+		return fmt.Errorf("%w: deadline must not be in the past", ErrInvalidGoal)
+	}
+	return nil
+}
+
+func (s *goalService) progressOne(ctx context.Context, g *model.Goal) (*model.GoalProgress, error) {
+	list, err := s.progress(ctx, g.UserID, []*model.Goal{g})
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// Считает прогресс целей: взносы и средний чистый доход пересчитываются в валюту каждой цели
+func (s *goalService) progress(ctx context.Context, userID string, goals []*model.Goal) ([]*model.GoalProgress, error) {
+	result := make([]*model.GoalProgress, 0, len(goals))
+	if len(goals) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(goals))
+	for i, g := range goals {
+		ids[i] = g.ID
+	}
+	contributions, err := s.repo.Contributions(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Доходы и расходы за последние полные месяцы, текущий месяц не учитывается
+	now := s.now()
+	month := model.GranularityMonth.Truncate(now)
+	rows, err := s.history.Report(ctx, model.ReportFilter{
+		UserID:      userID,
+		DateFrom:    month.AddDate(0, -model.GoalIncomeMonths, 0),
+		DateTo:      month.AddDate(0, 0, -1),
+		Granularity: model.GranularityMonth,
+	})
+	if err != nil {
+		s.logger.Error("failed to get income history", zap.Error(err))
+		return nil, err
+	}
+
+	for _, g := range goals {
+		var contributed, net model.Money
+		for _, c := range contributions {
+			if c.GoalID != g.ID {
+				continue
+			}
+			v, err := currency.Convert(ctx, s.rates, c.Amount, c.Currency, g.Currency)
+			if err != nil {
+				return nil, err
+			}
+			contributed += v
+		}
+		for _, row := range rows {
+			v, err := currency.Convert(ctx, s.rates, row.Income-row.Expense, row.Currency, g.Currency)
+			if err != nil {
+				return nil, err
+			}
+			net += v
+		}
+		result = append(result, model.NewGoalProgress(g, contributed, net/model.GoalIncomeMonths, now))
+	}
+	return result, nil
+}
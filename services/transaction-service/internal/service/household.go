@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+var (
+	// Общий бюджет, участник или приглашение не прошли валидацию
+	ErrInvalidHousehold = errors.New("ошибка валидации общего бюджета")
+	// Пользователь не участвует в общем бюджете или его роли недостаточно
+	ErrHouseholdForbidden = errors.New("нет доступа к общему бюджету")
+)
+
+// Срок действия приглашения в общий бюджет
+const HouseholdInvitationTTL = 7 * 24 * time.Hour
+
+// Интерфейс бизнес-логики общих бюджетов
+type HouseholdService interface {
+	CreateHousehold(ctx context.Context, h *model.Household) (*model.Household, error)
+	ListHouseholds(ctx context.Context, userID string) ([]*model.Household, error)
+	ListMembers(ctx context.Context, householdID, userID string) ([]*model.HouseholdMember, error)
+	InviteMember(ctx context.Context, householdID, userID string, role model.HouseholdRole) (*model.HouseholdInvitation, error)
+	AcceptInvitation(ctx context.Context, userID, code string) (*model.HouseholdMember, error)
+	UpdateMemberRole(ctx context.Context, householdID, userID, memberID string, role model.HouseholdRole) (*model.HouseholdMember, error)
+	RemoveMember(ctx context.Context, householdID, userID, memberID string) error
+}
+
+// Реализует бизнес-логику общих бюджетов
+type householdService struct {
+	repo   repo.HouseholdRepository
+	logger *zap.Logger
+	now    func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса общих бюджетов
+func NewHouseholdService(r repo.HouseholdRepository, logger *zap.Logger) HouseholdService {
+	return &householdService{
+		repo:   r,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Создаёт общий бюджет; создатель становится владельцем
+func (s *householdService) CreateHousehold(ctx context.Context, h *model.Household) (*model.Household, error) {
+	h.Name = strings.TrimSpace(h.Name)
+	if err := h.Validate(); err != nil {
+		s.logger.Error("invalid household", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHousehold, err)
+	}
+	s.logger.Info("creating household", zap.String("owner_id", h.OwnerID))
+	h.ID = uuid.New().String()
+	h.Role = model.HouseholdOwner
+	h.CreatedAt = s.now()
+	if err := s.repo.Create(ctx, h); err != nil {
+		s.logger.Error("failed to create household", zap.Error(err))
+		return nil, err
+	}
+	return h, nil
+}
+
+func (s *householdService) ListHouseholds(ctx context.Context, userID string) ([]*model.Household, error) {
+	s.logger.Info("listing households", zap.String("user_id", userID))
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Список участников доступен любому участнику бюджета
+func (s *householdService) ListMembers(ctx context.Context, householdID, userID string) ([]*model.HouseholdMember, error) {
+	if _, err := checkHouseholdAccess(ctx, s.repo, householdID, userID, false); err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, householdID)
+}
+
+// Создаёт одноразовое приглашение. Код возвращается только здесь, в базе хранится его хеш
+func (s *householdService) InviteMember(ctx context.Context, householdID, userID string, role model.HouseholdRole) (*model.HouseholdInvitation, error) {
+	if !role.Assignable() {
+		return nil, fmt.Errorf("%w: role must be editor or viewer", ErrInvalidHousehold)
+	}
+	if err := s.checkOwner(ctx, householdID, userID); err != nil {
+		return nil, err
+	}
+
+	code, err := newInvitationCode()
+	if err != nil {
+		s.logger.Error("failed to generate invitation code", zap.Error(err))
+		return nil, err
+	}
+	now := s.now()
+	inv := &model.HouseholdInvitation{
+		ID:          uuid.New().String(),
+		HouseholdID: householdID,
+		InvitedBy:   userID,
+		Role:        role,
+		CodeHash:    hashInvitationCode(code),
+		Code:        code,
+		ExpiresAt:   now.Add(HouseholdInvitationTTL),
+		CreatedAt:   now,
+	}
+	s.logger.Info("inviting household member", zap.String("household_id", householdID), zap.String("role", string(role)))
+	if err := s.repo.CreateInvitation(ctx, inv); err != nil {
+		s.logger.Error("failed to create invitation", zap.Error(err))
+		return nil, err
+	}
+	return inv, nil
+}
+
+// Принимает приглашение по коду: пользователь получает роль из приглашения
+func (s *householdService) AcceptInvitation(ctx context.Context, userID, code string) (*model.HouseholdMember, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("%w: code is required", ErrInvalidHousehold)
+	}
+	s.logger.Info("accepting household invitation", zap.String("user_id", userID))
+	m, err := s.repo.AcceptInvitation(ctx, hashInvitationCode(code), userID, s.now())
+	if err != nil {
+		if !errors.Is(err, repo.ErrInvitationNotFound) && !errors.Is(err, repo.ErrAlreadyMember) {
+			s.logger.Error("failed to accept invitation", zap.Error(err))
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// Меняет роль участника. Владелец не может сменить роль самому себе
+func (s *householdService) UpdateMemberRole(ctx context.Context, householdID, userID, memberID string, role model.HouseholdRole) (*model.HouseholdMember, error) {
+	if !role.Assignable() {
+		return nil, fmt.Errorf("%w: role must be editor or viewer", ErrInvalidHousehold)
+	}
+	if memberID == userID {
+		return nil, fmt.Errorf("%w: owner role cannot be changed", ErrInvalidHousehold)
+	}
+	if err := s.checkOwner(ctx, householdID, userID); err != nil {
+		return nil, err
+	}
+	s.logger.Info("updating household member role", zap.String("household_id", householdID), zap.String("member_id", memberID), zap.String("role", string(role)))
+	if err := s.repo.UpdateMemberRole(ctx, householdID, memberID, role); err != nil {
+		return nil, err
+	}
+	return s.repo.GetMember(ctx, householdID, memberID)
+}
+
+// Исключает участника (только владелец) или выводит пользователя из бюджета.
+// Владелец покинуть бюджет не может
+func (s *householdService) RemoveMember(ctx context.Context, householdID, userID, memberID string) error {
+	if memberID == userID {
+		m, err := checkHouseholdAccess(ctx, s.repo, householdID, userID, false)
+		if err != nil {
+			return err
+		}
+		if m.Role == model.HouseholdOwner {
+			return fmt.Errorf("%w: owner cannot leave the household", ErrInvalidHousehold)
+		}
+	} else if err := s.checkOwner(ctx, householdID, userID); err != nil {
+		return err
+	}
+	s.logger.Info("removing household member", zap.String("household_id", householdID), zap.String("member_id", memberID))
+	return s.repo.RemoveMember(ctx, householdID, memberID)
+}
+
+func (s *householdService) checkOwner(ctx context.Context, householdID, userID string) error {
+	m, err := checkHouseholdAccess(ctx, s.repo, householdID, userID, false)
+	if err != nil {
+		return err
+	}
+	if m.Role != model.HouseholdOwner {
+		return fmt.Errorf("%w: only the owner manages members", ErrHouseholdForbidden)
+	}
+	return nil
+}
+
+// Проверяет, что пользователь участвует в бюджете, а для write — что он может
+// добавлять и менять транзакции. Неучастнику не сообщается, существует ли бюджет
+func checkHouseholdAccess(ctx context.Context, households repo.HouseholdRepository, householdID, userID string, write bool) (*model.HouseholdMember, error) {
+	m, err := households.GetMember(ctx, householdID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrMemberNotFound) {
+			return nil, ErrHouseholdForbidden
+		}
+		return nil, err
+	}
+	if write && !m.Role.CanEdit() {
+		return nil, fmt.Errorf("%w: role %s is read-only", ErrHouseholdForbidden, m.Role)
+	}
+	return m, nil
+}
+
+// Случайный код приглашения: 128 бит в base64url
+func newInvitationCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashInvitationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
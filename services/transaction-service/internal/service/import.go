@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/csvimport"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Импортирует выписку: каждая строка проверяется отдельно, принятые строки
+// сохраняются одной транзакцией БД. Ошибка возвращается, только если файл
+// не разобран целиком или сохранение не удалось — тогда не сохраняется ничего
+func (s *transactionService) ImportTransactions(ctx context.Context, userID string, data []byte, opts model.ImportOptions) (*model.ImportReport, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user_id is required", ErrInvalidTransaction)
+	}
+	s.logger.Info("importing transactions", zap.String("user_id", userID), zap.Int("size", len(data)))
+
+	rows, err := csvimport.Parse(data, opts)
+	if err != nil {
+		s.logger.Error("failed to parse import file", zap.Error(err))
+		return nil, err
+	}
+
+	report := &model.ImportReport{Rows: make([]model.ImportResult, 0, len(rows))}
+	accepted := make([]*model.Transaction, 0, len(rows))
+	// Результат проверки категории для пары (категория, тип), чтобы не ходить в базу на каждую строку
+	checked := make(map[string]error)
+	// Строки выписки попадают на основные счета своих валют
+	defaults := make(map[string]*model.Account)
+	now := s.now()
+	for _, row := range rows {
+		result := model.ImportResult{Line: row.Line}
+		if err := row.Err; err != nil {
+			result.Error = err.Error()
+			report.Rows = append(report.Rows, result)
+			continue
+		}
+
+		tx := row.Transaction
+		tx.UserID = userID
+		if err := s.validateImported(ctx, tx, checked); err != nil {
+			if !isRowError(err) {
+				return nil, err
+			}
+			result.Error = err.Error()
+			report.Rows = append(report.Rows, result)
+			continue
+		}
+
+		account, ok := defaults[tx.Currency]
+		if !ok {
+			if account, err = s.accounts.EnsureDefault(ctx, userID, tx.Currency, now); err != nil {
+				s.logger.Error("failed to get default account", zap.String("currency", tx.Currency), zap.Error(err))
+				return nil, err
+			}
+			defaults[tx.Currency] = account
+		}
+		tx.AccountID = account.ID
+		tx.ID = uuid.New().String()
+		tx.CreatedAt = now
+		accepted = append(accepted, tx)
+		result.Accepted = true
+		result.TransactionID = tx.ID
+		report.Rows = append(report.Rows, result)
+	}
+
+	if len(accepted) > 0 {
+		if err := s.repo.CreateBatch(ctx, accepted); err != nil {
+			s.logger.Error("failed to save imported transactions", zap.Error(err))
+			return nil, err
+		}
+	}
+	s.logger.Info("import finished", zap.String("user_id", userID), zap.Int("accepted", report.Accepted()), zap.Int("rejected", report.Rejected()))
+	return report, nil
+}
+
+func (s *transactionService) validateImported(ctx context.Context, tx *model.Transaction, checked map[string]error) error {
+	if err := tx.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTransaction, err)
+	}
+	if tx.CategoryID == "" {
+		return fmt.Errorf("%w: category_id is required", ErrInvalidTransaction)
+	}
+	key := tx.CategoryID + "/" + string(tx.Type)
+	err, ok := checked[key]
+	if !ok {
+		err = checkCategory(ctx, s.categories, tx)
+		checked[key] = err
+	}
+	return err
+}
+
+// Ошибки, из-за которых отклоняется только строка, а не весь импорт
+func isRowError(err error) bool {
+	return errors.Is(err, ErrInvalidTransaction) ||
+		errors.Is(err, repo.ErrCategoryNotFound) ||
+		errors.Is(err, ErrCategoryForbidden) ||
+		errors.Is(err, ErrCategoryArchived) ||
+		errors.Is(err, ErrCategoryTypeMismatch)
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Займ или погашение не прошли валидацию
+var ErrInvalidLoan = errors.New("ошибка валидации займа")
+
+// Интерфейс бизнес-логики займов и долгов
+type LoanService interface {
+	CreateLoan(ctx context.Context, l *model.Loan) (*model.LoanStatus, error)
+	ListLoans(ctx context.Context, userID string, includeClosed bool) ([]*model.LoanStatus, error)
+	GetLoan(ctx context.Context, loanID, userID string) (*model.LoanStatus, error)
+	DeleteLoan(ctx context.Context, loanID, userID string) error
+	AddRepayment(ctx context.Context, tx *model.Transaction) (string, error)
+	LinkTransaction(ctx context.Context, loanID, userID, transactionID string) (*model.LoanStatus, error)
+	UnlinkTransaction(ctx context.Context, loanID, userID, transactionID string) (*model.LoanStatus, error)
+}
+
+// Реализует бизнес-логику займов. Остаток считается по графику платежей
+// и сумме привязанных погашений
+type loanService struct {
+	repo         repo.LoanRepository
+	history      repo.TransactionRepository
+	transactions TransactionService
+	rates        currency.RateProvider
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса займов
+func NewLoanService(r repo.LoanRepository, history repo.TransactionRepository, transactions TransactionService, rates currency.RateProvider, logger *zap.Logger) LoanService {
+	return &loanService{
+		repo:         r,
+		history:      history,
+		transactions: transactions,
+		rates:        rates,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+func (s *loanService) CreateLoan(ctx context.Context, l *model.Loan) (*model.LoanStatus, error) {
+	if err := l.Validate(); err != nil {
+		s.logger.Error("invalid loan", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidLoan, err)
+	}
+	s.logger.Info("adding loan", zap.String("user_id", l.UserID), zap.String("direction", string(l.Direction)), zap.Stringer("principal", l.Principal))
+	l.ID = uuid.New().String()
+	l.CreatedAt = s.now()
+	if err := s.repo.Create(ctx, l); err != nil {
+		s.logger.Error("failed to add loan", zap.Error(err))
+		return nil, err
+	}
+	return model.NewLoanStatus(l, 0), nil
+}
+
+// Займы пользователя; погашенные — только с includeClosed
+func (s *loanService) ListLoans(ctx context.Context, userID string, includeClosed bool) ([]*model.LoanStatus, error) {
+	s.logger.Info("listing loans", zap.String("user_id", userID))
+	loans, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := loanStatuses(ctx, s.repo, s.rates, loans)
+	if err != nil {
+		return nil, err
+	}
+	if includeClosed {
+		return statuses, nil
+	}
+	open := make([]*model.LoanStatus, 0, len(statuses))
+	for _, st := range statuses {
+		if !st.Closed() {
+			open = append(open, st)
+		}
+	}
+	return open, nil
+}
+
+func (s *loanService) GetLoan(ctx context.Context, loanID, userID string) (*model.LoanStatus, error) {
+	l, err := s.get(ctx, loanID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(ctx, l)
+}
+
+func (s *loanService) DeleteLoan(ctx context.Context, loanID, userID string) error {
+	s.logger.Info("deleting loan", zap.String("loan_id", loanID), zap.String("user_id", userID))
+	err := s.repo.Delete(ctx, loanID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrLoanNotFound) {
+			return fmt.Errorf("займ не найден: %w", err)
+		}
+		s.logger.Error("failed to delete loan", zap.Error(err))
+	}
+	return err
+}
+
+// Добавляет транзакцию сразу погашением займа tx.LoanID
+func (s *loanService) AddRepayment(ctx context.Context, tx *model.Transaction) (string, error) {
+	l, err := s.get(ctx, tx.LoanID, tx.UserID)
+	if err != nil {
+		return "", err
+	}
+	if err := checkRepayment(l, tx); err != nil {
+		return "", err
+	}
+	s.logger.Info("adding loan repayment", zap.String("loan_id", tx.LoanID), zap.String("user_id", tx.UserID))
+	return s.transactions.AddTransaction(ctx, tx)
+}
+
+// Засчитывает существующую транзакцию пользователя погашением займа
+func (s *loanService) LinkTransaction(ctx context.Context, loanID, userID, transactionID string) (*model.LoanStatus, error) {
+	l, err := s.get(ctx, loanID, userID)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.history.GetByID(ctx, transactionID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		return nil, err
+	}
+	if err := checkRepayment(l, tx); err != nil {
+		return nil, err
+	}
+	if err := s.repo.LinkTransaction(ctx, transactionID, userID, loanID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		return nil, err
+	}
+	return s.status(ctx, l)
+}
+
+// Отвязывает погашение от займа; сама транзакция не удаляется
+func (s *loanService) UnlinkTransaction(ctx context.Context, loanID, userID, transactionID string) (*model.LoanStatus, error) {
+	l, err := s.get(ctx, loanID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UnlinkTransaction(ctx, transactionID, userID, loanID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		return nil, err
+	}
+	return s.status(ctx, l)
+}
+
+func (s *loanService) get(ctx context.Context, loanID, userID string) (*model.Loan, error) {
+	l, err := s.repo.GetByID(ctx, loanID, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrLoanNotFound) {
+			return nil, fmt.Errorf("займ не найден: %w", err)
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *loanService) status(ctx context.Context, l *model.Loan) (*model.LoanStatus, error) {
+	statuses, err := loanStatuses(ctx, s.repo, s.rates, []*model.Loan{l})
+	if err != nil {
+		return nil, err
+	}
+	return statuses[0], nil
+}
+
+// Выданный займ гасится доходом, полученный — расходом
+func checkRepayment(l *model.Loan, tx *model.Transaction) error {
+	if want := l.Direction.RepaymentType(); tx.Type != want {
+		return fmt.Errorf("%w: repayment of a %s loan must be %s", ErrInvalidLoan, l.Direction, want)
+	}
+	return nil
+}
+
+// Считает состояние займов; погашения в других валютах пересчитываются в валюту займа
+func loanStatuses(ctx context.Context, loans repo.LoanRepository, rates currency.RateProvider, list []*model.Loan) ([]*model.LoanStatus, error) {
+	result := make([]*model.LoanStatus, 0, len(list))
+	if len(list) == 0 {
+		return result, nil
+	}
+
+	ids := make([]string, len(list))
+	for i, l := range list {
+		ids[i] = l.ID
+	}
+	repayments, err := loans.Repayments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range list {
+		var paid model.Money
+		for _, r := range repayments {
+			if r.LoanID != l.ID {
+				continue
+			}
+			v, err := currency.Convert(ctx, rates, r.Amount, r.Currency, l.Currency)
+			if err != nil {
+				return nil, err
+			}
+			paid += v
+		}
+		result = append(result, model.NewLoanStatus(l, paid))
+	}
+	return result, nil
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"go.uber.org/zap"
+)
+
+// Шаблон повторяющейся транзакции не прошёл валидацию
+var ErrInvalidRecurring = errors.New("ошибка валидации повторяющейся транзакции")
+
+// Интерфейс бизнес-логики повторяющихся транзакций
+type RecurringService interface {
+	CreateRecurring(ctx context.Context, r *model.RecurringTransaction) (*model.RecurringTransaction, error)
+	ListRecurring(ctx context.Context, userID string) ([]*model.RecurringTransaction, error)
+	SetPaused(ctx context.Context, id, userID string, paused bool) (*model.RecurringTransaction, error)
+	SkipNext(ctx context.Context, id, userID string) (*model.RecurringTransaction, error)
+	DeleteRecurring(ctx context.Context, id, userID string) error
+	// Создаёт транзакции по всем повторениям, наступившим к now, возвращает число созданных
+	MaterializeDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// Реализует бизнес-логику повторяющихся транзакций
+type recurringService struct {
+	repo         repo.RecurringRepository
+	categories   repo.CategoryRepository
+	transactions TransactionService
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// Создаёт новый экземпляр сервиса повторяющихся транзакций.
+// Транзакции создаются через TransactionService со всеми его проверками
+func NewRecurringService(r repo.RecurringRepository, categories repo.CategoryRepository, transactions TransactionService, logger *zap.Logger) RecurringService {
+	return &recurringService{
+		repo:         r,
+		categories:   categories,
+		transactions: transactions,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+func (s *recurringService) CreateRecurring(ctx context.Context, r *model.RecurringTransaction) (*model.RecurringTransaction, error) {
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+	r.StartDate = model.GranularityDay.Truncate(r.StartDate)
+	if r.EndDate != nil {
+		end := model.GranularityDay.Truncate(*r.EndDate)
+		r.EndDate = &end
+	}
+	if err := r.Validate(); err != nil {
+		s.logger.Error("invalid recurring transaction", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRecurring, err)
+	}
+	if err := checkCategory(ctx, s.categories, r.TransactionFor(r.StartDate)); err != nil {
+		s.logger.Error("invalid recurring transaction category", zap.String("category_id", r.CategoryID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("adding recurring transaction", zap.String("user_id", r.UserID), zap.String("frequency", string(r.Frequency)))
+	r.ID = uuid.New().String()
+	r.Occurrences = 0
+	r.NextDate = r.Occurrence(0)
+	r.Paused = false
+	r.CreatedAt = s.now()
+	r.UpdatedAt = r.CreatedAt
+	if err := s.repo.Create(ctx, r); err != nil {
+		s.logger.Error("failed to add recurring transaction", zap.Error(err))
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *recurringService) ListRecurring(ctx context.Context, userID string) ([]*model.RecurringTransaction, error) {
+	s.logger.Info("listing recurring transactions", zap.String("user_id", userID))
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Ставит шаблон на паузу или снимает с неё. Повторения, выпавшие на паузу,
+// при возобновлении не создаются задним числом, а считаются пропущенными
+func (s *recurringService) SetPaused(ctx context.Context, id, userID string, paused bool) (*model.RecurringTransaction, error) {
+	s.logger.Info("pausing recurring transaction", zap.String("recurring_id", id), zap.Bool("paused", paused))
+	r, err := s.get(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	prev := r.Occurrences
+	r.Paused = paused
+	if !paused {
+		today := model.GranularityDay.Truncate(s.now())
+		for !r.Finished() && r.NextDate.Before(today) {
+			r.Advance()
+		}
+	}
+	if err := s.save(ctx, r, prev); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Пропускает ближайшее повторение
+func (s *recurringService) SkipNext(ctx context.Context, id, userID string) (*model.RecurringTransaction, error) {
+	s.logger.Info("skipping recurring occurrence", zap.String("recurring_id", id), zap.String("user_id", userID))
+	r, err := s.get(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Finished() {
+		return nil, fmt.Errorf("%w: no occurrences left", ErrInvalidRecurring)
+	}
+	prev := r.Occurrences
+	r.Advance()
+	if err := s.save(ctx, r, prev); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *recurringService) DeleteRecurring(ctx context.Context, id, userID string) error {
+	s.logger.Info("deleting recurring transaction", zap.String("recurring_id", id), zap.String("user_id", userID))
+	err := s.repo.Delete(ctx, id, userID)
+	if errors.Is(err, repo.ErrRecurringNotFound) {
+		return fmt.Errorf("шаблон не найден: %w", err)
+	}
+	return err
+}
+
+func (s *recurringService) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	today := model.GranularityDay.Truncate(now)
+	due, err := s.repo.ListDue(ctx, today)
+	if err != nil {
+		s.logger.Error("failed to list due recurring transactions", zap.Error(err))
+		return 0, err
+	}
+
+	created := 0
+	for _, r := range due {
+		n, err := s.materialize(ctx, r, today)
+		created += n
+		if err != nil {
+			// Шаблон будет обработан при следующем запуске
+			s.logger.Error("failed to materialize recurring transaction", zap.String("recurring_id", r.ID), zap.Error(err))
+		}
+	}
+	if created > 0 {
+		s.logger.Info("recurring transactions materialized", zap.Int("created", created))
+	}
+	return created, nil
+}
+
+// Создаёт транзакции по шаблону до сегодняшней даты включительно.
+// Повторный запуск безопасен: уже созданное повторение отклоняется уникальным
+// индексом (recurring_id, date), и шаблон просто сдвигается дальше
+func (s *recurringService) materialize(ctx context.Context, r *model.RecurringTransaction, today time.Time) (int, error) {
+	created := 0
+	for !r.Finished() && !r.NextDate.After(today) {
+		_, err := s.transactions.AddTransaction(ctx, r.TransactionFor(r.NextDate))
+		switch {
+		case err == nil:
+			created++
+		case errors.Is(err, repo.ErrAlreadyExists):
+			s.logger.Info("recurring occurrence already exists", zap.String("recurring_id", r.ID), zap.Time("date", r.NextDate))
+		case isPermanentAddError(err):
+			// Категорию архивировали или удалили — без вмешательства пользователя не исправить
+			s.logger.Warn("pausing recurring transaction", zap.String("recurring_id", r.ID), zap.Error(err))
+			prev := r.Occurrences
+			r.Paused = true
+			return created, s.save(ctx, r, prev)
+		default:
+			return created, err
+		}
+
+		prev := r.Occurrences
+		r.Advance()
+		if err := s.save(ctx, r, prev); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+func isPermanentAddError(err error) bool {
+	return errors.Is(err, ErrInvalidTransaction) ||
+		errors.Is(err, ErrCategoryArchived) ||
+		errors.Is(err, ErrCategoryForbidden) ||
+		errors.Is(err, ErrCategoryTypeMismatch) ||
+		errors.Is(err, repo.ErrCategoryNotFound)
+}
+
+func (s *recurringService) get(ctx context.Context, id, userID string) (*model.RecurringTransaction, error) {
+	r, err := s.repo.GetByID(ctx, id, userID)
+	if errors.Is(err, repo.ErrRecurringNotFound) {
+		return nil, fmt.Errorf("шаблон не найден: %w", err)
+	}
+	return r, err
+}
+
+func (s *recurringService) save(ctx context.Context, r *model.RecurringTransaction, prevOccurrences int) error {
+	r.UpdatedAt = s.now()
+	err := s.repo.UpdateState(ctx, r, prevOccurrences)
+	if err != nil {
+		s.logger.Error("failed to update recurring transaction", zap.String("recurring_id", r.ID), zap.Error(err))
+	}
+	return err
+}
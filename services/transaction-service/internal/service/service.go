@@ -2,45 +2,89 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/export"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
 	"go.uber.org/zap"
 )
 
+var (
+	// Транзакция не прошла валидацию
+	ErrInvalidTransaction = errors.New("ошибка валидации транзакции")
+	// Некорректный фильтр списка транзакций
+	ErrInvalidFilter = errors.New("некорректный фильтр")
+)
+
 // Интерфейс бизнес-логики транзакций
 type TransactionService interface {
 	AddTransaction(ctx context.Context, tx *model.Transaction) (string, error)
-	ListTransactions(ctx context.Context, userID string, limit, offset int) ([]*model.Transaction, error)
+	ListTransactions(ctx context.Context, filter model.TransactionFilter) (*model.TransactionPage, error)
+	UpdateTransaction(ctx context.Context, transactionID, userID string, patch *model.TransactionPatch) (*model.Transaction, error)
 	DeleteTransaction(ctx context.Context, transactionID, userID string) error
-	GetBalance(ctx context.Context, userID string) (income, expense, balance float64, err error)
+	ImportTransactions(ctx context.Context, userID string, data []byte, opts model.ImportOptions) (*model.ImportReport, error)
+	ExportTransactions(ctx context.Context, filter model.TransactionFilter, format export.Format, w io.Writer) error
 }
 
 // Реализует бизнес-логику транзакций
 type transactionService struct {
-	repo   repo.TransactionRepository
-	logger *zap.Logger
-	now    func() time.Time
+	repo       repo.TransactionRepository
+	categories repo.CategoryRepository
+	households repo.HouseholdRepository
+	accounts   repo.AccountRepository
+	logger     *zap.Logger
+	now        func() time.Time
 }
 
 // Создаёт новый экземпляр сервиса
-func NewTransactionService(r repo.TransactionRepository, logger *zap.Logger) TransactionService {
+func NewTransactionService(r repo.TransactionRepository, categories repo.CategoryRepository, households repo.HouseholdRepository, accounts repo.AccountRepository, logger *zap.Logger) TransactionService {
 	return &transactionService{
-		repo:   r,
-		logger: logger,
-		now:    time.Now,
+		repo:       r,
+		categories: categories,
+		households: households,
+		accounts:   accounts,
+		logger:     logger,
+		now:        time.Now,
 	}
 }
 
+// Добавляет доход, расход или перевод между своими счетами. Перевод — одна
+// запись, поэтому списание и зачисление сохраняются атомарно
 func (s *transactionService) AddTransaction(ctx context.Context, tx *model.Transaction) (string, error) {
+	// Без валюты транзакция берёт валюту указанного счёта
+	if tx.Currency == "" && tx.AccountID != "" {
+		account, err := checkAccount(ctx, s.accounts, tx.AccountID, tx.UserID)
+		if err != nil {
+			return "", err
+		}
+		tx.Currency = account.Currency
+	}
 	if err := tx.Validate(); err != nil {
 		s.logger.Error("invalid transaction", zap.Error(err))
-		return "", fmt.Errorf("ошибка валидации транзакции: %w", err)
+		return "", fmt.Errorf("%w: %w", ErrInvalidTransaction, err)
 	}
-	s.logger.Info("adding model", zap.String("user_id", tx.UserID), zap.Float64("amount", tx.Amount), zap.String("type", string(tx.Type)))
+	if tx.Type != model.Transfer {
+		if err := checkCategory(ctx, s.categories, tx); err != nil {
+			s.logger.Error("invalid transaction category", zap.String("category_id", tx.CategoryID), zap.Error(err))
+			return "", err
+		}
+	}
+	if tx.HouseholdID != "" {
+		if _, err := checkHouseholdAccess(ctx, s.households, tx.HouseholdID, tx.UserID, true); err != nil {
+			s.logger.Warn("household transaction denied", zap.String("household_id", tx.HouseholdID), zap.String("user_id", tx.UserID), zap.Error(err))
+			return "", err
+		}
+	}
+	if err := attachAccounts(ctx, s.accounts, tx, s.now()); err != nil {
+		s.logger.Error("invalid transaction account", zap.String("account_id", tx.AccountID), zap.Error(err))
+		return "", err
+	}
+	s.logger.Info("adding model", zap.String("user_id", tx.UserID), zap.Stringer("amount", tx.Amount), zap.String("type", string(tx.Type)))
 	tx.ID = uuid.New().String()
 	tx.CreatedAt = s.now()
 	err := s.repo.Create(ctx, tx)
@@ -50,38 +94,109 @@ func (s *transactionService) AddTransaction(ctx context.Context, tx *model.Trans
 	return tx.ID, err
 }
 
-func (s *transactionService) ListTransactions(ctx context.Context, userID string, limit, offset int) ([]*model.Transaction, error) {
-	s.logger.Info("listing transactions", zap.String("user_id", userID), zap.Int("limit", limit), zap.Int("offset", offset))
-	txs, err := s.repo.ListByUserID(ctx, userID, limit, offset)
+// Возвращает страницу транзакций. Страницы можно листать как по offset,
+// так и по курсору из NextPageToken. С HouseholdID возвращается лента общего
+// бюджета: транзакции всех участников, автор каждой — в UserID
+func (s *transactionService) ListTransactions(ctx context.Context, filter model.TransactionFilter) (*model.TransactionPage, error) {
+	filter.Normalize()
+	s.logger.Info("listing transactions", zap.String("user_id", filter.UserID), zap.Int("limit", filter.Limit), zap.Int("offset", filter.Offset))
+	if filter.PageToken != "" {
+		cursor, err := model.DecodeCursor(filter.PageToken)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+		}
+		filter.Cursor = cursor
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+	if filter.HouseholdID != "" {
+		if _, err := checkHouseholdAccess(ctx, s.households, filter.HouseholdID, filter.UserID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Запрашиваем на одну запись больше, чтобы узнать, есть ли следующая страница
+	limit := filter.Limit
+	filter.Limit++
+	txs, total, err := s.repo.List(ctx, filter)
 	if err != nil {
 		if err == repo.ErrNotFound {
 			return nil, fmt.Errorf("транзакция не найдена: %w", err)
 		}
 		return nil, err
 	}
-	return txs, nil
+
+	page := &model.TransactionPage{Transactions: txs, TotalCount: total}
+	if len(txs) > limit {
+		page.Transactions = txs[:limit]
+		page.NextPageToken = model.CursorAfter(txs[limit-1], filter.SortBy, filter.Ascending).Encode()
+	}
+	return page, nil
 }
 
-func (s *transactionService) DeleteTransaction(ctx context.Context, transactionID, userID string) error {
-	s.logger.Info("deleting model", zap.String("transaction_id", transactionID), zap.String("user_id", userID))
-	err := s.repo.Delete(ctx, transactionID, userID)
+// Частично обновляет транзакцию и заново проверяет её целиком.
+// Транзакцию общего бюджета меняет только автор, пока у него есть право на изменения.
+// Перевод не изменяется: его удаляют и создают заново
+func (s *transactionService) UpdateTransaction(ctx context.Context, transactionID, userID string, patch *model.TransactionPatch) (*model.Transaction, error) {
+	s.logger.Info("updating model", zap.String("transaction_id", transactionID), zap.String("user_id", userID))
+	if patch.IsEmpty() {
+		return nil, fmt.Errorf("%w: nothing to update", ErrInvalidTransaction)
+	}
+	tx, err := s.repo.GetByID(ctx, transactionID, userID)
 	if err != nil {
 		if err == repo.ErrNotFound {
-			return fmt.Errorf("транзакция не найдена: %w", err)
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
 		}
-		s.logger.Error("failed to delete model", zap.Error(err))
+		s.logger.Error("failed to get model", zap.Error(err))
+		return nil, err
 	}
-	return err
+	if tx.HouseholdID != "" {
+		if _, err := checkHouseholdAccess(ctx, s.households, tx.HouseholdID, userID, true); err != nil {
+			return nil, err
+		}
+	}
+	if tx.Type == model.Transfer {
+		return nil, fmt.Errorf("%w: transfer cannot be updated", ErrInvalidTransaction)
+	}
+
+	patch.Apply(tx)
+	if err := tx.Validate(); err != nil {
+		s.logger.Error("invalid transaction", zap.Error(err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTransaction, err)
+	}
+	if patch.TouchesCategory() {
+		if err := checkCategory(ctx, s.categories, tx); err != nil {
+			s.logger.Error("invalid transaction category", zap.String("category_id", tx.CategoryID), zap.Error(err))
+			return nil, err
+		}
+	}
+	if patch.AccountID != nil || patch.Currency != nil {
+		if err := attachAccounts(ctx, s.accounts, tx, s.now()); err != nil {
+			s.logger.Error("invalid transaction account", zap.String("account_id", tx.AccountID), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	tx.UpdatedAt = s.now()
+	if err := s.repo.Update(ctx, tx); err != nil {
+		if err == repo.ErrNotFound {
+			return nil, fmt.Errorf("транзакция не найдена: %w", err)
+		}
+		s.logger.Error("failed to update model", zap.Error(err))
+		return nil, err
+	}
+	return tx, nil
 }
 
-func (s *transactionService) GetBalance(ctx context.Context, userID string) (float64, float64, float64, error) {
-	s.logger.Info("getting balance", zap.String("user_id", userID))
-	income, expense, balance, err := s.repo.GetBalance(ctx, userID)
+func (s *transactionService) DeleteTransaction(ctx context.Context, transactionID, userID string) error {
+	s.logger.Info("deleting model", zap.String("transaction_id", transactionID), zap.String("user_id", userID))
+	err := s.repo.Delete(ctx, transactionID, userID)
 	if err != nil {
 		if err == repo.ErrNotFound {
-			return 0, 0, 0, fmt.Errorf("транзакция не найдена: %w", err)
+			return fmt.Errorf("транзакция не найдена: %w", err)
 		}
-		s.logger.Error("failed to get balance", zap.Error(err))
+		s.logger.Error("failed to delete model", zap.Error(err))
 	}
-	return income, expense, balance, err
+	return err
 }
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestAccountRepo(t *testing.T) (repo.AccountRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewAccountRepo(db, zap.NewNop()), mock
+}
+
+var accountColumns = []string{"id", "user_id", "name", "type", "currency", "opening_balance", "is_default", "archived", "created_at"}
+
+func TestEnsureDefault_CreatesOnce(t *testing.T) {
+	r, mock := newTestAccountRepo(t)
+	now := time.Now()
+	selectDefault := regexp.QuoteMeta(`FROM accounts WHERE user_id = $1 AND currency = $2 AND is_default`)
+	mock.ExpectQuery(selectDefault).WithArgs("user-1", "USD").WillReturnRows(sqlmock.NewRows(accountColumns))
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (user_id, currency) WHERE is_default DO NOTHING`)).
+		WithArgs(sqlmock.AnyArg(), "user-1", model.DefaultAccountName, model.AccountCash, "USD", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectDefault).WithArgs("user-1", "USD").
+		WillReturnRows(sqlmock.NewRows(accountColumns).AddRow("acc-1", "user-1", model.DefaultAccountName, "CASH", "USD", "0", true, false, now))
+
+	a, err := r.EnsureDefault(context.Background(), "user-1", "USD", now)
+	require.NoError(t, err)
+	require.Equal(t, "acc-1", a.ID)
+	require.True(t, a.IsDefault)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListBalances(t *testing.T) {
+	r, mock := newTestAccountRepo(t)
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(`LEFT JOIN transactions t ON t.account_id = a.id OR t.transfer_account_id = a.id`)).
+		WithArgs("user-1", false).
+		WillReturnRows(sqlmock.NewRows(append(accountColumns, "balance")).
+			AddRow("card", "user-1", "Карта", "CARD", "RUB", "1000.00", false, false, now, "250.50"))
+
+	accounts, err := r.ListBalances(context.Background(), "user-1", false)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, model.AccountCard, accounts[0].Type)
+	require.Equal(t, model.Money(100000), accounts[0].OpeningBalance)
+	require.Equal(t, model.Money(25050), accounts[0].Balance)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestList_AccountFilterIncludesIncomingTransfers(t *testing.T) {
+	r, mock := newTestRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM transactions WHERE user_id = $1 AND (account_id = $2 OR transfer_account_id = $2) AND household_id IS NULL`)).
+		WithArgs("user-1", "savings").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`COALESCE(transfer_account_id::text, '') AS transfer_account_id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "account_id", "transfer_account_id", "transfer_amount"}).AddRow("tx-1", "TRANSFER", "card", "savings", "500.00"))
+
+	txs, _, err := r.List(context.Background(), model.TransactionFilter{UserID: "user-1", AccountID: "savings", SortBy: model.SortByDate, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, model.Transfer, txs[0].Type)
+	require.Equal(t, model.Money(50000), txs[0].TransferAmount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
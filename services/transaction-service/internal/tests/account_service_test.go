@@ -0,0 +1,208 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+// Счета без ожиданий, кроме основных: "default-<валюта>" для любого пользователя
+func defaultAccounts(ctrl *gomock.Controller) *MockAccountRepository {
+	accounts := NewMockAccountRepository(ctrl)
+	accounts.EXPECT().EnsureDefault(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, userID, currency string, _ time.Time) (*model.Account, error) {
+			return &model.Account{ID: "default-" + currency, UserID: userID, Currency: currency, IsDefault: true}, nil
+		}).AnyTimes()
+	return accounts
+}
+
+// Счета user-1: карта и накопительный в рублях, валютный в долларах
+func expectUserAccounts(accounts *MockAccountRepository) {
+	for _, a := range []*model.Account{
+		{ID: "card", UserID: "user-1", Type: model.AccountCard, Currency: "RUB"},
+		{ID: "savings", UserID: "user-1", Type: model.AccountSavings, Currency: "RUB"},
+		{ID: "usd", UserID: "user-1", Type: model.AccountSavings, Currency: "USD"},
+		{ID: "old", UserID: "user-1", Type: model.AccountCash, Currency: "RUB", Archived: true},
+		{ID: "foreign", UserID: "user-2", Type: model.AccountCard, Currency: "RUB"},
+	} {
+		accounts.EXPECT().GetByID(gomock.Any(), a.ID).Return(a, nil).AnyTimes()
+	}
+}
+
+func TestAddTransaction_DefaultAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), zap.NewNop())
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", Type: model.Expense}, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) error {
+		require.Equal(t, "default-USD", tx.AccountID)
+		return nil
+	})
+	_, err := s.AddTransaction(context.Background(), &model.Transaction{UserID: "user-1", CategoryID: "cat-1", Type: model.Expense, Amount: 100, Currency: "usd", Date: time.Now()})
+	require.NoError(t, err)
+}
+
+func TestAddTransaction_AccountChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	mockAccounts := NewMockAccountRepository(ctrl)
+	expectUserAccounts(mockAccounts)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, mockAccounts, zap.NewNop())
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", Type: model.Expense}, nil).AnyTimes()
+	ctx := context.Background()
+
+	newTx := func(accountID, currency string) *model.Transaction {
+		return &model.Transaction{UserID: "user-1", AccountID: accountID, CategoryID: "cat-1", Type: model.Expense, Amount: 100, Currency: currency, Date: time.Now()}
+	}
+
+	_, err := s.AddTransaction(ctx, newTx("foreign", ""))
+	require.ErrorIs(t, err, service.ErrAccountForbidden)
+	_, err = s.AddTransaction(ctx, newTx("old", ""))
+	require.ErrorIs(t, err, service.ErrAccountArchived)
+	_, err = s.AddTransaction(ctx, newTx("usd", "RUB"))
+	require.ErrorIs(t, err, service.ErrAccountCurrencyMismatch)
+
+	// Без валюты транзакция берёт валюту счёта
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) error {
+		require.Equal(t, "usd", tx.AccountID)
+		require.Equal(t, "USD", tx.Currency)
+		return nil
+	})
+	_, err = s.AddTransaction(ctx, newTx("usd", ""))
+	require.NoError(t, err)
+}
+
+func TestAddTransaction_Transfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockAccounts := NewMockAccountRepository(ctrl)
+	expectUserAccounts(mockAccounts)
+	// Категория у перевода не проверяется
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), nil, mockAccounts, zap.NewNop())
+	ctx := context.Background()
+
+	newTransfer := func(from, to string, amount, credited model.Money) *model.Transaction {
+		return &model.Transaction{UserID: "user-1", Type: model.Transfer, AccountID: from, TransferAccountID: to, Amount: amount, TransferAmount: credited, Date: time.Now()}
+	}
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) error {
+		require.Equal(t, "card", tx.AccountID)
+		require.Equal(t, "savings", tx.TransferAccountID)
+		require.Equal(t, model.Money(500000), tx.TransferAmount)
+		require.Empty(t, tx.CategoryID)
+		return nil
+	})
+	_, err := s.AddTransaction(ctx, newTransfer("card", "savings", 500000, 0))
+	require.NoError(t, err)
+
+	// Между валютами сумма зачисления обязательна
+	_, err = s.AddTransaction(ctx, newTransfer("card", "usd", 900000, 0))
+	require.ErrorIs(t, err, service.ErrInvalidTransaction)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) error {
+		require.Equal(t, "RUB", tx.Currency)
+		require.Equal(t, model.Money(10000), tx.TransferAmount)
+		return nil
+	})
+	_, err = s.AddTransaction(ctx, newTransfer("card", "usd", 900000, 10000))
+	require.NoError(t, err)
+
+	_, err = s.AddTransaction(ctx, newTransfer("card", "card", 100, 0))
+	require.ErrorIs(t, err, service.ErrInvalidTransaction)
+	_, err = s.AddTransaction(ctx, newTransfer("card", "foreign", 100, 0))
+	require.ErrorIs(t, err, service.ErrAccountForbidden)
+	withCategory := newTransfer("card", "savings", 100, 0)
+	withCategory.CategoryID = "cat-1"
+	_, err = s.AddTransaction(ctx, withCategory)
+	require.ErrorIs(t, err, service.ErrInvalidTransaction)
+}
+
+func TestUpdateTransaction_TransferRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), nil, defaultAccounts(ctrl), zap.NewNop())
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "user-1").
+		Return(&model.Transaction{ID: "tx-1", UserID: "user-1", Type: model.Transfer, AccountID: "card", TransferAccountID: "savings", Amount: 100, TransferAmount: 100}, nil)
+	amount := model.Money(200)
+	_, err := s.UpdateTransaction(context.Background(), "tx-1", "user-1", &model.TransactionPatch{Amount: &amount})
+	require.ErrorIs(t, err, service.ErrInvalidTransaction)
+}
+
+func TestUpdateTransaction_MoveToAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockAccounts := NewMockAccountRepository(ctrl)
+	expectUserAccounts(mockAccounts)
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), nil, mockAccounts, zap.NewNop())
+	ctx := context.Background()
+
+	existing := func() *model.Transaction {
+		return &model.Transaction{ID: "tx-1", UserID: "user-1", AccountID: "card", CategoryID: "cat-1", Type: model.Expense, Amount: 100, Currency: "RUB", Date: time.Now()}
+	}
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "user-1").DoAndReturn(func(context.Context, string, string) (*model.Transaction, error) {
+		return existing(), nil
+	}).Times(2)
+
+	usd := "usd"
+	_, err := s.UpdateTransaction(ctx, "tx-1", "user-1", &model.TransactionPatch{AccountID: &usd})
+	require.ErrorIs(t, err, service.ErrAccountCurrencyMismatch)
+
+	savings := "savings"
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	tx, err := s.UpdateTransaction(ctx, "tx-1", "user-1", &model.TransactionPatch{AccountID: &savings})
+	require.NoError(t, err)
+	require.Equal(t, "savings", tx.AccountID)
+}
+
+func TestArchiveAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAccounts := NewMockAccountRepository(ctrl)
+	expectUserAccounts(mockAccounts)
+	mockAccounts.EXPECT().GetByID(gomock.Any(), "default").Return(&model.Account{ID: "default", UserID: "user-1", IsDefault: true}, nil)
+	s := service.NewAccountService(mockAccounts, zap.NewNop())
+	ctx := context.Background()
+
+	require.ErrorIs(t, s.ArchiveAccount(ctx, "default", "user-1"), service.ErrInvalidAccount)
+	require.ErrorIs(t, s.ArchiveAccount(ctx, "foreign", "user-1"), repo.ErrAccountNotFound)
+
+	mockAccounts.EXPECT().Archive(ctx, "savings", "user-1").Return(nil)
+	require.NoError(t, s.ArchiveAccount(ctx, "savings", "user-1"))
+}
+
+func TestCreateAccount_Validation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAccounts := NewMockAccountRepository(ctrl)
+	s := service.NewAccountService(mockAccounts, zap.NewNop())
+	ctx := context.Background()
+
+	_, err := s.CreateAccount(ctx, &model.Account{UserID: "user-1", Name: " "})
+	require.ErrorIs(t, err, service.ErrInvalidAccount)
+	_, err = s.CreateAccount(ctx, &model.Account{UserID: "user-1", Name: "Вклад", Currency: "GBP"})
+	require.ErrorIs(t, err, service.ErrInvalidAccount)
+
+	mockAccounts.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	a, err := s.CreateAccount(ctx, &model.Account{UserID: "user-1", Name: " Кредитка ", Type: model.AccountCredit, OpeningBalance: -1500000})
+	require.NoError(t, err)
+	require.NotEmpty(t, a.ID)
+	require.Equal(t, "Кредитка", a.Name)
+	require.Equal(t, "RUB", a.Currency)
+	require.Equal(t, model.Money(-1500000), a.Balance)
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+// Валюта профиля без обращения к user-service
+type stubProfiles struct {
+	currency string
+	err      error
+}
+
+func (p stubProfiles) ProfileCurrency(context.Context, string) (string, error) {
+	return p.currency, p.err
+}
+
+func newTestRates(t *testing.T) currency.RateProvider {
+	rates, err := currency.NewStaticProvider("RUB", map[string]string{"USD": "90", "EUR": "100"})
+	require.NoError(t, err)
+	return rates
+}
+
+func TestGetBalance_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, newTestRates(t), stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	userID := "user-1"
+	mockRepo.EXPECT().GetBalance(gomock.Any(), userID).Return([]model.CurrencyBalance{
+		{Currency: "RUB", Income: 100000, Expense: 50000},
+	}, nil)
+	report, err := s.GetBalance(context.Background(), userID, "")
+	require.NoError(t, err)
+	require.Equal(t, "RUB", report.Currency)
+	require.Equal(t, model.Money(100000), report.Income)
+	require.Equal(t, model.Money(50000), report.Expense)
+	require.Equal(t, model.Money(50000), report.Balance())
+}
+
+func TestGetBalance_ConvertsToProfileCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, newTestRates(t), stubProfiles{currency: "usd"}, zap.NewNop())
+
+	balances := []model.CurrencyBalance{
+		{Currency: "EUR", Income: 1000, Expense: 0},     // 10 EUR = 1000 RUB = 11.11 USD
+		{Currency: "RUB", Income: 9000, Expense: 18000}, // 90 / 180 RUB = 1 / 2 USD
+		{Currency: "USD", Income: 500, Expense: 125},
+	}
+	mockRepo.EXPECT().GetBalance(gomock.Any(), "user-1").Return(balances, nil)
+	report, err := s.GetBalance(context.Background(), "user-1", "")
+	require.NoError(t, err)
+	require.Equal(t, "USD", report.Currency)
+	require.Equal(t, "17.11", report.Income.String())
+	require.Equal(t, "3.25", report.Expense.String())
+	require.Equal(t, "13.86", report.Balance().String())
+	require.Equal(t, balances, report.ByCurrency)
+}
+
+func TestGetBalance_MissingRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	rates, err := currency.NewStaticProvider("RUB", nil)
+	require.NoError(t, err)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, rates, stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	mockRepo.EXPECT().GetBalance(gomock.Any(), "user-1").Return([]model.CurrencyBalance{{Currency: "USD", Income: 100}}, nil)
+	_, err = s.GetBalance(context.Background(), "user-1", "")
+	require.ErrorIs(t, err, currency.ErrRateNotFound)
+}
+
+func TestGetBalance_ProfileUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, newTestRates(t), stubProfiles{err: errors.New("connection refused")}, zap.NewNop())
+
+	mockRepo.EXPECT().GetBalance(gomock.Any(), "user-1").Return(nil, nil)
+	_, err := s.GetBalance(context.Background(), "user-1", "")
+	require.ErrorIs(t, err, service.ErrProfileUnavailable)
+}
+
+func TestGetReport_BucketsAndBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, newTestRates(t), stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	from := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetBalanceBefore(gomock.Any(), "user-1", from).
+		Return([]model.CurrencyBalance{{Currency: "RUB", Income: 100000, Expense: 40000}}, nil)
+	mockRepo.EXPECT().Report(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, f model.ReportFilter) ([]model.ReportRow, error) {
+			require.Equal(t, model.GranularityMonth, f.Granularity)
+			return []model.ReportRow{
+				{Period: jan, Currency: "RUB", CategoryID: "salary", Income: 50000},
+				{Period: jan, Currency: "USD", CategoryID: "food", Expense: 1000}, // 10 USD = 900 RUB
+				{Period: mar, Currency: "RUB", CategoryID: "food", Expense: 20000},
+			}, nil
+		})
+
+	report, err := s.GetReport(context.Background(), model.ReportFilter{
+		UserID: "user-1", DateFrom: from, DateTo: to, ByCategory: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "RUB", report.Currency)
+	require.Len(t, report.Buckets, 3)
+
+	b := report.Buckets[0]
+	require.Equal(t, from, b.Start) // обрезан по началу запроса
+	require.Equal(t, "600.00", b.Opening.String())
+	require.Equal(t, "500.00", b.Income.String())
+	require.Equal(t, "900.00", b.Expense.String())
+	require.Equal(t, "200.00", b.Closing.String())
+	require.Len(t, b.Categories, 2)
+
+	require.Zero(t, report.Buckets[1].Net()) // февраль без операций
+	require.Equal(t, "200.00", report.Buckets[1].Opening.String())
+	require.Equal(t, "200.00", report.Buckets[1].Closing.String())
+
+	last := report.Buckets[2]
+	require.Equal(t, to.AddDate(0, 0, 1), last.End)
+	require.Equal(t, "0.00", last.Closing.String())
+}
+
+func TestGetReport_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewBalanceService(mockRepo, nil, nil, nil, newTestRates(t), stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := s.GetReport(context.Background(), model.ReportFilter{
+		UserID: "user-1", DateFrom: from, DateTo: from.AddDate(10, 0, 0), Granularity: model.GranularityDay,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
+
+	_, err = s.GetReport(context.Background(), model.ReportFilter{UserID: "user-1", DateFrom: from, DateTo: from.AddDate(0, 0, -1)})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
+}
+
+func TestGranularity_TruncateWeek(t *testing.T) {
+	sunday := time.Date(2025, 3, 16, 15, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), model.GranularityWeek.Truncate(sunday))
+	require.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), model.GranularityYear.Truncate(sunday))
+}
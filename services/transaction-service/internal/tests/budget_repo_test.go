@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestBudgetRepo(t *testing.T) (repo.BudgetRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewBudgetRepo(db, zap.NewNop()), mock
+}
+
+func TestBudgetUpsert_KeepsExistingID(t *testing.T) {
+	r, mock := newTestBudgetRepo(t)
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &model.Budget{ID: "new-id", UserID: "user-1", CategoryID: "cat-1", Limit: 1500000, Currency: "RUB", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	mock.ExpectQuery(regexp.QuoteMeta(`ON CONFLICT (user_id, category_id)`)).
+		WithArgs(b.ID, b.UserID, b.CategoryID, b.Limit, b.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow("old-id", created))
+
+	err := r.Upsert(context.Background(), b)
+	require.NoError(t, err)
+	require.Equal(t, "old-id", b.ID)
+	require.Equal(t, created, b.CreatedAt)
+}
+
+func TestBudgetDelete_NotFound(t *testing.T) {
+	r, mock := newTestBudgetRepo(t)
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM budgets`)).
+		WithArgs("b-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := r.Delete(context.Background(), "b-1", "user-1")
+	require.ErrorIs(t, err, repo.ErrBudgetNotFound)
+}
+
+func TestSpentByCategory(t *testing.T) {
+	r, mock := newTestBudgetRepo(t)
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE user_id = $1 AND type = 'EXPENSE' AND date >= $2 AND date < $3`)).
+		WithArgs("user-1", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id", "currency", "amount"}).AddRow("cat-1", "RUB", []byte("1250.50")))
+
+	spending, err := r.SpentByCategory(context.Background(), "user-1", from, to)
+	require.NoError(t, err)
+	require.Len(t, spending, 1)
+	require.Equal(t, "1250.50", spending[0].Amount.String())
+}
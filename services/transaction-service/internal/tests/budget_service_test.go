@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func TestSetBudget_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockBudgets := NewMockBudgetRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	s := service.NewBudgetService(mockBudgets, mockCategories, newTestRates(t), zap.NewNop())
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", Type: model.Expense}, nil)
+	mockBudgets.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+
+	b, err := s.SetBudget(context.Background(), &model.Budget{UserID: "user-1", CategoryID: "cat-1", Limit: 1500000, Currency: "usd"})
+	require.NoError(t, err)
+	require.NotEmpty(t, b.ID)
+	require.Equal(t, "USD", b.Currency)
+}
+
+func TestSetBudget_IncomeCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockBudgets := NewMockBudgetRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	s := service.NewBudgetService(mockBudgets, mockCategories, newTestRates(t), zap.NewNop())
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "salary").Return(&model.Category{ID: "salary", Type: model.Income}, nil)
+
+	_, err := s.SetBudget(context.Background(), &model.Budget{UserID: "user-1", CategoryID: "salary", Limit: 100})
+	require.ErrorIs(t, err, service.ErrCategoryTypeMismatch)
+}
+
+func TestSetBudget_InvalidLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := service.NewBudgetService(NewMockBudgetRepository(ctrl), NewMockCategoryRepository(ctrl), newTestRates(t), zap.NewNop())
+
+	_, err := s.SetBudget(context.Background(), &model.Budget{UserID: "user-1", CategoryID: "cat-1", Limit: 0})
+	require.ErrorIs(t, err, service.ErrInvalidBudget)
+}
+
+func TestListBudgets_Overspent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockBudgets := NewMockBudgetRepository(ctrl)
+	s := service.NewBudgetService(mockBudgets, NewMockCategoryRepository(ctrl), newTestRates(t), zap.NewNop())
+
+	month := time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC)
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	budgets := []*model.Budget{
+		{ID: "b-1", CategoryID: "food", Limit: 1000000, Currency: "RUB"},
+		{ID: "b-2", CategoryID: "taxi", Limit: 500000, Currency: "RUB"},
+		{ID: "b-3", CategoryID: "fun", Limit: 100000, Currency: "RUB"},
+	}
+	mockBudgets.EXPECT().ListByUserID(gomock.Any(), "user-1").Return(budgets, nil)
+	mockBudgets.EXPECT().SpentByCategory(gomock.Any(), "user-1", from, from.AddDate(0, 1, 0)).Return([]model.CategorySpending{
+		{CategoryID: "food", Currency: "RUB", Amount: 800000},
+		{CategoryID: "food", Currency: "USD", Amount: 10000}, // 100 USD = 9000 RUB
+		{CategoryID: "taxi", Currency: "RUB", Amount: 120000},
+	}, nil)
+
+	statuses, err := s.ListBudgets(context.Background(), "user-1", month)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+
+	require.Equal(t, "17000.00", statuses[0].Spent.String())
+	require.Equal(t, "-7000.00", statuses[0].Remaining().String())
+	require.True(t, statuses[0].Overspent())
+
+	require.Equal(t, "3800.00", statuses[1].Remaining().String())
+	require.False(t, statuses[1].Overspent())
+
+	require.Zero(t, statuses[2].Spent)
+	require.Equal(t, from, statuses[2].Month)
+}
+
+func TestDeleteBudget_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockBudgets := NewMockBudgetRepository(ctrl)
+	s := service.NewBudgetService(mockBudgets, NewMockCategoryRepository(ctrl), newTestRates(t), zap.NewNop())
+
+	mockBudgets.EXPECT().Delete(gomock.Any(), "b-1", "user-1").Return(repo.ErrBudgetNotFound)
+	err := s.DeleteBudget(context.Background(), "b-1", "user-1")
+	require.ErrorIs(t, err, repo.ErrBudgetNotFound)
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestCategoryRepo(t *testing.T) (repo.CategoryRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewCategoryRepo(db, zap.NewNop()), mock
+}
+
+func TestCategoryCreate_Success(t *testing.T) {
+	r, mock := newTestCategoryRepo(t)
+	c := &model.Category{
+		ID:        "cat-1",
+		UserID:    "user-1",
+		Name:      "Кафе",
+		Type:      model.Expense,
+		CreatedAt: time.Now(),
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO categories`)).
+		WithArgs(c.ID, c.UserID, c.Name, c.Description, c.Type, c.Archived, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := r.Create(context.Background(), c)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCategoryGetByID_NotFound(t *testing.T) {
+	r, mock := newTestCategoryRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	c, err := r.GetByID(context.Background(), "missing")
+	require.ErrorIs(t, err, repo.ErrCategoryNotFound)
+	require.Nil(t, c)
+}
+
+func TestCategoryListByUserID_Success(t *testing.T) {
+	r, mock := newTestCategoryRepo(t)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "description", "type", "archived", "created_at"}).
+		AddRow("cat-sys", "", "Продукты", "", "EXPENSE", false, time.Now()).
+		AddRow("cat-1", "user-1", "Кафе", "", "EXPENSE", false, time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
+		WithArgs("user-1", "EXPENSE", false).
+		WillReturnRows(rows)
+
+	categories, err := r.ListByUserID(context.Background(), "user-1", model.Expense, false)
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	require.True(t, categories[0].IsSystem())
+}
+
+func TestCategoryRename_NotFound(t *testing.T) {
+	r, mock := newTestCategoryRepo(t)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE categories SET name`)).
+		WithArgs("Новое", "cat-sys", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := r.Rename(context.Background(), "cat-sys", "user-1", "Новое")
+	require.ErrorIs(t, err, repo.ErrCategoryNotFound)
+}
+
+func TestCategoryArchive_Success(t *testing.T) {
+	r, mock := newTestCategoryRepo(t)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE categories SET archived = TRUE`)).
+		WithArgs("cat-1", "user-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := r.Archive(context.Background(), "cat-1", "user-1")
+	require.NoError(t, err)
+}
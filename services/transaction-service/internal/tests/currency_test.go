@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+func TestConvert_CrossRateRounding(t *testing.T) {
+	rates, err := currency.NewStaticProvider("RUB", map[string]string{"USD": "92.50", "EUR": "100.10"})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	got, err := currency.Convert(ctx, rates, model.Money(1000), "USD", "RUB") // 10 USD
+	require.NoError(t, err)
+	require.Equal(t, "925.00", got.String())
+
+	got, err = currency.Convert(ctx, rates, model.Money(100), "RUB", "USD") // 1 RUB = 0.0108 USD
+	require.NoError(t, err)
+	require.Equal(t, "0.01", got.String())
+
+	got, err = currency.Convert(ctx, rates, model.Money(-1000), "EUR", "USD") // -10 EUR = -10.8216... USD
+	require.NoError(t, err)
+	require.Equal(t, "-10.82", got.String())
+
+	got, err = currency.Convert(ctx, rates, model.Money(123), "USD", "USD")
+	require.NoError(t, err)
+	require.Equal(t, model.Money(123), got)
+
+	_, err = currency.Convert(ctx, rates, model.Money(100), "GBP", "RUB")
+	require.ErrorIs(t, err, currency.ErrRateNotFound)
+}
+
+func TestNewFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"base":"RUB","rates":{"USD":"90"}}`), 0o600))
+
+	rates, err := currency.NewFileProvider(path)
+	require.NoError(t, err)
+	got, err := currency.Convert(context.Background(), rates, model.Money(100), "USD", "RUB")
+	require.NoError(t, err)
+	require.Equal(t, "90.00", got.String())
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"base":"RUB","rates":{"USD":"-1"}}`), 0o600))
+	_, err = currency.NewFileProvider(path)
+	require.Error(t, err)
+}
+
+func TestNormalizeCurrency(t *testing.T) {
+	code, err := model.NormalizeCurrency(" usd ")
+	require.NoError(t, err)
+	require.Equal(t, "USD", code)
+
+	code, err = model.NormalizeCurrency("")
+	require.NoError(t, err)
+	require.Equal(t, model.DefaultCurrency, code)
+
+	_, err = model.NormalizeCurrency("XYZ")
+	require.Error(t, err)
+}
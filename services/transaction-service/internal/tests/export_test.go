@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/csvimport"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/export"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func exportSample() []*model.Transaction {
+	return []*model.Transaction{
+		{ID: "tx-1", CategoryID: "cat-1", Type: model.Expense, Amount: 12050, Currency: "RUB", Description: "Кафе, ужин", Date: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{ID: "tx-2", CategoryID: "cat-2", Type: model.Income, Amount: 100000, Currency: "USD", Description: "Bonus & <gift>", Date: time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)},
+	}
+}
+
+func encodeAll(t *testing.T, format export.Format, txs []*model.Transaction) string {
+	var buf bytes.Buffer
+	enc, err := export.NewEncoder(format, &buf, export.Meta{UserID: "user-1", GeneratedAt: time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)})
+	require.NoError(t, err)
+	for _, tx := range txs {
+		require.NoError(t, enc.Encode(tx))
+	}
+	require.NoError(t, enc.Close())
+	return buf.String()
+}
+
+func TestExport_CSVRoundTrip(t *testing.T) {
+	out := encodeAll(t, export.FormatCSV, exportSample())
+	require.True(t, strings.HasPrefix(out, "date,type,amount,currency,category_id,description,id\n2024-03-05,EXPENSE,120.50,RUB,cat-1,\"Кафе, ужин\",tx-1\n"))
+
+	// Выгрузку можно загрузить обратно импортом с настройками по умолчанию
+	rows, err := csvimport.Parse([]byte(out), model.ImportOptions{})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, model.Money(12050), rows[0].Transaction.Amount)
+	require.Equal(t, model.Expense, rows[0].Transaction.Type)
+	require.Equal(t, "Кафе, ужин", rows[0].Transaction.Description)
+}
+
+func TestExport_JSON(t *testing.T) {
+	out := encodeAll(t, export.FormatJSON, exportSample())
+	var items []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &items))
+	require.Len(t, items, 2)
+	require.Equal(t, "2024-03-05", items[0]["date"])
+	require.Equal(t, 120.5, items[0]["amount"])
+
+	require.Equal(t, "[]\n", encodeAll(t, export.FormatJSON, nil))
+}
+
+func TestExport_OFX(t *testing.T) {
+	out := encodeAll(t, export.FormatOFX, exportSample())
+	// Две валюты — две выписки
+	require.Equal(t, 2, strings.Count(out, "<STMTRS>"))
+	require.Contains(t, out, "<CURDEF>RUB</CURDEF>")
+	require.Contains(t, out, "<TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240305</DTPOSTED><TRNAMT>-120.50</TRNAMT><FITID>tx-1</FITID>")
+	require.Contains(t, out, "<MEMO>Bonus &amp; &lt;gift&gt;</MEMO>")
+	require.Contains(t, out, "<BALAMT>1000.00</BALAMT>")
+	require.True(t, strings.HasSuffix(out, "</BANKMSGSRSV1>\n</OFX>\n"))
+}
+
+func TestExportTransactions_Service(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), nil, defaultAccounts(ctrl), zap.NewNop())
+
+	mockRepo.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter model.TransactionFilter, fn func(*model.Transaction) error) error {
+			// Пагинация списка при выгрузке не применяется
+			require.Equal(t, 0, filter.Limit)
+			require.Equal(t, model.Expense, filter.Type)
+			for _, tx := range exportSample()[:1] {
+				if err := fn(tx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	var buf bytes.Buffer
+	err := s.ExportTransactions(context.Background(), model.TransactionFilter{UserID: "user-1", Type: model.Expense, Limit: 20, Offset: 40}, export.FormatCSV, &buf)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "tx-1")
+}
+
+func TestExportTransactions_InvalidFormat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := service.NewTransactionService(NewMockTransactionRepository(ctrl), NewMockCategoryRepository(ctrl), nil, defaultAccounts(ctrl), zap.NewNop())
+
+	err := s.ExportTransactions(context.Background(), model.TransactionFilter{UserID: "user-1"}, export.Format("xls"), &bytes.Buffer{})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
+}
+
+func TestExport_Repo(t *testing.T) {
+	r, mock := newTestRepo(t)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "category_id", "type", "amount", "currency", "description", "date", "created_at", "updated_at"}).
+		AddRow("tx-1", "user-1", "cat-1", "EXPENSE", "120.50", "RUB", "Кафе", time.Now(), time.Now(), time.Now()).
+		AddRow("tx-2", "user-1", "cat-1", "EXPENSE", "10.00", "RUB", "", time.Now(), time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM transactions`) + `.*` + regexp.QuoteMeta(`ORDER BY amount ASC, created_at ASC, id ASC`)).
+		WithArgs("user-1", "EXPENSE").
+		WillReturnRows(rows)
+
+	var ids []string
+	err := r.Export(context.Background(), model.TransactionFilter{UserID: "user-1", Type: model.Expense, SortBy: model.SortByAmount, Ascending: true}, func(tx *model.Transaction) error {
+		ids = append(ids, tx.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tx-1", "tx-2"}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
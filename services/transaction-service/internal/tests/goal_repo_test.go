@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestGoalRepo(t *testing.T) (repo.GoalRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewGoalRepo(db, zap.NewNop()), mock
+}
+
+func TestGoalContributions(t *testing.T) {
+	r, mock := newTestGoalRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`LEFT JOIN accounts a ON a.id = t.transfer_account_id`)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"goal_id", "currency", "amount"}).
+			AddRow("vacation", "RUB", "10000.00").
+			AddRow("vacation", "USD", "100.50"))
+
+	contributions, err := r.Contributions(context.Background(), []string{"vacation"})
+	require.NoError(t, err)
+	require.Equal(t, []model.GoalContribution{
+		{GoalID: "vacation", Currency: "RUB", Amount: 1000000},
+		{GoalID: "vacation", Currency: "USD", Amount: 10050},
+	}, contributions)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGoalLinkTransaction_NotFound(t *testing.T) {
+	r, mock := newTestGoalRepo(t)
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE transactions SET goal_id = $3 WHERE id = $1 AND user_id = $2`)).
+		WithArgs("tx-1", "user-2", "vacation").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := r.LinkTransaction(context.Background(), "tx-1", "user-2", "vacation")
+	require.ErrorIs(t, err, repo.ErrNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func TestNewGoalProgress(t *testing.T) {
+	now := time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC)
+	deadline := date(2025, 6, 1)
+	g := &model.Goal{TargetAmount: 15000000, Currency: "RUB", Deadline: &deadline}
+
+	// До 1 июня остаётся четыре полных месяца
+	p := model.NewGoalProgress(g, 3000000, 4000000, now)
+	require.Equal(t, model.Money(12000000), p.Remaining())
+	require.Equal(t, model.Money(3000000), p.RequiredMonthly)
+	require.Equal(t, date(2025, 4, 15), *p.ProjectedDate)
+	require.InDelta(t, 20, p.Percent(), 0.001)
+	require.True(t, p.OnTrack())
+
+	p = model.NewGoalProgress(g, 3000000, 2000000, now)
+	require.Equal(t, date(2025, 7, 15), *p.ProjectedDate)
+	require.False(t, p.OnTrack())
+
+	// Без положительного дохода прогноза нет
+	p = model.NewGoalProgress(g, 0, -100, now)
+	require.Nil(t, p.ProjectedDate)
+	require.False(t, p.OnTrack())
+
+	p = model.NewGoalProgress(g, 16000000, 0, now)
+	require.True(t, p.Completed())
+	require.Zero(t, p.Remaining())
+	require.Zero(t, p.RequiredMonthly)
+	require.Equal(t, date(2025, 1, 15), *p.ProjectedDate)
+}
+
+func newTestGoalService(t *testing.T) (service.GoalService, *MockGoalRepository, *MockTransactionRepository, *MockTransactionService) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockGoals := NewMockGoalRepository(ctrl)
+	mockHistory := NewMockTransactionRepository(ctrl)
+	mockTransactions := NewMockTransactionService(ctrl)
+	s := service.NewGoalService(mockGoals, mockHistory, mockTransactions, newTestRates(t), zap.NewNop())
+	return s, mockGoals, mockHistory, mockTransactions
+}
+
+func TestGetGoal_ConvertsContributionsAndIncome(t *testing.T) {
+	s, mockGoals, mockHistory, _ := newTestGoalService(t)
+	g := &model.Goal{ID: "vacation", UserID: "user-1", Name: "Отпуск", TargetAmount: 15000000, Currency: "RUB"}
+
+	mockGoals.EXPECT().GetByID(gomock.Any(), "vacation", "user-1").Return(g, nil)
+	mockGoals.EXPECT().Contributions(gomock.Any(), []string{"vacation"}).Return([]model.GoalContribution{
+		{GoalID: "vacation", Currency: "RUB", Amount: 1000000},
+		{GoalID: "vacation", Currency: "USD", Amount: 10000},
+	}, nil)
+	mockHistory.EXPECT().Report(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f model.ReportFilter) ([]model.ReportRow, error) {
+		// Последние три полных месяца без текущего
+		month := model.GranularityMonth.Truncate(time.Now())
+		require.Equal(t, month.AddDate(0, -3, 0), f.DateFrom)
+		require.Equal(t, month.AddDate(0, 0, -1), f.DateTo)
+		require.Equal(t, model.GranularityMonth, f.Granularity)
+		return []model.ReportRow{
+			{Currency: "RUB", Income: 15000000, Expense: 9000000},
+			{Currency: "USD", Income: 100000},
+		}, nil
+	})
+
+	p, err := s.GetGoal(context.Background(), "vacation", "user-1")
+	require.NoError(t, err)
+	// 10 000 ₽ + 100 $ по 90 ₽
+	require.Equal(t, model.Money(1900000), p.Contributed)
+	// (60 000 ₽ + 1 000 $ по 90 ₽) / 3
+	require.Equal(t, model.Money(5000000), p.AverageNetIncome)
+	require.NotNil(t, p.ProjectedDate)
+	require.Zero(t, p.RequiredMonthly)
+}
+
+func TestCreateGoal_PastDeadline(t *testing.T) {
+	s, _, _, _ := newTestGoalService(t)
+	deadline := time.Now().AddDate(0, 0, -2)
+
+	_, err := s.CreateGoal(context.Background(), &model.Goal{UserID: "user-1", Name: "Отпуск", TargetAmount: 100, Deadline: &deadline})
+	require.ErrorIs(t, err, service.ErrInvalidGoal)
+}
+
+func TestAddContribution_ChecksGoalOwner(t *testing.T) {
+	s, mockGoals, _, mockTransactions := newTestGoalService(t)
+	ctx := context.Background()
+
+	mockGoals.EXPECT().GetByID(gomock.Any(), "vacation", "user-2").Return(nil, repo.ErrGoalNotFound)
+	_, err := s.AddContribution(ctx, &model.Transaction{UserID: "user-2", GoalID: "vacation"})
+	require.ErrorIs(t, err, repo.ErrGoalNotFound)
+
+	mockGoals.EXPECT().GetByID(gomock.Any(), "vacation", "user-1").Return(&model.Goal{ID: "vacation", UserID: "user-1"}, nil)
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) (string, error) {
+		require.Equal(t, "vacation", tx.GoalID)
+		return "tx-1", nil
+	})
+	id, err := s.AddContribution(ctx, &model.Transaction{UserID: "user-1", GoalID: "vacation", Type: model.Transfer})
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", id)
+}
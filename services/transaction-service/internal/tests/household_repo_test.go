@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestHouseholdRepo(t *testing.T) (repo.HouseholdRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewHouseholdRepo(db, zap.NewNop()), mock
+}
+
+var invitationColumns = []string{"id", "household_id", "invited_by", "role", "code_hash", "expires_at", "created_at"}
+
+func TestAcceptInvitation_AddsMember(t *testing.T) {
+	r, mock := newTestHouseholdRepo(t)
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE code_hash = $1 AND accepted_at IS NULL AND expires_at > $2`)).
+		WithArgs("hash", now).
+		WillReturnRows(sqlmock.NewRows(invitationColumns).AddRow("inv-1", "hh-1", "owner-1", "viewer", "hash", now.Add(time.Hour), now))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO household_members`)).
+		WithArgs("hh-1", "user-2", model.HouseholdViewer, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE household_invitations SET accepted_by = $1, accepted_at = $2 WHERE id = $3`)).
+		WithArgs("user-2", now, "inv-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	m, err := r.AcceptInvitation(context.Background(), "hash", "user-2", now)
+	require.NoError(t, err)
+	require.Equal(t, model.HouseholdViewer, m.Role)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptInvitation_AlreadyMemberKeepsInvitation(t *testing.T) {
+	r, mock := newTestHouseholdRepo(t)
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM household_invitations`)).
+		WillReturnRows(sqlmock.NewRows(invitationColumns).AddRow("inv-1", "hh-1", "owner-1", "editor", "hash", now.Add(time.Hour), now))
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT DO NOTHING`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := r.AcceptInvitation(context.Background(), "hash", "owner-1", now)
+	require.ErrorIs(t, err, repo.ErrAlreadyMember)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestList_HouseholdLedger(t *testing.T) {
+	r, mock := newTestRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM transactions WHERE household_id = $1 AND type = $2`)).
+		WithArgs("hh-1", "EXPENSE").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`COALESCE(household_id::text, '') AS household_id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "household_id"}).AddRow("tx-1", "editor-1", "hh-1"))
+
+	txs, total, err := r.List(context.Background(), model.TransactionFilter{UserID: "viewer-1", HouseholdID: "hh-1", Type: model.Expense, SortBy: model.SortByDate, Limit: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, total)
+	require.Equal(t, "editor-1", txs[0].UserID)
+	require.Equal(t, "hh-1", txs[0].HouseholdID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+// Участники тестового бюджета hh-1: owner-1, editor-1 и viewer-1
+func expectHouseholdMembers(households *MockHouseholdRepository) {
+	for userID, role := range map[string]model.HouseholdRole{
+		"owner-1":  model.HouseholdOwner,
+		"editor-1": model.HouseholdEditor,
+		"viewer-1": model.HouseholdViewer,
+	} {
+		households.EXPECT().GetMember(gomock.Any(), "hh-1", userID).
+			Return(&model.HouseholdMember{HouseholdID: "hh-1", UserID: userID, Role: role}, nil).AnyTimes()
+	}
+	households.EXPECT().GetMember(gomock.Any(), "hh-1", gomock.Any()).Return(nil, repo.ErrMemberNotFound).AnyTimes()
+}
+
+func TestAddTransaction_HouseholdRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewTransactionService(mockRepo, mockCategories, mockHouseholds, defaultAccounts(ctrl), zap.NewNop())
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", Type: model.Expense}, nil).AnyTimes()
+
+	newTx := func(userID string) *model.Transaction {
+		return &model.Transaction{UserID: userID, HouseholdID: "hh-1", CategoryID: "cat-1", Type: model.Expense, Amount: 100, Date: time.Now()}
+	}
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tx *model.Transaction) error {
+		require.Equal(t, "editor-1", tx.UserID)
+		require.Equal(t, "hh-1", tx.HouseholdID)
+		return nil
+	})
+	_, err := s.AddTransaction(context.Background(), newTx("editor-1"))
+	require.NoError(t, err)
+
+	_, err = s.AddTransaction(context.Background(), newTx("viewer-1"))
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+	_, err = s.AddTransaction(context.Background(), newTx("stranger"))
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+}
+
+func TestListTransactions_HouseholdLedger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), mockHouseholds, defaultAccounts(ctrl), zap.NewNop())
+
+	// Участник с правом просмотра видит записи всех участников
+	mockRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f model.TransactionFilter) ([]*model.Transaction, int64, error) {
+		require.Equal(t, "hh-1", f.HouseholdID)
+		return []*model.Transaction{
+			{ID: "tx-1", UserID: "owner-1", HouseholdID: "hh-1"},
+			{ID: "tx-2", UserID: "editor-1", HouseholdID: "hh-1"},
+		}, 2, nil
+	})
+	page, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "viewer-1", HouseholdID: "hh-1"})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 2)
+	require.Equal(t, "owner-1", page.Transactions[0].UserID)
+
+	_, err = s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "stranger", HouseholdID: "hh-1"})
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+}
+
+func TestUpdateTransaction_HouseholdViewerDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewTransactionService(mockRepo, NewMockCategoryRepository(ctrl), mockHouseholds, defaultAccounts(ctrl), zap.NewNop())
+
+	// Автор записи, которого понизили до просмотра, больше не может её менять
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "viewer-1").
+		Return(&model.Transaction{ID: "tx-1", UserID: "viewer-1", HouseholdID: "hh-1", Type: model.Expense, Amount: 100}, nil)
+	description := "Продукты"
+	_, err := s.UpdateTransaction(context.Background(), "tx-1", "viewer-1", &model.TransactionPatch{Description: &description})
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+}
+
+func TestGetBalance_Household(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewBalanceService(mockRepo, mockHouseholds, nil, nil, newTestRates(t), stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	mockRepo.EXPECT().GetHouseholdBalance(gomock.Any(), "hh-1").
+		Return([]model.CurrencyBalance{{Currency: "RUB", Income: 1000000, Expense: 250000}}, nil)
+	report, err := s.GetBalance(context.Background(), "viewer-1", "hh-1")
+	require.NoError(t, err)
+	require.Equal(t, model.Money(750000), report.Balance())
+
+	_, err = s.GetBalance(context.Background(), "stranger", "hh-1")
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+}
+
+func TestInviteMember_OwnerOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewHouseholdService(mockHouseholds, zap.NewNop())
+	ctx := context.Background()
+
+	_, err := s.InviteMember(ctx, "hh-1", "editor-1", model.HouseholdViewer)
+	require.ErrorIs(t, err, service.ErrHouseholdForbidden)
+	_, err = s.InviteMember(ctx, "hh-1", "owner-1", model.HouseholdOwner)
+	require.ErrorIs(t, err, service.ErrInvalidHousehold)
+
+	var stored *model.HouseholdInvitation
+	mockHouseholds.EXPECT().CreateInvitation(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, inv *model.HouseholdInvitation) error {
+		stored = inv
+		return nil
+	})
+	inv, err := s.InviteMember(ctx, "hh-1", "owner-1", model.HouseholdEditor)
+	require.NoError(t, err)
+	require.NotEmpty(t, inv.Code)
+	sum := sha256.Sum256([]byte(inv.Code))
+	require.Equal(t, hex.EncodeToString(sum[:]), stored.CodeHash)
+	require.WithinDuration(t, time.Now().Add(service.HouseholdInvitationTTL), inv.ExpiresAt, time.Minute)
+}
+
+func TestAcceptInvitation_ByCodeHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	s := service.NewHouseholdService(mockHouseholds, zap.NewNop())
+	ctx := context.Background()
+	sum := sha256.Sum256([]byte("invite-code"))
+
+	mockHouseholds.EXPECT().AcceptInvitation(ctx, hex.EncodeToString(sum[:]), "user-2", gomock.Any()).
+		Return(&model.HouseholdMember{HouseholdID: "hh-1", UserID: "user-2", Role: model.HouseholdEditor}, nil)
+	m, err := s.AcceptInvitation(ctx, "user-2", " invite-code ")
+	require.NoError(t, err)
+	require.Equal(t, model.HouseholdEditor, m.Role)
+
+	mockHouseholds.EXPECT().AcceptInvitation(ctx, gomock.Any(), "user-2", gomock.Any()).Return(nil, repo.ErrInvitationNotFound)
+	_, err = s.AcceptInvitation(ctx, "user-2", "expired")
+	require.ErrorIs(t, err, repo.ErrInvitationNotFound)
+}
+
+func TestRemoveMember_OwnerAndLeave(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockHouseholds := NewMockHouseholdRepository(ctrl)
+	expectHouseholdMembers(mockHouseholds)
+	s := service.NewHouseholdService(mockHouseholds, zap.NewNop())
+	ctx := context.Background()
+
+	require.ErrorIs(t, s.RemoveMember(ctx, "hh-1", "editor-1", "viewer-1"), service.ErrHouseholdForbidden)
+	require.ErrorIs(t, s.RemoveMember(ctx, "hh-1", "owner-1", "owner-1"), service.ErrInvalidHousehold)
+
+	mockHouseholds.EXPECT().RemoveMember(ctx, "hh-1", "viewer-1").Return(nil)
+	require.NoError(t, s.RemoveMember(ctx, "hh-1", "owner-1", "viewer-1"))
+	mockHouseholds.EXPECT().RemoveMember(ctx, "hh-1", "editor-1").Return(nil)
+	require.NoError(t, s.RemoveMember(ctx, "hh-1", "editor-1", "editor-1"))
+}
@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/auth"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/delivery"
+)
+
+const testKID = "test-key"
+
+var testSigningKey = func() ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// Набор ключей из одного тестового ключа вместо JWKS auth-service
+type staticKeys map[string]*auth.PublicKey
+
+func (s staticKeys) Key(_ context.Context, kid string) (*auth.PublicKey, error) {
+	if key, ok := s[kid]; ok {
+		return key, nil
+	}
+	return nil, auth.ErrUnknownKey
+}
+
+type revokedSet map[string]bool
+
+func (s revokedSet) IsRevoked(jti string) bool { return s[jti] }
+
+var ownership = delivery.NewOwnership(auth.NewVerifier(
+	staticKeys{testKID: {ID: testKID, Algorithm: "EdDSA", Key: testSigningKey.Public()}},
+	revokedSet{"revoked-jti": true},
+), zap.NewNop())
+
+func signTestJWT(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = testKID
+	tokenStr, _ := token.SignedString(testSigningKey)
+	return tokenStr
+}
+
+func testToken(userID, role, jti string) string {
+	return signTestJWT(jwt.MapClaims{
+		"user_id": userID,
+		"role":    role,
+		"jti":     jti,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	})
+}
+
+func tokenContext(token string, kv ...string) context.Context {
+	md := metadata.Pairs(append([]string{delivery.AuthorizationMetadataKey, "Bearer " + token}, kv...)...)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func callerContext(userID string) context.Context {
+	return tokenContext(testToken(userID, "user", userID+"-jti"))
+}
+
+func runUnary(ctx context.Context, method string, req interface{}) (bool, error) {
+	called := false
+	_, err := ownership.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+	return called, err
+}
+
+func TestOwnershipUnaryInterceptor(t *testing.T) {
+	const method = transactionpb.TransactionService_GetBalance_FullMethodName
+
+	called, err := runUnary(callerContext("user-1"), method, &transactionpb.GetBalanceRequest{UserId: "user-1"})
+	require.NoError(t, err)
+	require.True(t, called)
+
+	called, err = runUnary(callerContext("user-1"), method, &transactionpb.GetBalanceRequest{UserId: "user-2"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.False(t, called)
+
+	called, err = runUnary(context.Background(), method, &transactionpb.GetBalanceRequest{UserId: "user-1"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+
+	// Заголовок x-user-id без токена больше не подтверждает пользователя
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "user-1"))
+	called, err = runUnary(forged, method, &transactionpb.GetBalanceRequest{UserId: "user-1"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+
+	// Отозванный токен и токен, подписанный чужим ключом, не принимаются
+	called, err = runUnary(tokenContext(testToken("user-1", "user", "revoked-jti")), method, &transactionpb.GetBalanceRequest{UserId: "user-1"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	alien := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"user_id": "user-1", "jti": "x", "exp": time.Now().Add(time.Hour).Unix()})
+	alien.Header["kid"] = testKID
+	alienStr, err := alien.SignedString(otherKey)
+	require.NoError(t, err)
+	called, err = runUnary(tokenContext(alienStr), method, &transactionpb.GetBalanceRequest{UserId: "user-1"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.False(t, called)
+
+	// Поддержка читает чужие транзакции, но не меняет их и не видит остального
+	support := tokenContext(testToken("support-1", "support", "support-jti"))
+	called, err = runUnary(support, transactionpb.TransactionService_ListTransactions_FullMethodName,
+		&transactionpb.ListTransactionsRequest{UserId: "user-2"})
+	require.NoError(t, err)
+	require.True(t, called)
+	called, err = runUnary(support, method, &transactionpb.GetBalanceRequest{UserId: "user-2"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.False(t, called)
+	called, err = runUnary(support, transactionpb.TransactionService_DeleteTransaction_FullMethodName,
+		&transactionpb.DeleteTransactionRequest{UserId: "user-2"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.False(t, called)
+
+	// Роль из заголовка не подменяет роль из токена
+	forgedRole := tokenContext(testToken("user-1", "user", "user-1-jti"), "x-user-role", "admin")
+	called, err = runUnary(forgedRole, transactionpb.TransactionService_ListTransactions_FullMethodName,
+		&transactionpb.ListTransactionsRequest{UserId: "user-2"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.False(t, called)
+
+	// Служебные сервисы (reflection и т.п.) не проверяются
+	called, err = runUnary(context.Background(), "/grpc.health.v1.Health/Check", nil)
+	require.NoError(t, err)
+	require.True(t, called)
+}
+
+// Поток с одним входящим сообщением
+type recvStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *transactionpb.ExportTransactionsRequest
+}
+
+func (s *recvStream) Context() context.Context { return s.ctx }
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestOwnershipStreamInterceptor_ChecksExportFilter(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: transactionpb.TransactionService_ExportTransactions_FullMethodName, IsServerStream: true}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&transactionpb.ExportTransactionsRequest{})
+	}
+
+	own := &recvStream{ctx: callerContext("user-1"), req: &transactionpb.ExportTransactionsRequest{
+		Filter: &transactionpb.ListTransactionsRequest{UserId: "user-1"},
+	}}
+	require.NoError(t, ownership.StreamInterceptor(nil, own, info, handler))
+
+	foreign := &recvStream{ctx: callerContext("user-1"), req: &transactionpb.ExportTransactionsRequest{
+		Filter: &transactionpb.ListTransactionsRequest{UserId: "user-2"},
+	}}
+	err := ownership.StreamInterceptor(nil, foreign, info, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// Через настоящий gRPC-сервер: роль admin в заголовке при токене обычного пользователя
+// не открывает чужие транзакции
+func TestOwnership_ForgedRoleHeaderOverGRPC(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(ownership.UnaryInterceptor),
+		grpc.StreamInterceptor(ownership.StreamInterceptor),
+	)
+	transactionpb.RegisterTransactionServiceServer(server, delivery.NewTransactionHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := transactionpb.NewTransactionServiceClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		delivery.AuthorizationMetadataKey, "Bearer "+testToken("user-1", "user", "user-1-jti"),
+		"x-user-id", "user-2", "x-user-role", "admin")
+	_, err = client.ListTransactions(ctx, &transactionpb.ListTransactionsRequest{UserId: "user-2"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/csvimport"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func TestParse_BankStatement(t *testing.T) {
+	data := []byte("\xef\xbb\xbfДата;Сумма;Описание\n" +
+		"05.03.2024;-1 234,50;Продукты\n" +
+		"06.03.2024;50000,00;Зарплата\n" +
+		"\n" +
+		"32.03.2024;10,00;Ошибка\n")
+	rows, err := csvimport.Parse(data, model.ImportOptions{
+		Delimiter:                ';',
+		DateFormat:               "DD.MM.YYYY",
+		DecimalSeparator:         ",",
+		Columns:                  model.ImportColumns{Date: "Дата", Amount: "Сумма", Description: "Описание"},
+		DefaultIncomeCategoryID:  "cat-in",
+		DefaultExpenseCategoryID: "cat-out",
+		Currency:                 "USD",
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, 2, rows[0].Line)
+	require.Equal(t, model.Expense, rows[0].Transaction.Type)
+	require.Equal(t, model.Money(123450), rows[0].Transaction.Amount)
+	require.Equal(t, "cat-out", rows[0].Transaction.CategoryID)
+	require.Equal(t, "USD", rows[0].Transaction.Currency)
+	require.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), rows[0].Transaction.Date)
+
+	require.NoError(t, rows[1].Err)
+	require.Equal(t, model.Income, rows[1].Transaction.Type)
+	require.Equal(t, "cat-in", rows[1].Transaction.CategoryID)
+
+	require.Error(t, rows[2].Err)
+	require.Equal(t, 5, rows[2].Line)
+}
+
+func TestParse_TypeAndCategoryColumns(t *testing.T) {
+	data := []byte("date,amount,type,category_id,currency\n2024-03-05,\"1,200.00\",expense,cat-1,eur\n")
+	rows, err := csvimport.Parse(data, model.ImportOptions{})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, model.Expense, rows[0].Transaction.Type)
+	require.Equal(t, model.Money(120000), rows[0].Transaction.Amount)
+	require.Equal(t, "cat-1", rows[0].Transaction.CategoryID)
+	require.Equal(t, "eur", rows[0].Transaction.Currency)
+}
+
+func TestParse_MissingColumn(t *testing.T) {
+	_, err := csvimport.Parse([]byte("date,sum\n2024-03-05,10\n"), model.ImportOptions{})
+	require.ErrorIs(t, err, csvimport.ErrInvalidFile)
+
+	_, err = csvimport.Parse([]byte("date,amount\n2024-03-05,10\n"), model.ImportOptions{Columns: model.ImportColumns{Description: "memo"}})
+	require.ErrorIs(t, err, csvimport.ErrInvalidFile)
+}
+
+func TestImportTransactions_PartialAccept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), zap.NewNop())
+
+	data := []byte("date,amount,category_id\n" +
+		"2024-03-05,-100.00,cat-food\n" +
+		"2024-03-06,-20.00,cat-food\n" +
+		"2024-03-07,500.00,cat-food\n" +
+		"2024-03-08,abc,cat-food\n" +
+		"2024-03-09,-5.00,\n")
+
+	// Категория проверяется один раз для пары (категория, тип)
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-food").Return(&model.Category{ID: "cat-food", Type: model.Expense}, nil).Times(2)
+	mockRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, txs []*model.Transaction) error {
+		require.Len(t, txs, 2)
+		for _, tx := range txs {
+			require.Equal(t, "user-1", tx.UserID)
+			require.NotEmpty(t, tx.ID)
+			require.Equal(t, model.DefaultCurrency, tx.Currency)
+		}
+		return nil
+	})
+
+	report, err := s.ImportTransactions(context.Background(), "user-1", data, model.ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Accepted())
+	require.Equal(t, 3, report.Rejected())
+	require.True(t, report.Rows[0].Accepted)
+	require.NotEmpty(t, report.Rows[0].TransactionID)
+	require.False(t, report.Rows[2].Accepted)
+	require.Equal(t, 4, report.Rows[2].Line)
+	require.NotEmpty(t, report.Rows[4].Error)
+}
+
+func TestImportTransactions_NonUUIDCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	categories := repo.NewCategoryRepo(sqlx.NewDb(sqlDB, "sqlmock"), zap.NewNop())
+	s := service.NewTransactionService(mockRepo, categories, nil, defaultAccounts(ctrl), zap.NewNop())
+
+	// Postgres не принимает название категории из выписки как uuid
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM categories WHERE id = $1`)).WithArgs("Продукты").
+		WillReturnError(&pq.Error{Code: "22P02", Message: "invalid input syntax for type uuid"})
+	mockRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Times(0)
+
+	report, err := s.ImportTransactions(context.Background(), "user-1", []byte("date,amount,category_id\n2024-03-05,-1,Продукты\n"), model.ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Rejected())
+	require.Contains(t, report.Rows[0].Error, repo.ErrCategoryNotFound.Error())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportTransactions_CategoryLookupFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), zap.NewNop())
+
+	dbErr := errors.New("connection refused")
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(nil, dbErr)
+
+	_, err := s.ImportTransactions(context.Background(), "user-1", []byte("date,amount,category_id\n2024-03-05,-1,cat-1\n"), model.ImportOptions{})
+	require.ErrorIs(t, err, dbErr)
+}
+
+func TestCreateBatch_Success(t *testing.T) {
+	r, mock := newTestRepo(t)
+	txs := []*model.Transaction{
+		{ID: "tx1", UserID: "user-1", CategoryID: "cat-1", Type: model.Expense, Amount: 100, Currency: "RUB"},
+		{ID: "tx2", UserID: "user-1", CategoryID: "cat-1", Type: model.Expense, Amount: 200, Currency: "RUB"},
+	}
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO transactions`))
+	for _, tx := range txs {
+		prep.ExpectExec().
+			WithArgs(tx.ID, tx.UserID, tx.CategoryID, tx.Type, tx.Amount, tx.Currency, tx.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", tx.AccountID, "", model.Money(0), "", "").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	require.NoError(t, r.CreateBatch(context.Background(), txs))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBatch_RollbackOnError(t *testing.T) {
+	r, mock := newTestRepo(t)
+	txs := []*model.Transaction{{ID: "tx1"}, {ID: "tx2"}}
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO transactions`))
+	prep.ExpectExec().WillReturnResult(sqlmock.NewResult(1, 1))
+	prep.ExpectExec().WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	require.Error(t, r.CreateBatch(context.Background(), txs))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestLoanRepo(t *testing.T) (repo.LoanRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewLoanRepo(db, zap.NewNop()), mock
+}
+
+func TestLoanGetByID(t *testing.T) {
+	r, mock := newTestLoanRepo(t)
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM loans WHERE id = $1 AND user_id = $2`)).
+		WithArgs("car", "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "counterparty", "direction", "schedule", "principal", "currency", "annual_rate", "term_months", "start_date", "created_at"}).
+			AddRow("car", "user-1", "Банк", "BORROWED", "ANNUITY", "120000.00", "RUB", "12.500", 36, date(2025, 1, 10), now))
+
+	l, err := r.GetByID(context.Background(), "car", "user-1")
+	require.NoError(t, err)
+	require.Equal(t, model.LoanBorrowed, l.Direction)
+	require.Equal(t, model.Money(12000000), l.Principal)
+	require.Equal(t, 12.5, l.AnnualRate)
+	require.Equal(t, 36, l.TermMonths)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLoanRepayments(t *testing.T) {
+	r, mock := newTestLoanRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE loan_id = ANY($1)`)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"loan_id", "currency", "amount"}).AddRow("car", "RUB", "11200.00"))
+
+	repayments, err := r.Repayments(context.Background(), []string{"car"})
+	require.NoError(t, err)
+	require.Equal(t, []model.LoanRepayment{{LoanID: "car", Currency: "RUB", Amount: 1120000}}, repayments)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func TestLoanSchedule_Annuity(t *testing.T) {
+	l := &model.Loan{Schedule: model.ScheduleAnnuity, Principal: 10000000, AnnualRate: 12, TermMonths: 12, StartDate: date(2025, 1, 31)}
+	schedule := l.PaymentSchedule()
+	require.Len(t, schedule, 12)
+
+	require.Equal(t, model.Money(888488), schedule[0].Payment)
+	require.Equal(t, model.Money(100000), schedule[0].Interest)
+	require.Equal(t, model.Money(9211512), schedule[0].Balance)
+	require.Equal(t, date(2025, 2, 28), schedule[0].Date)
+
+	var principal model.Money
+	for i, p := range schedule {
+		principal += p.Principal
+		require.Equal(t, p.Principal+p.Interest, p.Payment)
+		if i < len(schedule)-1 {
+			require.Equal(t, schedule[0].Payment, p.Payment)
+		}
+	}
+	require.Equal(t, l.Principal, principal)
+	require.Zero(t, schedule[11].Balance)
+	require.InDelta(t, int64(schedule[0].Payment), int64(schedule[11].Payment), 5)
+}
+
+func TestLoanSchedule_DifferentiatedAndInterestFree(t *testing.T) {
+	l := &model.Loan{Schedule: model.ScheduleDifferentiated, Principal: 12000000, AnnualRate: 12, TermMonths: 12, StartDate: date(2025, 1, 10)}
+	schedule := l.PaymentSchedule()
+	require.Equal(t, model.Money(1120000), schedule[0].Payment)
+	require.Equal(t, model.Money(1110000), schedule[1].Payment)
+	require.Equal(t, model.Money(1010000), schedule[11].Payment)
+	require.Equal(t, model.Money(780000), model.NewLoanStatus(l, 0).TotalInterest())
+
+	free := &model.Loan{Schedule: model.ScheduleAnnuity, Principal: 100000, TermMonths: 3, StartDate: date(2025, 1, 10)}
+	schedule = free.PaymentSchedule()
+	require.Equal(t, []model.Money{33334, 33334, 33332}, []model.Money{schedule[0].Payment, schedule[1].Payment, schedule[2].Payment})
+	require.Zero(t, schedule[2].Interest)
+}
+
+func TestNewLoanStatus_AllocatesRepayments(t *testing.T) {
+	l := &model.Loan{Schedule: model.ScheduleDifferentiated, Principal: 12000000, AnnualRate: 12, TermMonths: 12, StartDate: date(2025, 1, 10)}
+
+	// Первый платёж покрыт, остатка не хватает даже на проценты второго
+	s := model.NewLoanStatus(l, 1120000+50000)
+	require.Equal(t, model.Money(11000000), s.Outstanding)
+	require.Equal(t, 2, s.NextPayment.Number)
+
+	// Сверх процентов второго платежа — в основной долг
+	s = model.NewLoanStatus(l, 1120000+210000)
+	require.Equal(t, model.Money(10900000), s.Outstanding)
+	require.False(t, s.Closed())
+
+	s = model.NewLoanStatus(l, 12780000)
+	require.True(t, s.Closed())
+	require.Nil(t, s.NextPayment)
+}
+
+func newTestLoanService(t *testing.T) (service.LoanService, *MockLoanRepository, *MockTransactionRepository, *MockTransactionService) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockLoans := NewMockLoanRepository(ctrl)
+	mockHistory := NewMockTransactionRepository(ctrl)
+	mockTransactions := NewMockTransactionService(ctrl)
+	s := service.NewLoanService(mockLoans, mockHistory, mockTransactions, newTestRates(t), zap.NewNop())
+	return s, mockLoans, mockHistory, mockTransactions
+}
+
+func TestCreateLoan_Invalid(t *testing.T) {
+	s, _, _, _ := newTestLoanService(t)
+
+	_, err := s.CreateLoan(context.Background(), &model.Loan{
+		UserID: "user-1", Counterparty: "Банк", Direction: model.LoanBorrowed, Principal: 100, TermMonths: 0, StartDate: time.Now(),
+	})
+	require.ErrorIs(t, err, service.ErrInvalidLoan)
+}
+
+func TestLoanRepayment_ChecksType(t *testing.T) {
+	s, mockLoans, mockHistory, mockTransactions := newTestLoanService(t)
+	ctx := context.Background()
+	mortgage := &model.Loan{ID: "mortgage", UserID: "user-1", Direction: model.LoanBorrowed, Schedule: model.ScheduleAnnuity,
+		Principal: 10000000, Currency: "RUB", TermMonths: 12, StartDate: date(2025, 1, 10)}
+	mockLoans.EXPECT().GetByID(gomock.Any(), "mortgage", "user-1").Return(mortgage, nil).AnyTimes()
+
+	// Полученный займ гасится расходом, а не доходом
+	_, err := s.AddRepayment(ctx, &model.Transaction{UserID: "user-1", LoanID: "mortgage", Type: model.Income})
+	require.ErrorIs(t, err, service.ErrInvalidLoan)
+
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).Return("tx-1", nil)
+	id, err := s.AddRepayment(ctx, &model.Transaction{UserID: "user-1", LoanID: "mortgage", Type: model.Expense})
+	require.NoError(t, err)
+	require.Equal(t, "tx-1", id)
+
+	// Привязка существующей транзакции; погашение в долларах пересчитывается в рубли
+	mockHistory.EXPECT().GetByID(gomock.Any(), "tx-2", "user-1").Return(&model.Transaction{ID: "tx-2", Type: model.Expense}, nil)
+	mockLoans.EXPECT().LinkTransaction(gomock.Any(), "tx-2", "user-1", "mortgage").Return(nil)
+	mockLoans.EXPECT().Repayments(gomock.Any(), []string{"mortgage"}).Return([]model.LoanRepayment{{LoanID: "mortgage", Currency: "USD", Amount: 20000}}, nil)
+	status, err := s.LinkTransaction(ctx, "mortgage", "user-1", "tx-2")
+	require.NoError(t, err)
+	require.Equal(t, model.Money(1800000), status.Paid)
+
+	mockLoans.EXPECT().GetByID(gomock.Any(), "mortgage", "user-2").Return(nil, repo.ErrLoanNotFound)
+	_, err = s.AddRepayment(ctx, &model.Transaction{UserID: "user-2", LoanID: "mortgage", Type: model.Expense})
+	require.ErrorIs(t, err, repo.ErrLoanNotFound)
+}
+
+func TestGetNetWorth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAccounts := NewMockAccountRepository(ctrl)
+	mockLoans := NewMockLoanRepository(ctrl)
+	s := service.NewBalanceService(nil, nil, mockAccounts, mockLoans, newTestRates(t), stubProfiles{currency: "RUB"}, zap.NewNop())
+
+	mockAccounts.EXPECT().ListBalances(gomock.Any(), "user-1", false).Return([]*model.AccountBalance{
+		{Account: model.Account{ID: "card", Currency: "RUB"}, Balance: 5000000},
+		{Account: model.Account{ID: "usd", Currency: "USD"}, Balance: 10000},
+	}, nil)
+	mockLoans.EXPECT().ListByUserID(gomock.Any(), "user-1").Return([]*model.Loan{
+		{ID: "friend", Direction: model.LoanLent, Schedule: model.ScheduleAnnuity, Principal: 1000000, Currency: "RUB", TermMonths: 1, StartDate: date(2025, 1, 10)},
+		{ID: "car", Direction: model.LoanBorrowed, Schedule: model.ScheduleDifferentiated, Principal: 12000000, AnnualRate: 12, Currency: "RUB", TermMonths: 12, StartDate: date(2025, 1, 10)},
+		{ID: "repaid", Direction: model.LoanBorrowed, Schedule: model.ScheduleAnnuity, Principal: 100000, Currency: "RUB", TermMonths: 1, StartDate: date(2025, 1, 10)},
+	}, nil)
+	mockLoans.EXPECT().Repayments(gomock.Any(), []string{"friend", "car", "repaid"}).Return([]model.LoanRepayment{
+		{LoanID: "car", Currency: "RUB", Amount: 1120000},
+		{LoanID: "repaid", Currency: "RUB", Amount: 100000},
+	}, nil)
+
+	nw, err := s.GetNetWorth(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.Equal(t, "RUB", nw.Currency)
+	// 50 000 ₽ + 100 $ по 90 ₽
+	require.Equal(t, model.Money(5900000), nw.Accounts)
+	require.Equal(t, model.Money(1000000), nw.Lent)
+	require.Equal(t, model.Money(11000000), nw.Borrowed)
+	require.Equal(t, model.Money(-4100000), nw.Total())
+	require.Len(t, nw.Loans, 2)
+}
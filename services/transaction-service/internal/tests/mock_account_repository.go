@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/account_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockAccountRepository is a mock of AccountRepository interface.
+type MockAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountRepositoryMockRecorder
+}
+
+// MockAccountRepositoryMockRecorder is the mock recorder for MockAccountRepository.
+type MockAccountRepositoryMockRecorder struct {
+	mock *MockAccountRepository
+}
+
+// NewMockAccountRepository creates a new mock instance.
+func NewMockAccountRepository(ctrl *gomock.Controller) *MockAccountRepository {
+	mock := &MockAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountRepository) EXPECT() *MockAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockAccountRepository) Archive(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockAccountRepositoryMockRecorder) Archive(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockAccountRepository)(nil).Archive), ctx, id, userID)
+}
+
+// Create mocks base method.
+func (m *MockAccountRepository) Create(ctx context.Context, a *model.Account) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccountRepositoryMockRecorder) Create(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountRepository)(nil).Create), ctx, a)
+}
+
+// EnsureDefault mocks base method.
+func (m *MockAccountRepository) EnsureDefault(ctx context.Context, userID, currency string, now time.Time) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureDefault", ctx, userID, currency, now)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureDefault indicates an expected call of EnsureDefault.
+func (mr *MockAccountRepositoryMockRecorder) EnsureDefault(ctx, userID, currency, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDefault", reflect.TypeOf((*MockAccountRepository)(nil).EnsureDefault), ctx, userID, currency, now)
+}
+
+// GetByID mocks base method.
+func (m *MockAccountRepository) GetByID(ctx context.Context, id string) (*model.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAccountRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccountRepository)(nil).GetByID), ctx, id)
+}
+
+// ListBalances mocks base method.
+func (m *MockAccountRepository) ListBalances(ctx context.Context, userID string, includeArchived bool) ([]*model.AccountBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalances", ctx, userID, includeArchived)
+	ret0, _ := ret[0].([]*model.AccountBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalances indicates an expected call of ListBalances.
+func (mr *MockAccountRepositoryMockRecorder) ListBalances(ctx, userID, includeArchived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalances", reflect.TypeOf((*MockAccountRepository)(nil).ListBalances), ctx, userID, includeArchived)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/budget_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockBudgetRepository is a mock of BudgetRepository interface.
+type MockBudgetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetRepositoryMockRecorder
+}
+
+// MockBudgetRepositoryMockRecorder is the mock recorder for MockBudgetRepository.
+type MockBudgetRepositoryMockRecorder struct {
+	mock *MockBudgetRepository
+}
+
+// NewMockBudgetRepository creates a new mock instance.
+func NewMockBudgetRepository(ctrl *gomock.Controller) *MockBudgetRepository {
+	mock := &MockBudgetRepository{ctrl: ctrl}
+	mock.recorder = &MockBudgetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetRepository) EXPECT() *MockBudgetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBudgetRepository) Delete(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBudgetRepositoryMockRecorder) Delete(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBudgetRepository)(nil).Delete), ctx, id, userID)
+}
+
+// ListByUserID mocks base method.
+func (m *MockBudgetRepository) ListByUserID(ctx context.Context, userID string) ([]*model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockBudgetRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockBudgetRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// SpentByCategory mocks base method.
+func (m *MockBudgetRepository) SpentByCategory(ctx context.Context, userID string, from, to time.Time) ([]model.CategorySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpentByCategory", ctx, userID, from, to)
+	ret0, _ := ret[0].([]model.CategorySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpentByCategory indicates an expected call of SpentByCategory.
+func (mr *MockBudgetRepositoryMockRecorder) SpentByCategory(ctx, userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpentByCategory", reflect.TypeOf((*MockBudgetRepository)(nil).SpentByCategory), ctx, userID, from, to)
+}
+
+// Upsert mocks base method.
+func (m *MockBudgetRepository) Upsert(ctx context.Context, b *model.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, b)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockBudgetRepositoryMockRecorder) Upsert(ctx, b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockBudgetRepository)(nil).Upsert), ctx, b)
+}
@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/goal_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockGoalRepository is a mock of GoalRepository interface.
+type MockGoalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalRepositoryMockRecorder
+}
+
+// MockGoalRepositoryMockRecorder is the mock recorder for MockGoalRepository.
+type MockGoalRepositoryMockRecorder struct {
+	mock *MockGoalRepository
+}
+
+// NewMockGoalRepository creates a new mock instance.
+func NewMockGoalRepository(ctrl *gomock.Controller) *MockGoalRepository {
+	mock := &MockGoalRepository{ctrl: ctrl}
+	mock.recorder = &MockGoalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalRepository) EXPECT() *MockGoalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Contributions mocks base method.
+func (m *MockGoalRepository) Contributions(ctx context.Context, goalIDs []string) ([]model.GoalContribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contributions", ctx, goalIDs)
+	ret0, _ := ret[0].([]model.GoalContribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contributions indicates an expected call of Contributions.
+func (mr *MockGoalRepositoryMockRecorder) Contributions(ctx, goalIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contributions", reflect.TypeOf((*MockGoalRepository)(nil).Contributions), ctx, goalIDs)
+}
+
+// Create mocks base method.
+func (m *MockGoalRepository) Create(ctx context.Context, g *model.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGoalRepositoryMockRecorder) Create(ctx, g interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGoalRepository)(nil).Create), ctx, g)
+}
+
+// Delete mocks base method.
+func (m *MockGoalRepository) Delete(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGoalRepositoryMockRecorder) Delete(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGoalRepository)(nil).Delete), ctx, id, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockGoalRepository) GetByID(ctx context.Context, id, userID string) (*model.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*model.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGoalRepositoryMockRecorder) GetByID(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGoalRepository)(nil).GetByID), ctx, id, userID)
+}
+
+// LinkTransaction mocks base method.
+func (m *MockGoalRepository) LinkTransaction(ctx context.Context, transactionID, userID, goalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkTransaction", ctx, transactionID, userID, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkTransaction indicates an expected call of LinkTransaction.
+func (mr *MockGoalRepositoryMockRecorder) LinkTransaction(ctx, transactionID, userID, goalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkTransaction", reflect.TypeOf((*MockGoalRepository)(nil).LinkTransaction), ctx, transactionID, userID, goalID)
+}
+
+// ListByUserID mocks base method.
+func (m *MockGoalRepository) ListByUserID(ctx context.Context, userID string) ([]*model.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockGoalRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockGoalRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// UnlinkTransaction mocks base method.
+func (m *MockGoalRepository) UnlinkTransaction(ctx context.Context, transactionID, userID, goalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkTransaction", ctx, transactionID, userID, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkTransaction indicates an expected call of UnlinkTransaction.
+func (mr *MockGoalRepositoryMockRecorder) UnlinkTransaction(ctx, transactionID, userID, goalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkTransaction", reflect.TypeOf((*MockGoalRepository)(nil).UnlinkTransaction), ctx, transactionID, userID, goalID)
+}
+
+// Update mocks base method.
+func (m *MockGoalRepository) Update(ctx context.Context, g *model.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, g)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGoalRepositoryMockRecorder) Update(ctx, g interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGoalRepository)(nil).Update), ctx, g)
+}
@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/household_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockHouseholdRepository is a mock of HouseholdRepository interface.
+type MockHouseholdRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHouseholdRepositoryMockRecorder
+}
+
+// MockHouseholdRepositoryMockRecorder is the mock recorder for MockHouseholdRepository.
+type MockHouseholdRepositoryMockRecorder struct {
+	mock *MockHouseholdRepository
+}
+
+// NewMockHouseholdRepository creates a new mock instance.
+func NewMockHouseholdRepository(ctrl *gomock.Controller) *MockHouseholdRepository {
+	mock := &MockHouseholdRepository{ctrl: ctrl}
+	mock.recorder = &MockHouseholdRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHouseholdRepository) EXPECT() *MockHouseholdRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockHouseholdRepository) AcceptInvitation(ctx context.Context, codeHash, userID string, now time.Time) (*model.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, codeHash, userID, now)
+	ret0, _ := ret[0].(*model.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockHouseholdRepositoryMockRecorder) AcceptInvitation(ctx, codeHash, userID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockHouseholdRepository)(nil).AcceptInvitation), ctx, codeHash, userID, now)
+}
+
+// Create mocks base method.
+func (m *MockHouseholdRepository) Create(ctx context.Context, h *model.Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHouseholdRepositoryMockRecorder) Create(ctx, h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHouseholdRepository)(nil).Create), ctx, h)
+}
+
+// CreateInvitation mocks base method.
+func (m *MockHouseholdRepository) CreateInvitation(ctx context.Context, inv *model.HouseholdInvitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvitation", ctx, inv)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvitation indicates an expected call of CreateInvitation.
+func (mr *MockHouseholdRepositoryMockRecorder) CreateInvitation(ctx, inv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvitation", reflect.TypeOf((*MockHouseholdRepository)(nil).CreateInvitation), ctx, inv)
+}
+
+// GetMember mocks base method.
+func (m *MockHouseholdRepository) GetMember(ctx context.Context, householdID, userID string) (*model.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, householdID, userID)
+	ret0, _ := ret[0].(*model.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockHouseholdRepositoryMockRecorder) GetMember(ctx, householdID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockHouseholdRepository)(nil).GetMember), ctx, householdID, userID)
+}
+
+// ListByUserID mocks base method.
+func (m *MockHouseholdRepository) ListByUserID(ctx context.Context, userID string) ([]*model.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockHouseholdRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockHouseholdRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// ListMembers mocks base method.
+func (m *MockHouseholdRepository) ListMembers(ctx context.Context, householdID string) ([]*model.HouseholdMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, householdID)
+	ret0, _ := ret[0].([]*model.HouseholdMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockHouseholdRepositoryMockRecorder) ListMembers(ctx, householdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockHouseholdRepository)(nil).ListMembers), ctx, householdID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockHouseholdRepository) RemoveMember(ctx context.Context, householdID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, householdID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockHouseholdRepositoryMockRecorder) RemoveMember(ctx, householdID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockHouseholdRepository)(nil).RemoveMember), ctx, householdID, userID)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockHouseholdRepository) UpdateMemberRole(ctx context.Context, householdID, userID string, role model.HouseholdRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, householdID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockHouseholdRepositoryMockRecorder) UpdateMemberRole(ctx, householdID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockHouseholdRepository)(nil).UpdateMemberRole), ctx, householdID, userID, role)
+}
@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/loan_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockLoanRepository is a mock of LoanRepository interface.
+type MockLoanRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoanRepositoryMockRecorder
+}
+
+// MockLoanRepositoryMockRecorder is the mock recorder for MockLoanRepository.
+type MockLoanRepositoryMockRecorder struct {
+	mock *MockLoanRepository
+}
+
+// NewMockLoanRepository creates a new mock instance.
+func NewMockLoanRepository(ctrl *gomock.Controller) *MockLoanRepository {
+	mock := &MockLoanRepository{ctrl: ctrl}
+	mock.recorder = &MockLoanRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoanRepository) EXPECT() *MockLoanRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLoanRepository) Create(ctx context.Context, l *model.Loan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoanRepositoryMockRecorder) Create(ctx, l interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoanRepository)(nil).Create), ctx, l)
+}
+
+// Delete mocks base method.
+func (m *MockLoanRepository) Delete(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoanRepositoryMockRecorder) Delete(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoanRepository)(nil).Delete), ctx, id, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockLoanRepository) GetByID(ctx context.Context, id, userID string) (*model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLoanRepositoryMockRecorder) GetByID(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLoanRepository)(nil).GetByID), ctx, id, userID)
+}
+
+// LinkTransaction mocks base method.
+func (m *MockLoanRepository) LinkTransaction(ctx context.Context, transactionID, userID, loanID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkTransaction", ctx, transactionID, userID, loanID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkTransaction indicates an expected call of LinkTransaction.
+func (mr *MockLoanRepositoryMockRecorder) LinkTransaction(ctx, transactionID, userID, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkTransaction", reflect.TypeOf((*MockLoanRepository)(nil).LinkTransaction), ctx, transactionID, userID, loanID)
+}
+
+// ListByUserID mocks base method.
+func (m *MockLoanRepository) ListByUserID(ctx context.Context, userID string) ([]*model.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockLoanRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockLoanRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// Repayments mocks base method.
+func (m *MockLoanRepository) Repayments(ctx context.Context, loanIDs []string) ([]model.LoanRepayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repayments", ctx, loanIDs)
+	ret0, _ := ret[0].([]model.LoanRepayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repayments indicates an expected call of Repayments.
+func (mr *MockLoanRepositoryMockRecorder) Repayments(ctx, loanIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repayments", reflect.TypeOf((*MockLoanRepository)(nil).Repayments), ctx, loanIDs)
+}
+
+// UnlinkTransaction mocks base method.
+func (m *MockLoanRepository) UnlinkTransaction(ctx context.Context, transactionID, userID, loanID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkTransaction", ctx, transactionID, userID, loanID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkTransaction indicates an expected call of UnlinkTransaction.
+func (mr *MockLoanRepositoryMockRecorder) UnlinkTransaction(ctx, transactionID, userID, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkTransaction", reflect.TypeOf((*MockLoanRepository)(nil).UnlinkTransaction), ctx, transactionID, userID, loanID)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo/recurring_repository.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockRecurringRepository is a mock of RecurringRepository interface.
+type MockRecurringRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringRepositoryMockRecorder
+}
+
+// MockRecurringRepositoryMockRecorder is the mock recorder for MockRecurringRepository.
+type MockRecurringRepositoryMockRecorder struct {
+	mock *MockRecurringRepository
+}
+
+// NewMockRecurringRepository creates a new mock instance.
+func NewMockRecurringRepository(ctrl *gomock.Controller) *MockRecurringRepository {
+	mock := &MockRecurringRepository{ctrl: ctrl}
+	mock.recorder = &MockRecurringRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringRepository) EXPECT() *MockRecurringRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecurringRepository) Create(ctx context.Context, r *model.RecurringTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurringRepositoryMockRecorder) Create(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringRepository)(nil).Create), ctx, r)
+}
+
+// Delete mocks base method.
+func (m *MockRecurringRepository) Delete(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringRepositoryMockRecorder) Delete(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringRepository)(nil).Delete), ctx, id, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockRecurringRepository) GetByID(ctx context.Context, id, userID string) (*model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id, userID)
+	ret0, _ := ret[0].(*model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRecurringRepositoryMockRecorder) GetByID(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurringRepository)(nil).GetByID), ctx, id, userID)
+}
+
+// ListByUserID mocks base method.
+func (m *MockRecurringRepository) ListByUserID(ctx context.Context, userID string) ([]*model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserID indicates an expected call of ListByUserID.
+func (mr *MockRecurringRepositoryMockRecorder) ListByUserID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockRecurringRepository)(nil).ListByUserID), ctx, userID)
+}
+
+// ListDue mocks base method.
+func (m *MockRecurringRepository) ListDue(ctx context.Context, date time.Time) ([]*model.RecurringTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, date)
+	ret0, _ := ret[0].([]*model.RecurringTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockRecurringRepositoryMockRecorder) ListDue(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockRecurringRepository)(nil).ListDue), ctx, date)
+}
+
+// UpdateState mocks base method.
+func (m *MockRecurringRepository) UpdateState(ctx context.Context, r *model.RecurringTransaction, prevOccurrences int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateState", ctx, r, prevOccurrences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateState indicates an expected call of UpdateState.
+func (mr *MockRecurringRepositoryMockRecorder) UpdateState(ctx, r, prevOccurrences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockRecurringRepository)(nil).UpdateState), ctx, r, prevOccurrences)
+}
@@ -6,10 +6,11 @@ package tests
 
 import (
 	context "context"
-	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 )
 
 // MockTransactionRepository is a mock of TransactionRepository interface.
@@ -49,6 +50,20 @@ func (mr *MockTransactionRepositoryMockRecorder) Create(ctx, tx interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepository)(nil).Create), ctx, tx)
 }
 
+// CreateBatch mocks base method.
+func (m *MockTransactionRepository) CreateBatch(ctx context.Context, txs []*model.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, txs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockTransactionRepositoryMockRecorder) CreateBatch(ctx, txs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockTransactionRepository)(nil).CreateBatch), ctx, txs)
+}
+
 // Delete mocks base method.
 func (m *MockTransactionRepository) Delete(ctx context.Context, transactionID, userID string) error {
 	m.ctrl.T.Helper()
@@ -63,15 +78,27 @@ func (mr *MockTransactionRepositoryMockRecorder) Delete(ctx, transactionID, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTransactionRepository)(nil).Delete), ctx, transactionID, userID)
 }
 
+// Export mocks base method.
+func (m *MockTransactionRepository) Export(ctx context.Context, filter model.TransactionFilter, fn func(*model.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTransactionRepositoryMockRecorder) Export(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTransactionRepository)(nil).Export), ctx, filter, fn)
+}
+
 // GetBalance mocks base method.
-func (m *MockTransactionRepository) GetBalance(ctx context.Context, userID string) (float64, float64, float64, error) {
+func (m *MockTransactionRepository) GetBalance(ctx context.Context, userID string) ([]model.CurrencyBalance, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBalance", ctx, userID)
-	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(float64)
-	ret2, _ := ret[2].(float64)
-	ret3, _ := ret[3].(error)
-	return ret0, ret1, ret2, ret3
+	ret0, _ := ret[0].([]model.CurrencyBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetBalance indicates an expected call of GetBalance.
@@ -80,17 +107,92 @@ func (mr *MockTransactionRepositoryMockRecorder) GetBalance(ctx, userID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockTransactionRepository)(nil).GetBalance), ctx, userID)
 }
 
-// ListByUserID mocks base method.
-func (m *MockTransactionRepository) ListByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Transaction, error) {
+// GetBalanceBefore mocks base method.
+func (m *MockTransactionRepository) GetBalanceBefore(ctx context.Context, userID string, before time.Time) ([]model.CurrencyBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceBefore", ctx, userID, before)
+	ret0, _ := ret[0].([]model.CurrencyBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceBefore indicates an expected call of GetBalanceBefore.
+func (mr *MockTransactionRepositoryMockRecorder) GetBalanceBefore(ctx, userID, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceBefore", reflect.TypeOf((*MockTransactionRepository)(nil).GetBalanceBefore), ctx, userID, before)
+}
+
+// GetByID mocks base method.
+func (m *MockTransactionRepository) GetByID(ctx context.Context, transactionID, userID string) (*model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, transactionID, userID)
+	ret0, _ := ret[0].(*model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTransactionRepositoryMockRecorder) GetByID(ctx, transactionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransactionRepository)(nil).GetByID), ctx, transactionID, userID)
+}
+
+// GetHouseholdBalance mocks base method.
+func (m *MockTransactionRepository) GetHouseholdBalance(ctx context.Context, householdID string) ([]model.CurrencyBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHouseholdBalance", ctx, householdID)
+	ret0, _ := ret[0].([]model.CurrencyBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHouseholdBalance indicates an expected call of GetHouseholdBalance.
+func (mr *MockTransactionRepositoryMockRecorder) GetHouseholdBalance(ctx, householdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHouseholdBalance", reflect.TypeOf((*MockTransactionRepository)(nil).GetHouseholdBalance), ctx, householdID)
+}
+
+// List mocks base method.
+func (m *MockTransactionRepository) List(ctx context.Context, filter model.TransactionFilter) ([]*model.Transaction, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByUserID", ctx, userID, limit, offset)
+	ret := m.ctrl.Call(m, "List", ctx, filter)
 	ret0, _ := ret[0].([]*model.Transaction)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTransactionRepositoryMockRecorder) List(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTransactionRepository)(nil).List), ctx, filter)
+}
+
+// Report mocks base method.
+func (m *MockTransactionRepository) Report(ctx context.Context, filter model.ReportFilter) ([]model.ReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, filter)
+	ret0, _ := ret[0].([]model.ReportRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListByUserID indicates an expected call of ListByUserID.
-func (mr *MockTransactionRepositoryMockRecorder) ListByUserID(ctx, userID, limit, offset interface{}) *gomock.Call {
+// Report indicates an expected call of Report.
+func (mr *MockTransactionRepositoryMockRecorder) Report(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockTransactionRepository)(nil).Report), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockTransactionRepository) Update(ctx context.Context, tx *model.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTransactionRepositoryMockRecorder) Update(ctx, tx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserID", reflect.TypeOf((*MockTransactionRepository)(nil).ListByUserID), ctx, userID, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionRepository)(nil).Update), ctx, tx)
 }
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service/service.go
+
+// Package tests is a generated GoMock package.
+package tests
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	export "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/export"
+	model "github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+)
+
+// MockTransactionService is a mock of TransactionService interface.
+type MockTransactionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionServiceMockRecorder
+}
+
+// MockTransactionServiceMockRecorder is the mock recorder for MockTransactionService.
+type MockTransactionServiceMockRecorder struct {
+	mock *MockTransactionService
+}
+
+// NewMockTransactionService creates a new mock instance.
+func NewMockTransactionService(ctrl *gomock.Controller) *MockTransactionService {
+	mock := &MockTransactionService{ctrl: ctrl}
+	mock.recorder = &MockTransactionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionService) EXPECT() *MockTransactionServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTransaction mocks base method.
+func (m *MockTransactionService) AddTransaction(ctx context.Context, tx *model.Transaction) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransaction", ctx, tx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransaction indicates an expected call of AddTransaction.
+func (mr *MockTransactionServiceMockRecorder) AddTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransaction", reflect.TypeOf((*MockTransactionService)(nil).AddTransaction), ctx, tx)
+}
+
+// DeleteTransaction mocks base method.
+func (m *MockTransactionService) DeleteTransaction(ctx context.Context, transactionID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransaction", ctx, transactionID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransaction indicates an expected call of DeleteTransaction.
+func (mr *MockTransactionServiceMockRecorder) DeleteTransaction(ctx, transactionID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransaction", reflect.TypeOf((*MockTransactionService)(nil).DeleteTransaction), ctx, transactionID, userID)
+}
+
+// ExportTransactions mocks base method.
+func (m *MockTransactionService) ExportTransactions(ctx context.Context, filter model.TransactionFilter, format export.Format, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTransactions", ctx, filter, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportTransactions indicates an expected call of ExportTransactions.
+func (mr *MockTransactionServiceMockRecorder) ExportTransactions(ctx, filter, format, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransactions", reflect.TypeOf((*MockTransactionService)(nil).ExportTransactions), ctx, filter, format, w)
+}
+
+// ImportTransactions mocks base method.
+func (m *MockTransactionService) ImportTransactions(ctx context.Context, userID string, data []byte, opts model.ImportOptions) (*model.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTransactions", ctx, userID, data, opts)
+	ret0, _ := ret[0].(*model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTransactions indicates an expected call of ImportTransactions.
+func (mr *MockTransactionServiceMockRecorder) ImportTransactions(ctx, userID, data, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTransactions", reflect.TypeOf((*MockTransactionService)(nil).ImportTransactions), ctx, userID, data, opts)
+}
+
+// ListTransactions mocks base method.
+func (m *MockTransactionService) ListTransactions(ctx context.Context, filter model.TransactionFilter) (*model.TransactionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, filter)
+	ret0, _ := ret[0].(*model.TransactionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockTransactionServiceMockRecorder) ListTransactions(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockTransactionService)(nil).ListTransactions), ctx, filter)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockTransactionService) UpdateTransaction(ctx context.Context, transactionID, userID string, patch *model.TransactionPatch) (*model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, transactionID, userID, patch)
+	ret0, _ := ret[0].(*model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockTransactionServiceMockRecorder) UpdateTransaction(ctx, transactionID, userID, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionService)(nil).UpdateTransaction), ctx, transactionID, userID, patch)
+}
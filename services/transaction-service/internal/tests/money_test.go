@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	cases := map[string]model.Money{
+		"0":          0,
+		"10":         1000,
+		"10.5":       1050,
+		"10.05":      1005,
+		"-0.01":      -1,
+		".99":        99,
+		"1234567.89": 123456789,
+	}
+	for in, want := range cases {
+		got, err := model.ParseMoney(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{"", "1.005", "1,5", "abc", "1.", "-", "1e3"} {
+		_, err := model.ParseMoney(in)
+		require.ErrorIs(t, err, model.ErrInvalidMoney, in)
+	}
+}
+
+func TestMoney_ScanNumeric(t *testing.T) {
+	var m model.Money
+	require.NoError(t, m.Scan([]byte("123.45")))
+	require.Equal(t, "123.45", m.String())
+
+	// SUM(numeric) может вернуть лишние нули в дробной части
+	require.NoError(t, m.Scan([]byte("-7.1000")))
+	require.Equal(t, "-7.10", m.String())
+
+	require.Error(t, m.Scan([]byte("7.105")))
+}
+
+func TestMoney_SumIsExact(t *testing.T) {
+	var sum model.Money
+	var floatSum float64
+	for i := 0; i < 1000; i++ {
+		sum += model.MoneyFromFloat(0.1)
+		floatSum += 0.1
+	}
+	require.Equal(t, "100.00", sum.String())
+	require.NotEqual(t, 100.0, floatSum)
+}
+
+func TestTransaction_MarshalJSON_ExactAmount(t *testing.T) {
+	amount, err := model.ParseMoney("1999.90")
+	require.NoError(t, err)
+	tx := &model.Transaction{
+		ID:        "tx1",
+		Amount:    amount,
+		Date:      time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+	data, err := json.Marshal(tx)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"amount":1999.90`)
+
+	var back model.Transaction
+	require.NoError(t, json.Unmarshal(data, &back))
+	require.Equal(t, amount, back.Amount)
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestRecurringRepo(t *testing.T) (repo.RecurringRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db := sqlx.NewDb(sqlDB, "sqlmock")
+	return repo.NewRecurringRepo(db, zap.NewNop()), mock
+}
+
+func TestRecurringUpdateState_Conflict(t *testing.T) {
+	r, mock := newTestRecurringRepo(t)
+	rt := &model.RecurringTransaction{ID: "rec-1", UserID: "user-1", Occurrences: 3, NextDate: time.Now(), UpdatedAt: time.Now()}
+	mock.ExpectExec(regexp.QuoteMeta(`WHERE id = $5 AND user_id = $6 AND occurrences = $7`)).
+		WithArgs(3, rt.NextDate, false, rt.UpdatedAt, "rec-1", "user-1", 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := r.UpdateState(context.Background(), rt, 2)
+	require.ErrorIs(t, err, repo.ErrRecurringNotFound)
+}
+
+func TestRecurringListDue(t *testing.T) {
+	r, mock := newTestRecurringRepo(t)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE NOT paused`)).
+		WithArgs(today).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "frequency", "repeat_interval", "max_count", "end_date", "next_date"}).
+			AddRow("rec-1", "MONTHLY", 1, nil, nil, today))
+
+	due, err := r.ListDue(context.Background(), today)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Nil(t, due[0].MaxCount)
+	require.Equal(t, model.FrequencyMonthly, due[0].Frequency)
+}
@@ -0,0 +1,191 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRecurringOccurrence_MonthEndClamp(t *testing.T) {
+	r := &model.RecurringTransaction{Frequency: model.FrequencyMonthly, Interval: 1, StartDate: date(2025, 1, 31)}
+	require.Equal(t, date(2025, 2, 28), r.Occurrence(1))
+	require.Equal(t, date(2025, 3, 31), r.Occurrence(2))
+	require.Equal(t, date(2025, 4, 30), r.Occurrence(3))
+
+	weekly := &model.RecurringTransaction{Frequency: model.FrequencyWeekly, Interval: 2, StartDate: date(2025, 1, 6)}
+	require.Equal(t, date(2025, 2, 3), weekly.Occurrence(2))
+
+	yearly := &model.RecurringTransaction{Frequency: model.FrequencyYearly, Interval: 1, StartDate: date(2024, 2, 29)}
+	require.Equal(t, date(2025, 2, 28), yearly.Occurrence(1))
+}
+
+func TestRecurringFinished(t *testing.T) {
+	count := 2
+	end := date(2025, 3, 15)
+	r := &model.RecurringTransaction{Frequency: model.FrequencyMonthly, Interval: 1, StartDate: date(2025, 1, 10), MaxCount: &count}
+	r.NextDate = r.Occurrence(0)
+	r.Advance()
+	require.False(t, r.Finished())
+	r.Advance()
+	require.True(t, r.Finished())
+
+	r = &model.RecurringTransaction{Frequency: model.FrequencyMonthly, Interval: 1, StartDate: date(2025, 1, 10), EndDate: &end}
+	r.Occurrences, r.NextDate = 2, date(2025, 3, 10)
+	require.False(t, r.Finished())
+	r.Advance()
+	require.True(t, r.Finished())
+}
+
+func newTestRecurringService(t *testing.T) (service.RecurringService, *MockRecurringRepository, *MockCategoryRepository, *MockTransactionService) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := NewMockRecurringRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	mockTransactions := NewMockTransactionService(ctrl)
+	s := service.NewRecurringService(mockRepo, mockCategories, mockTransactions, zap.NewNop())
+	return s, mockRepo, mockCategories, mockTransactions
+}
+
+func TestCreateRecurring_Defaults(t *testing.T) {
+	s, mockRepo, mockCategories, _ := newTestRecurringService(t)
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "rent").Return(&model.Category{ID: "rent", Type: model.Expense}, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	r, err := s.CreateRecurring(context.Background(), &model.RecurringTransaction{
+		UserID: "user-1", CategoryID: "rent", Type: model.Expense, Amount: 5000000,
+		Frequency: model.FrequencyMonthly, StartDate: time.Date(2025, 3, 5, 14, 30, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, r.ID)
+	require.Equal(t, 1, r.Interval)
+	require.Equal(t, "RUB", r.Currency)
+	require.Equal(t, date(2025, 3, 5), r.NextDate)
+}
+
+func TestCreateRecurring_Invalid(t *testing.T) {
+	s, _, _, _ := newTestRecurringService(t)
+
+	_, err := s.CreateRecurring(context.Background(), &model.RecurringTransaction{
+		UserID: "user-1", CategoryID: "rent", Type: model.Expense, Amount: 100, Frequency: "HOURLY", StartDate: date(2025, 3, 1),
+	})
+	require.ErrorIs(t, err, service.ErrInvalidRecurring)
+}
+
+func TestMaterializeDue_CatchesUpAndIsIdempotent(t *testing.T) {
+	s, mockRepo, _, mockTransactions := newTestRecurringService(t)
+
+	r := &model.RecurringTransaction{
+		ID: "rec-1", UserID: "user-1", CategoryID: "rent", Type: model.Expense, Amount: 5000000, Currency: "RUB",
+		Frequency: model.FrequencyMonthly, Interval: 1, StartDate: date(2025, 1, 5), Occurrences: 1, NextDate: date(2025, 2, 5),
+	}
+	mockRepo.EXPECT().ListDue(gomock.Any(), date(2025, 3, 10)).Return([]*model.RecurringTransaction{r}, nil)
+
+	// Февраль уже создан прошлым запуском, который упал до сдвига шаблона
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *model.Transaction) (string, error) {
+			require.Equal(t, date(2025, 2, 5), tx.Date)
+			require.Equal(t, "rec-1", tx.RecurringID)
+			return "", repo.ErrAlreadyExists
+		})
+	mockRepo.EXPECT().UpdateState(gomock.Any(), r, 1).Return(nil)
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *model.Transaction) (string, error) {
+			require.Equal(t, date(2025, 3, 5), tx.Date)
+			return "tx-3", nil
+		})
+	mockRepo.EXPECT().UpdateState(gomock.Any(), r, 2).Return(nil)
+
+	created, err := s.MaterializeDue(context.Background(), time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+	require.Equal(t, 3, r.Occurrences)
+	require.Equal(t, date(2025, 4, 5), r.NextDate)
+}
+
+func TestMaterializeDue_PausesOnArchivedCategory(t *testing.T) {
+	s, mockRepo, _, mockTransactions := newTestRecurringService(t)
+
+	r := &model.RecurringTransaction{
+		ID: "rec-1", UserID: "user-1", Frequency: model.FrequencyDaily, Interval: 1,
+		StartDate: date(2025, 3, 9), NextDate: date(2025, 3, 9),
+	}
+	mockRepo.EXPECT().ListDue(gomock.Any(), gomock.Any()).Return([]*model.RecurringTransaction{r}, nil)
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).Return("", service.ErrCategoryArchived)
+	mockRepo.EXPECT().UpdateState(gomock.Any(), r, 0).Return(nil)
+
+	created, err := s.MaterializeDue(context.Background(), date(2025, 3, 10))
+	require.NoError(t, err)
+	require.Zero(t, created)
+	require.True(t, r.Paused)
+	require.Equal(t, date(2025, 3, 9), r.NextDate)
+}
+
+func TestMaterializeDue_TransientErrorRetriesLater(t *testing.T) {
+	s, mockRepo, _, mockTransactions := newTestRecurringService(t)
+
+	r := &model.RecurringTransaction{ID: "rec-1", Frequency: model.FrequencyDaily, Interval: 1, StartDate: date(2025, 3, 10), NextDate: date(2025, 3, 10)}
+	mockRepo.EXPECT().ListDue(gomock.Any(), gomock.Any()).Return([]*model.RecurringTransaction{r}, nil)
+	mockTransactions.EXPECT().AddTransaction(gomock.Any(), gomock.Any()).Return("", errors.New("connection reset"))
+
+	created, err := s.MaterializeDue(context.Background(), date(2025, 3, 10))
+	require.NoError(t, err)
+	require.Zero(t, created)
+	require.Zero(t, r.Occurrences)
+	require.False(t, r.Paused)
+}
+
+func TestSetPaused_ResumeSkipsMissedOccurrences(t *testing.T) {
+	s, mockRepo, _, _ := newTestRecurringService(t)
+
+	today := model.GranularityDay.Truncate(time.Now())
+	start := today.AddDate(0, 0, -35)
+	r := &model.RecurringTransaction{
+		ID: "rec-1", UserID: "user-1", Frequency: model.FrequencyWeekly, Interval: 1,
+		StartDate: start, Occurrences: 1, NextDate: start.AddDate(0, 0, 7), Paused: true,
+	}
+	mockRepo.EXPECT().GetByID(gomock.Any(), "rec-1", "user-1").Return(r, nil)
+	mockRepo.EXPECT().UpdateState(gomock.Any(), r, 1).Return(nil)
+
+	got, err := s.SetPaused(context.Background(), "rec-1", "user-1", false)
+	require.NoError(t, err)
+	require.False(t, got.Paused)
+	require.Equal(t, today, got.NextDate)
+	require.Equal(t, 5, got.Occurrences)
+}
+
+func TestSkipNext(t *testing.T) {
+	s, mockRepo, _, _ := newTestRecurringService(t)
+
+	r := &model.RecurringTransaction{
+		ID: "rec-1", UserID: "user-1", Frequency: model.FrequencyMonthly, Interval: 1,
+		StartDate: date(2025, 1, 5), Occurrences: 2, NextDate: date(2025, 3, 5),
+	}
+	mockRepo.EXPECT().GetByID(gomock.Any(), "rec-1", "user-1").Return(r, nil)
+	mockRepo.EXPECT().UpdateState(gomock.Any(), r, 2).Return(nil)
+
+	got, err := s.SkipNext(context.Background(), "rec-1", "user-1")
+	require.NoError(t, err)
+	require.Equal(t, date(2025, 4, 5), got.NextDate)
+}
+
+func TestSkipNext_NotFound(t *testing.T) {
+	s, mockRepo, _, _ := newTestRecurringService(t)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "missing", "user-1").Return(nil, repo.ErrRecurringNotFound)
+	_, err := s.SkipNext(context.Background(), "missing", "user-1")
+	require.ErrorIs(t, err, repo.ErrRecurringNotFound)
+}
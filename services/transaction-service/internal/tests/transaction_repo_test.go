@@ -11,6 +11,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -30,14 +31,16 @@ func TestCreate_Success(t *testing.T) {
 		ID:          "tx1",
 		UserID:      "user-1",
 		CategoryID:  "cat-1",
+		AccountID:   "acc-1",
 		Type:        "INCOME",
 		Amount:      100.0,
+		Currency:    "USD",
 		Description: "Доход",
 		Date:        time.Now(),
 		CreatedAt:   time.Now(),
 	}
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions`)).
-		WithArgs(tx.ID, tx.UserID, tx.CategoryID, tx.Type, tx.Amount, tx.Description, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(tx.ID, tx.UserID, tx.CategoryID, tx.Type, tx.Amount, tx.Currency, tx.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", tx.AccountID, "", model.Money(0), "", "").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := r.Create(context.Background(), tx)
@@ -48,53 +51,21 @@ func TestCreate_Error(t *testing.T) {
 	r, mock := newTestRepo(t)
 	tx := &model.Transaction{ID: "tx2"}
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions`)).
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnError(sql.ErrConnDone)
 
 	err := r.Create(context.Background(), tx)
 	require.Error(t, err)
 }
 
-func TestListByUserID_Success(t *testing.T) {
+func TestCreate_RecurringDuplicate(t *testing.T) {
 	r, mock := newTestRepo(t)
-	userID := "user-1"
-	rows := sqlmock.NewRows([]string{"id", "user_id", "category_id", "type", "amount", "description", "date", "created_at"}).
-		AddRow("tx1", userID, "cat-1", "INCOME", 100.0, "Доход", time.Now(), time.Now()).
-		AddRow("tx2", userID, "cat-2", "EXPENSE", 50.0, "Расход", time.Now(), time.Now())
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
-		WithArgs(userID, 10, 0).
-		WillReturnRows(rows)
-
-	txs, err := r.ListByUserID(context.Background(), userID, 10, 0)
-	require.NoError(t, err)
-	require.Len(t, txs, 2)
-}
-
-func TestListByUserID_Empty(t *testing.T) {
-	r, mock := newTestRepo(t)
-	userID := "user-x"
-	rows := sqlmock.NewRows([]string{"id", "user_id", "category_id", "type", "amount", "description", "date", "created_at"})
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
-		WithArgs(userID, 10, 0).
-		WillReturnRows(rows)
-
-	txs, err := r.ListByUserID(context.Background(), userID, 10, 0)
-	require.NoError(t, err)
-	require.Len(t, txs, 0)
-}
-
-func TestListByUserID_Error(t *testing.T) {
-	r, mock := newTestRepo(t)
-	userID := "fail"
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
-		WithArgs(userID, 10, 0).
-		WillReturnError(sql.ErrConnDone)
+	tx := &model.Transaction{ID: "tx3", RecurringID: "rec-1"}
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO transactions`)).
+		WillReturnError(&pq.Error{Code: "23505", Constraint: "ux_transactions_recurring_date"})
 
-	txs, err := r.ListByUserID(context.Background(), userID, 10, 0)
-	require.Error(t, err)
-	require.Nil(t, txs)
+	err := r.Create(context.Background(), tx)
+	require.ErrorIs(t, err, repo.ErrAlreadyExists)
 }
 
 func TestDelete_Success(t *testing.T) {
@@ -124,9 +95,158 @@ func TestGetBalance_Error(t *testing.T) {
 		WithArgs(userID).
 		WillReturnError(sql.ErrConnDone)
 
-	income, expense, total, err := r.GetBalance(context.Background(), userID)
+	balances, err := r.GetBalance(context.Background(), userID)
 	require.Error(t, err)
-	require.Zero(t, income)
-	require.Zero(t, expense)
+	require.Empty(t, balances)
+}
+
+func TestUpdate_Success(t *testing.T) {
+	r, mock := newTestRepo(t)
+	tx := &model.Transaction{
+		ID:          "tx1",
+		UserID:      "user-1",
+		CategoryID:  "cat-1",
+		Type:        "EXPENSE",
+		Amount:      42.0,
+		Currency:    "RUB",
+		Description: "Исправлено",
+		Date:        time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE transactions t`)+`(?s).*`+regexp.QuoteMeta(`m.role IN ('owner', 'editor')`)).
+		WithArgs(tx.CategoryID, tx.AccountID, tx.Type, tx.Amount, tx.Currency, tx.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), tx.ID, tx.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := r.Update(context.Background(), tx)
+	require.NoError(t, err)
+}
+
+func TestUpdate_NotFound(t *testing.T) {
+	r, mock := newTestRepo(t)
+	tx := &model.Transaction{ID: "notx", UserID: "user-1"}
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE transactions`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := r.Update(context.Background(), tx)
+	require.ErrorIs(t, err, repo.ErrNotFound)
+}
+
+func TestList_WithFilters(t *testing.T) {
+	r, mock := newTestRepo(t)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	min := model.Money(1000)
+	filter := model.TransactionFilter{
+		UserID:      "user-1",
+		DateFrom:    &from,
+		Type:        model.Expense,
+		CategoryIDs: []string{"cat-1", "cat-2"},
+		AmountMin:   &min,
+		Search:      "50%",
+		SortBy:      model.SortByAmount,
+		Ascending:   true,
+		Limit:       10,
+		Offset:      20,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM transactions WHERE user_id = $1 AND date >= $2 AND type = $3 AND category_id = ANY($4) AND amount >= $5 AND description ILIKE '%' || $6 || '%'`)).
+		WithArgs("user-1", from, "EXPENSE", sqlmock.AnyArg(), min, `50\%`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(31))
+	mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY amount ASC, created_at ASC, id ASC`)).
+		WithArgs("user-1", from, "EXPENSE", sqlmock.AnyArg(), min, `50\%`, 10, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "category_id", "type", "amount", "description", "date", "created_at"}).
+			AddRow("tx1", "user-1", "cat-1", "EXPENSE", 12.0, "скидка 50%", time.Now(), time.Now()))
+
+	txs, total, err := r.List(context.Background(), filter)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.EqualValues(t, 31, total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestList_CountError(t *testing.T) {
+	r, mock := newTestRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*)`)).
+		WithArgs("fail").
+		WillReturnError(sql.ErrConnDone)
+
+	txs, total, err := r.List(context.Background(), model.TransactionFilter{UserID: "fail", SortBy: model.SortByDate, Limit: 10})
+	require.Error(t, err)
+	require.Nil(t, txs)
 	require.Zero(t, total)
 }
+
+func TestList_Cursor(t *testing.T) {
+	r, mock := newTestRepo(t)
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	created := day.Add(time.Hour)
+	filter := model.TransactionFilter{
+		UserID: "user-1",
+		SortBy: model.SortByDate,
+		Limit:  3,
+		Cursor: &model.Cursor{SortBy: model.SortByDate, Date: day, CreatedAt: created, ID: "tx9"},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM transactions WHERE user_id = $1`)).
+		WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (date, created_at, id) < ($2, $3, $4)`)).
+		WithArgs("user-1", day, created, "tx9", 3, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "category_id", "type", "amount", "description", "date", "created_at"}))
+
+	txs, total, err := r.List(context.Background(), filter)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+	require.EqualValues(t, 10, total)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBalance_ExactNumeric(t *testing.T) {
+	r, mock := newTestRepo(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT`)).
+		WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "income", "expense"}).
+			AddRow("RUB", []byte("100000.30"), []byte("99999.10")).
+			AddRow("USD", []byte("10.00"), []byte("0")))
+
+	balances, err := r.GetBalance(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	require.Equal(t, "RUB", balances[0].Currency)
+	require.Equal(t, "100000.30", balances[0].Income.String())
+	require.Equal(t, "99999.10", balances[0].Expense.String())
+	require.Equal(t, "1.20", balances[0].Balance().String())
+	require.Equal(t, "USD", balances[1].Currency)
+	require.Equal(t, "10.00", balances[1].Balance().String())
+}
+
+func TestReport_ByCategory(t *testing.T) {
+	r, mock := newTestRepo(t)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`date_trunc('month', date::timestamp) AS period`)+`(?s).*`+regexp.QuoteMeta(`GROUP BY period, currency, category_id`)).
+		WithArgs("user-1", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"period", "currency", "category_id", "income", "expense"}).
+			AddRow(from, "RUB", "cat-1", []byte("0.00"), []byte("150.25")))
+
+	rows, err := r.Report(context.Background(), model.ReportFilter{
+		UserID: "user-1", DateFrom: from, DateTo: to, Granularity: model.GranularityMonth, ByCategory: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "cat-1", rows[0].CategoryID)
+	require.Equal(t, "150.25", rows[0].Expense.String())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBalanceBefore(t *testing.T) {
+	r, mock := newTestRepo(t)
+	before := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE user_id = $1 AND date < $2`)).
+		WithArgs("user-1", before).
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "income", "expense"}).AddRow("RUB", []byte("10.00"), []byte("2.50")))
+
+	balances, err := r.GetBalanceBefore(context.Background(), "user-1", before)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	require.Equal(t, "7.50", balances[0].Balance().String())
+}
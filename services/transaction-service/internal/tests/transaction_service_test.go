@@ -8,7 +8,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/delivery"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/model"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
@@ -18,8 +22,9 @@ func TestAddTransaction_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	tx := &model.Transaction{
 		UserID:      "user-1",
@@ -30,18 +35,105 @@ func TestAddTransaction_Success(t *testing.T) {
 		Date:        time.Now(),
 	}
 
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", UserID: "user-1", Type: model.Income}, nil)
 	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
 	id, err := s.AddTransaction(context.Background(), tx)
 	require.NoError(t, err)
 	require.NotEmpty(t, id)
 }
 
+func TestAddTransaction_SystemCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	tx := &model.Transaction{
+		UserID:     "user-1",
+		CategoryID: "cat-sys",
+		Type:       model.Expense,
+		Amount:     10.0,
+		Date:       time.Now(),
+	}
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-sys").Return(&model.Category{ID: "cat-sys", Type: model.Expense}, nil)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := s.AddTransaction(context.Background(), tx)
+	require.NoError(t, err)
+}
+
+func TestAddTransaction_CategoryTypeMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	tx := &model.Transaction{
+		UserID:     "user-1",
+		CategoryID: "cat-1",
+		Type:       model.Income,
+		Amount:     100.0,
+		Date:       time.Now(),
+	}
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", UserID: "user-1", Type: model.Expense}, nil)
+	_, err := s.AddTransaction(context.Background(), tx)
+	require.ErrorIs(t, err, service.ErrCategoryTypeMismatch)
+}
+
+func TestAddTransaction_ForeignCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	tx := &model.Transaction{
+		UserID:     "user-1",
+		CategoryID: "cat-2",
+		Type:       model.Income,
+		Amount:     100.0,
+		Date:       time.Now(),
+	}
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-2").Return(&model.Category{ID: "cat-2", UserID: "user-2", Type: model.Income}, nil)
+	_, err := s.AddTransaction(context.Background(), tx)
+	require.ErrorIs(t, err, service.ErrCategoryForbidden)
+}
+
+func TestAddTransaction_CategoryNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	tx := &model.Transaction{
+		UserID:     "user-1",
+		CategoryID: "missing",
+		Type:       model.Income,
+		Amount:     100.0,
+		Date:       time.Now(),
+	}
+
+	mockCategories.EXPECT().GetByID(gomock.Any(), "missing").Return(nil, repo.ErrCategoryNotFound)
+	_, err := s.AddTransaction(context.Background(), tx)
+	require.ErrorIs(t, err, repo.ErrCategoryNotFound)
+}
+
 func TestAddTransaction_Invalid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	tx := &model.Transaction{
 		UserID:      "user-1",
@@ -60,30 +152,108 @@ func TestListTransactions_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	userID := "user-1"
 	want := []*model.Transaction{
 		{ID: "tx1", UserID: userID, Amount: 100},
 		{ID: "tx2", UserID: userID, Amount: 200},
 	}
-	mockRepo.EXPECT().ListByUserID(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(want, nil)
-	got, err := s.ListTransactions(context.Background(), userID, 0, 0)
+	mockRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f model.TransactionFilter) ([]*model.Transaction, int64, error) {
+			require.Equal(t, userID, f.UserID)
+			require.Equal(t, model.DefaultListLimit+1, f.Limit)
+			require.Equal(t, model.SortByDate, f.SortBy)
+			return want, 2, nil
+		})
+	page, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: userID})
 	require.NoError(t, err)
-	require.Len(t, got, 2)
+	require.Len(t, page.Transactions, 2)
+	require.EqualValues(t, 2, page.TotalCount)
+	require.Empty(t, page.NextPageToken)
+}
+
+func TestListTransactions_NextPageToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows := []*model.Transaction{
+		{ID: "tx3", UserID: "user-1", Date: day, CreatedAt: day.Add(3 * time.Hour)},
+		{ID: "tx2", UserID: "user-1", Date: day, CreatedAt: day.Add(2 * time.Hour)},
+		{ID: "tx1", UserID: "user-1", Date: day, CreatedAt: day.Add(time.Hour)},
+	}
+	mockRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(rows, int64(5), nil)
+	page, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "user-1", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Transactions, 2)
+	require.NotEmpty(t, page.NextPageToken)
+
+	// Следующая страница начинается строго после последней записи
+	mockRepo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f model.TransactionFilter) ([]*model.Transaction, int64, error) {
+			require.NotNil(t, f.Cursor)
+			require.Equal(t, "tx2", f.Cursor.ID)
+			require.True(t, f.Cursor.CreatedAt.Equal(day.Add(2*time.Hour)))
+			require.Zero(t, f.Offset)
+			return rows[2:], 5, nil
+		})
+	next, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "user-1", Limit: 2, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, next.Transactions, 1)
+	require.Empty(t, next.NextPageToken)
+}
+
+func TestListTransactions_InvalidPageToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	_, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "user-1", PageToken: "not-a-token"})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
+
+	token := (&model.Cursor{SortBy: model.SortByAmount, ID: "tx1"}).Encode()
+	_, err = s.ListTransactions(context.Background(), model.TransactionFilter{UserID: "user-1", PageToken: token})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
+}
+
+func TestListTransactions_InvalidFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	min, max := model.Money(50000), model.Money(10000)
+	_, err := s.ListTransactions(context.Background(), model.TransactionFilter{
+		UserID:    "user-1",
+		AmountMin: &min,
+		AmountMax: &max,
+	})
+	require.ErrorIs(t, err, service.ErrInvalidFilter)
 }
 
 func TestListTransactions_NotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	userID := "user-2"
-	mockRepo.EXPECT().ListByUserID(gomock.Any(), userID, gomock.Any(), gomock.Any()).Return(nil, repo.ErrNotFound)
-	got, err := s.ListTransactions(context.Background(), userID, 0, 0)
+	mockRepo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, int64(0), repo.ErrNotFound)
+	got, err := s.ListTransactions(context.Background(), model.TransactionFilter{UserID: userID})
 	require.Error(t, err)
 	require.Nil(t, got)
 }
@@ -92,8 +262,9 @@ func TestDeleteTransaction_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	txID := "tx-1"
 	userID := "user-1"
@@ -106,29 +277,125 @@ func TestDeleteTransaction_NotFound(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
 	txID := "tx-x"
 	userID := "user-x"
 	mockRepo.EXPECT().Delete(gomock.Any(), txID, userID).Return(repo.ErrNotFound)
 	err := s.DeleteTransaction(context.Background(), txID, userID)
 	require.Error(t, err)
+
+	// Ошибка доходит до клиента статусом, а не ответом с success=false
+	h := delivery.NewTransactionHandler(s, nil, nil, nil, nil, nil, nil, nil, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), txID, userID).Return(repo.ErrNotFound)
+	_, err = h.DeleteTransaction(context.Background(), &transactionpb.DeleteTransactionRequest{TransactionId: txID, UserId: userID})
+	require.Equal(t, codes.NotFound, status.Code(err))
 }
 
-func TestGetBalance_Success(t *testing.T) {
+func TestUpdateTransaction_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
 	logger := zap.NewNop()
-	s := service.NewTransactionService(mockRepo, logger)
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
 
-	userID := "user-1"
-	income, expense, total := 1000.0, 500.0, 500.0
-	mockRepo.EXPECT().GetBalance(gomock.Any(), userID).Return(income, expense, total, nil)
-	gotIncome, gotExpense, gotTotal, err := s.GetBalance(context.Background(), userID)
+	createdAt := time.Now().Add(-time.Hour)
+	existing := &model.Transaction{
+		ID:          "tx-1",
+		UserID:      "user-1",
+		CategoryID:  "cat-1",
+		Type:        model.Expense,
+		Amount:      100.0,
+		Description: "Опечатка",
+		Date:        time.Now(),
+		CreatedAt:   createdAt,
+	}
+	description := "Продукты"
+	amount := model.Money(15050)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "user-1").Return(existing, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	got, err := s.UpdateTransaction(context.Background(), "tx-1", "user-1", &model.TransactionPatch{
+		Description: &description,
+		Amount:      &amount,
+	})
 	require.NoError(t, err)
-	require.Equal(t, income, gotIncome)
-	require.Equal(t, expense, gotExpense)
-	require.Equal(t, total, gotTotal)
+	require.Equal(t, "Продукты", got.Description)
+	require.Equal(t, "150.50", got.Amount.String())
+	require.Equal(t, createdAt, got.CreatedAt)
+	require.True(t, got.UpdatedAt.After(createdAt))
+}
+
+func TestUpdateTransaction_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	existing := &model.Transaction{ID: "tx-1", UserID: "user-1", Type: model.Expense, Amount: 100.0, Date: time.Now()}
+	amount := model.Money(-500)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "user-1").Return(existing, nil)
+	_, err := s.UpdateTransaction(context.Background(), "tx-1", "user-1", &model.TransactionPatch{Amount: &amount})
+	require.ErrorIs(t, err, service.ErrInvalidTransaction)
+}
+
+func TestUpdateTransaction_TypeChangeChecksCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	existing := &model.Transaction{ID: "tx-1", UserID: "user-1", CategoryID: "cat-1", Type: model.Expense, Amount: 100.0, Date: time.Now()}
+	txType := model.Income
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-1", "user-1").Return(existing, nil)
+	mockCategories.EXPECT().GetByID(gomock.Any(), "cat-1").Return(&model.Category{ID: "cat-1", UserID: "user-1", Type: model.Expense}, nil)
+	_, err := s.UpdateTransaction(context.Background(), "tx-1", "user-1", &model.TransactionPatch{Type: &txType})
+	require.ErrorIs(t, err, service.ErrCategoryTypeMismatch)
+}
+
+func TestUpdateTransaction_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockTransactionRepository(ctrl)
+	mockCategories := NewMockCategoryRepository(ctrl)
+	logger := zap.NewNop()
+	s := service.NewTransactionService(mockRepo, mockCategories, nil, defaultAccounts(ctrl), logger)
+
+	description := "x"
+	mockRepo.EXPECT().GetByID(gomock.Any(), "tx-x", "user-1").Return(nil, repo.ErrNotFound)
+	_, err := s.UpdateTransaction(context.Background(), "tx-x", "user-1", &model.TransactionPatch{Description: &description})
+	require.ErrorIs(t, err, repo.ErrNotFound)
+}
+
+func TestListTransactionsHandler_DecimalAmountBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := NewMockTransactionService(ctrl)
+	h := delivery.NewTransactionHandler(svc, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Строка важнее double; граница без строки берётся из double
+	legacyMax := 200.0
+	svc.EXPECT().ListTransactions(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, f model.TransactionFilter) (*model.TransactionPage, error) {
+		require.Equal(t, model.Money(10000001), *f.AmountMin)
+		require.Equal(t, model.Money(20000), *f.AmountMax)
+		return &model.TransactionPage{}, nil
+	})
+	_, err := h.ListTransactions(context.Background(), &transactionpb.ListTransactionsRequest{
+		UserId:           "user-1",
+		AmountMinDecimal: "100000.01",
+		AmountMax:        &legacyMax,
+	})
+	require.NoError(t, err)
+
+	_, err = h.ListTransactions(context.Background(), &transactionpb.ListTransactionsRequest{UserId: "user-1", AmountMinDecimal: "1,5"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
+	"time"
 
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	transactionpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/transaction"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/auth"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/currency"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/delivery"
+	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/grpcclients"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/repo"
 	"github.com/khaldeezal/Finplan-structure/services/transaction-service/internal/service"
 
@@ -14,6 +20,7 @@ import (
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/joho/godotenv"
@@ -38,13 +45,85 @@ func main() {
 		logger.Fatal("failed to connect to database", zap.Error(err))
 	}
 
+	// Курсы валют для пересчёта баланса
+	ratesFile := os.Getenv("EXCHANGE_RATES_FILE")
+	if ratesFile == "" {
+		ratesFile = "rates.json"
+	}
+	rates, err := currency.NewFileProvider(ratesFile)
+	if err != nil {
+		logger.Fatal("failed to load exchange rates", zap.Error(err))
+	}
+
+	// Подключение к user-service (валюта профиля)
+	userConn, err := grpc.Dial(os.Getenv("USER_SERVICE_ADDR"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("failed to connect to user-service", zap.Error(err))
+	}
+	defer userConn.Close()
+	userClient := grpcclients.NewUserClient(userConn, logger)
+
+	// Подключение к auth-service: ключи подписи и отозванные токены для проверки access-токенов
+	authConn, err := grpc.Dial(os.Getenv("AUTH_SERVICE_ADDR"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("failed to connect to auth-service", zap.Error(err))
+	}
+	defer authConn.Close()
+	authClient := authpb.NewAuthServiceClient(authConn)
+	jwks := auth.NewJWKSCache(authClient, logger)
+	if err := jwks.Sync(context.Background()); err != nil {
+		logger.Warn("initial jwks sync failed", zap.Error(err))
+	}
+	go jwks.Run(context.Background(), syncInterval("JWKS_SYNC_INTERVAL", time.Minute))
+	revocations := auth.NewRevocationCache(authClient, logger)
+	if err := revocations.Sync(context.Background()); err != nil {
+		logger.Warn("initial revocation list sync failed", zap.Error(err))
+	}
+	go revocations.Run(context.Background(), syncInterval("REVOCATION_SYNC_INTERVAL", 10*time.Second))
+
 	// Сборка зависимостей
 	transactionRepo := repo.NewTransactionRepo(db, logger)
-	transactionService := service.NewTransactionService(transactionRepo, logger)
-	transactionHandler := delivery.NewTransactionHandler(transactionService)
+	categoryRepo := repo.NewCategoryRepo(db, logger)
+	budgetRepo := repo.NewBudgetRepo(db, logger)
+	recurringRepo := repo.NewRecurringRepo(db, logger)
+	householdRepo := repo.NewHouseholdRepo(db, logger)
+	accountRepo := repo.NewAccountRepo(db, logger)
+	goalRepo := repo.NewGoalRepo(db, logger)
+	loanRepo := repo.NewLoanRepo(db, logger)
+	transactionService := service.NewTransactionService(transactionRepo, categoryRepo, householdRepo, accountRepo, logger)
+	categoryService := service.NewCategoryService(categoryRepo, logger)
+	balanceService := service.NewBalanceService(transactionRepo, householdRepo, accountRepo, loanRepo, rates, userClient, logger)
+	budgetService := service.NewBudgetService(budgetRepo, categoryRepo, rates, logger)
+	recurringService := service.NewRecurringService(recurringRepo, categoryRepo, transactionService, logger)
+	householdService := service.NewHouseholdService(householdRepo, logger)
+	accountService := service.NewAccountService(accountRepo, logger)
+	goalService := service.NewGoalService(goalRepo, transactionRepo, transactionService, rates, logger)
+	loanService := service.NewLoanService(loanRepo, transactionRepo, transactionService, rates, logger)
+	transactionHandler := delivery.NewTransactionHandler(transactionService, categoryService, balanceService, budgetService, recurringService, householdService, accountService, goalService, loanService)
+
+	// Фоновое создание транзакций по повторяющимся шаблонам
+	recurringInterval, err := time.ParseDuration(os.Getenv("RECURRING_INTERVAL"))
+	if err != nil || recurringInterval <= 0 {
+		recurringInterval = time.Hour
+	}
+	go func() {
+		ticker := time.NewTicker(recurringInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := recurringService.MaterializeDue(context.Background(), time.Now()); err != nil {
+				logger.Error("recurring materializer failed", zap.Error(err))
+			}
+			<-ticker.C
+		}
+	}()
 
 	// gRPC сервер
-	grpcServer := grpc.NewServer()
+	// Каждый вызов выполняется от имени пользователя из проверенного access-токена
+	ownership := delivery.NewOwnership(auth.NewVerifier(jwks, revocations), logger)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(ownership.UnaryInterceptor),
+		grpc.StreamInterceptor(ownership.StreamInterceptor),
+	)
 	transactionpb.RegisterTransactionServiceServer(grpcServer, transactionHandler)
 
 	// Регистрация grpc reflection для поддержки grpcurl и других клиентов
@@ -64,3 +143,12 @@ func main() {
 		logger.Fatal("failed to serve gRPC", zap.Error(err))
 	}
 }
+
+// Период синхронизации из переменной окружения; пустое или неверное значение — def
+func syncInterval(env string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(env))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
@@ -0,0 +1,6 @@
+-- Системные категории удаляются, только если на них не ссылаются транзакции;
+-- используемые остаются, иначе откат упал бы на внешнем ключе
+DELETE FROM categories c
+WHERE c.user_id IS NULL
+  AND NOT EXISTS (SELECT 1 FROM transactions t WHERE t.category_id = c.id);
+ALTER TABLE categories DROP COLUMN IF EXISTS archived;
@@ -0,0 +1,10 @@
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Системные категории (user_id IS NULL) доступны всем пользователям
+INSERT INTO categories (id, name, description, type, user_id) VALUES
+    (gen_random_uuid(), 'Зарплата', 'Заработная плата', 'INCOME', NULL),
+    (gen_random_uuid(), 'Прочие доходы', NULL, 'INCOME', NULL),
+    (gen_random_uuid(), 'Продукты', NULL, 'EXPENSE', NULL),
+    (gen_random_uuid(), 'Транспорт', NULL, 'EXPENSE', NULL),
+    (gen_random_uuid(), 'Жильё', 'Аренда и коммунальные платежи', 'EXPENSE', NULL),
+    (gen_random_uuid(), 'Прочие расходы', NULL, 'EXPENSE', NULL);
@@ -0,0 +1,4 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- Для существующих записей время изменения совпадает со временем создания
+UPDATE transactions SET updated_at = created_at;
@@ -0,0 +1,3 @@
+-- Индекс под keyset-пагинацию списка транзакций: (date, created_at, id)
+CREATE INDEX IF NOT EXISTS idx_transactions_user_keyset
+    ON transactions (user_id, date DESC, created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+-- Валюта транзакции; существующие записи считаются рублёвыми
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'RUB';
@@ -0,0 +1,11 @@
+-- Месячный лимит расходов: один бюджет на категорию пользователя
+CREATE TABLE IF NOT EXISTS budgets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    category_id UUID NOT NULL REFERENCES categories(id),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, category_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ux_transactions_recurring_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurring_id;
+DROP TABLE IF EXISTS recurring_transactions;
@@ -0,0 +1,29 @@
+-- Шаблоны повторяющихся транзакций
+CREATE TABLE IF NOT EXISTS recurring_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    category_id UUID NOT NULL REFERENCES categories(id),
+    type VARCHAR(10) NOT NULL CHECK (type IN ('INCOME', 'EXPENSE')),
+    amount NUMERIC(14,2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    description TEXT NOT NULL DEFAULT '',
+    frequency VARCHAR(10) NOT NULL CHECK (frequency IN ('DAILY', 'WEEKLY', 'MONTHLY', 'YEARLY')),
+    repeat_interval INT NOT NULL DEFAULT 1 CHECK (repeat_interval > 0),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    max_count INT CHECK (max_count > 0),
+    occurrences INT NOT NULL DEFAULT 0,
+    next_date DATE NOT NULL,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Выборка шаблонов, по которым пора создавать транзакции
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_due
+    ON recurring_transactions (next_date) WHERE NOT paused;
+
+-- Связь транзакции с шаблоном. Уникальность (шаблон, дата) делает создание повторений идемпотентным
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS recurring_id UUID REFERENCES recurring_transactions(id) ON DELETE SET NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_transactions_recurring_date
+    ON transactions (recurring_id, date) WHERE recurring_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transactions_household_keyset;
+ALTER TABLE transactions DROP COLUMN IF EXISTS household_id;
+DROP TABLE IF EXISTS household_invitations;
+DROP TABLE IF EXISTS household_members;
+DROP TABLE IF EXISTS households;
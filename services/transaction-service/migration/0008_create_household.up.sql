@@ -0,0 +1,35 @@
+-- Общие бюджеты семьи
+CREATE TABLE IF NOT EXISTS households (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Участники: у бюджета ровно один owner, остальные editor или viewer
+CREATE TABLE IF NOT EXISTS household_members (
+    household_id UUID NOT NULL REFERENCES households(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id),
+    role VARCHAR(10) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    joined_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (household_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_household_members_user ON household_members (user_id);
+
+-- Одноразовые приглашения; хранится только SHA-256 кода
+CREATE TABLE IF NOT EXISTS household_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    household_id UUID NOT NULL REFERENCES households(id) ON DELETE CASCADE,
+    invited_by UUID NOT NULL REFERENCES users(id),
+    role VARCHAR(10) NOT NULL CHECK (role IN ('editor', 'viewer')),
+    code_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_by UUID REFERENCES users(id),
+    accepted_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Транзакция общего бюджета; автор остаётся в user_id
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS household_id UUID REFERENCES households(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_household_keyset
+    ON transactions (household_id, date DESC, created_at DESC, id DESC) WHERE household_id IS NOT NULL;
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_transactions_transfer_account;
+DROP INDEX IF EXISTS idx_transactions_account;
+DELETE FROM transactions WHERE type = 'TRANSFER';
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_transfer_check;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check CHECK (type IN ('INCOME', 'EXPENSE'));
+ALTER TABLE transactions ALTER COLUMN category_id SET NOT NULL;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_account_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS account_id;
+DROP TABLE IF EXISTS accounts;
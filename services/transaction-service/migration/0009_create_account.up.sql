@@ -0,0 +1,43 @@
+-- Счета пользователя: наличные, карты, накопления
+CREATE TABLE IF NOT EXISTS accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(10) NOT NULL CHECK (type IN ('CASH', 'CARD', 'SAVINGS', 'CREDIT', 'OTHER')),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    opening_balance NUMERIC(14,2) NOT NULL DEFAULT 0,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_accounts_user_id ON accounts (user_id);
+
+-- Основной счёт валюты, куда попадают транзакции без явного счёта
+CREATE UNIQUE INDEX IF NOT EXISTS ux_accounts_default ON accounts (user_id, currency) WHERE is_default;
+
+-- Существующие транзакции переносятся на основные счета по валютам
+INSERT INTO accounts (user_id, name, type, currency, is_default)
+SELECT DISTINCT user_id, 'Основной счёт', 'CASH', currency, TRUE FROM transactions
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS account_id UUID REFERENCES accounts(id);
+UPDATE transactions t SET account_id = a.id
+FROM accounts a
+WHERE t.account_id IS NULL AND a.user_id = t.user_id AND a.currency = t.currency AND a.is_default;
+ALTER TABLE transactions ALTER COLUMN account_id SET NOT NULL;
+
+-- Перевод между счетами — одна запись: списание amount со счёта account_id
+-- и зачисление transfer_amount на transfer_account_id (суммы различаются при разных валютах)
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS transfer_account_id UUID REFERENCES accounts(id);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS transfer_amount NUMERIC(14,2) CHECK (transfer_amount > 0);
+ALTER TABLE transactions ALTER COLUMN category_id DROP NOT NULL;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check CHECK (type IN ('INCOME', 'EXPENSE', 'TRANSFER'));
+ALTER TABLE transactions ADD CONSTRAINT transactions_transfer_check CHECK (
+    (type = 'TRANSFER' AND transfer_account_id IS NOT NULL AND transfer_amount IS NOT NULL
+        AND category_id IS NULL AND transfer_account_id <> account_id)
+    OR (type <> 'TRANSFER' AND transfer_account_id IS NULL AND transfer_amount IS NULL AND category_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account ON transactions (account_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_account ON transactions (transfer_account_id) WHERE transfer_account_id IS NOT NULL;
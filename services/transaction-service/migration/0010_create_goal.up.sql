@@ -0,0 +1,16 @@
+-- Цели накоплений
+CREATE TABLE IF NOT EXISTS goals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    name VARCHAR(100) NOT NULL,
+    target_amount NUMERIC(14,2) NOT NULL CHECK (target_amount > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    deadline DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_goals_user_id ON goals (user_id);
+
+-- Взнос в цель — привязанная транзакция или перевод
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS goal_id UUID REFERENCES goals(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_goal ON transactions (goal_id) WHERE goal_id IS NOT NULL;
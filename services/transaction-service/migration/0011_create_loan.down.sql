@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transactions_loan;
+ALTER TABLE transactions DROP COLUMN IF EXISTS loan_id;
+DROP TABLE IF EXISTS loans;
@@ -0,0 +1,19 @@
+-- Займы: выданные (LENT) и полученные (BORROWED)
+CREATE TABLE IF NOT EXISTS loans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    counterparty VARCHAR(100) NOT NULL,
+    direction VARCHAR(10) NOT NULL CHECK (direction IN ('LENT', 'BORROWED')),
+    schedule VARCHAR(20) NOT NULL DEFAULT 'ANNUITY' CHECK (schedule IN ('ANNUITY', 'DIFFERENTIATED')),
+    principal NUMERIC(14,2) NOT NULL CHECK (principal > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    annual_rate NUMERIC(6,3) NOT NULL DEFAULT 0 CHECK (annual_rate >= 0),
+    term_months INTEGER NOT NULL CHECK (term_months > 0),
+    start_date DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_loans_user_id ON loans (user_id);
+
+-- Погашение займа — привязанная транзакция
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS loan_id UUID REFERENCES loans(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_loan ON transactions (loan_id) WHERE loan_id IS NOT NULL;
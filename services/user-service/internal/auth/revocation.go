@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Проверка, отозван ли токен с данным jti
+type RevocationChecker interface {
+	IsRevoked(jti string) bool
+}
+
+// Запас при инкрементальной синхронизации: отзыв, закоммиченный чуть позже
+// своего revoked_at, всё равно попадёт в следующую выборку
+const revocationSyncOverlap = time.Minute
+
+// Локальная копия списка отозванных токенов auth-service.
+// Обновляется периодически, поэтому проверка токена не ходит по сети
+type RevocationCache struct {
+	client authpb.AuthServiceClient
+	logger *zap.Logger
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time // jti -> окончание срока токена
+	since   *timestamppb.Timestamp
+}
+
+func NewRevocationCache(client authpb.AuthServiceClient, logger *zap.Logger) *RevocationCache {
+	return &RevocationCache{
+		client:  client,
+		logger:  logger,
+		revoked: make(map[string]time.Time),
+	}
+}
+
+func (c *RevocationCache) IsRevoked(jti string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.revoked[jti]
+	return ok
+}
+
+// Загружает отзывы, появившиеся с прошлой синхронизации, и удаляет истёкшие записи
+func (c *RevocationCache) Sync(ctx context.Context) error {
+	c.mu.RLock()
+	since := c.since
+	c.mu.RUnlock()
+
+	resp, err := c.client.ListRevokedTokens(ctx, &authpb.ListRevokedTokensRequest{Since: since})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, t := range resp.GetTokens() {
+		c.revoked[t.GetJti()] = t.GetExpiresAt().AsTime()
+	}
+	for jti, expiresAt := range c.revoked {
+		if expiresAt.Before(now) {
+			delete(c.revoked, jti)
+		}
+	}
+	if resp.GetServerTime() != nil {
+		c.since = timestamppb.New(resp.GetServerTime().AsTime().Add(-revocationSyncOverlap))
+	}
+	return nil
+}
+
+// Синхронизирует кеш каждые interval до отмены ctx. При недоступности auth-service
+// продолжает работать с последним полученным списком
+func (c *RevocationCache) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Sync(ctx); err != nil {
+				c.logger.Warn("revocation list sync failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// Роль обычного пользователя; её же получают токены, выданные до появления ролей
+const RoleUser = "user"
+
+// Данные проверенного access-токена
+type Claims struct {
+	UserID string
+	Role   string
+}
+
+// Проверка access-токена auth-service
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+// Проверяет подпись по JWKS auth-service и отзыв по списку отозванных токенов,
+// так же как JWTMiddleware шлюза
+type Verifier struct {
+	keys    KeyResolver
+	revoked RevocationChecker
+}
+
+var _ TokenVerifier = (*Verifier)(nil)
+
+func NewVerifier(keys KeyResolver, revoked RevocationChecker) *Verifier {
+	return &Verifier{keys: keys, revoked: revoked}
+}
+
+// Принимает токен как есть или в виде "Bearer <token>"
+func (v *Verifier) Verify(ctx context.Context, tokenStr string) (*Claims, error) {
+	if parts := strings.SplitN(tokenStr, " ", 2); len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
+		tokenStr = parts[1]
+	}
+	if tokenStr == "" {
+		return nil, ErrInvalidToken
+	}
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := v.keys.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, errors.New("unexpected signing method")
+		}
+		return key.Key, nil
+	}, jwt.WithValidMethods(supportedAlgorithms))
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	userID, _ := claims["user_id"].(string)
+	jti, _ := claims["jti"].(string)
+	if userID == "" || jti == "" || v.revoked.IsRevoked(jti) {
+		return nil, ErrInvalidToken
+	}
+	role, _ := claims["role"].(string)
+	if role == "" {
+		role = RoleUser
+	}
+	return &Claims{UserID: userID, Role: role}, nil
+}
@@ -2,11 +2,15 @@ package delivery
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"log"
 
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	// userpb — gRPC API, сгенерированный из user.proto
 	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // gRPC интерфейс UserServiceServer, связывает delivery с бизнес-логикой
@@ -51,3 +55,39 @@ func (h *UserHandler) UpdateUserProfile(ctx context.Context, req *userpb.UpdateU
 
 	return &userpb.UpdateUserProfileResponse{Success: true}, nil
 }
+
+// Создаёт профиль нового пользователя; вызывается auth-service после регистрации
+func (h *UserHandler) CreateUserProfile(ctx context.Context, req *userpb.CreateUserProfileRequest) (*userpb.CreateUserProfileResponse, error) {
+	created, err := h.userService.CreateUser(ctx, domain.User{
+		ID:       req.GetUserId(),
+		Name:     req.GetName(),
+		Email:    req.GetEmail(),
+		Currency: req.GetCurrency(),
+		Language: req.GetLanguage(),
+	})
+	if err != nil {
+		log.Printf("failed to create user profile: %v", err)
+		return nil, err
+	}
+
+	return &userpb.CreateUserProfileResponse{Created: created}, nil
+}
+
+// Записывает новый email; вызывается auth-service после подтверждения адреса.
+// Если профиля ещё нет, возвращает NotFound, и auth-service повторит вызов позже
+func (h *UserHandler) UpdateUserEmail(ctx context.Context, req *userpb.UpdateUserEmailRequest) (*userpb.UpdateUserEmailResponse, error) {
+	// Без момента смены событие нельзя упорядочить с другими: AsTime дал бы 1970 год
+	if req.GetChangedAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "changed_at is required")
+	}
+	updated, err := h.userService.UpdateEmail(ctx, req.GetUserId(), req.GetEmail(), req.GetChangedAt().AsTime())
+	if err != nil {
+		log.Printf("failed to update user email: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "user profile not found")
+		}
+		return nil, err
+	}
+
+	return &userpb.UpdateUserEmailResponse{Updated: updated}, nil
+}
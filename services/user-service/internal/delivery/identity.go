@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ключи метаданных: access-токен пользователя ("Bearer <token>") и токен сервиса-источника
+const (
+	AuthorizationMetadataKey = "authorization"
+	ServiceTokenMetadataKey  = "x-service-token"
+)
+
+var serviceMethodPrefix = "/" + userpb.UserService_ServiceDesc.ServiceName + "/"
+
+// Методы, которые вызывает только auth-service: профиль создаётся и email
+// обновляется по событиям auth-service, а не по запросу пользователя
+var serviceOnlyMethods = map[string]bool{
+	userpb.UserService_CreateUserProfile_FullMethodName: true,
+	userpb.UserService_UpdateUserEmail_FullMethodName:   true,
+}
+
+// Запросы к профилю одного пользователя
+type userScoped interface {
+	GetUserId() string
+}
+
+// Проверка вызывающего в UserService. Служебные методы требуют токен сервиса,
+// остальные — проверенный access-токен владельца профиля
+type Ownership struct {
+	tokens       auth.TokenVerifier
+	serviceToken string
+}
+
+// serviceToken — общий секрет с auth-service; пустой закрывает служебные методы
+func NewOwnership(tokens auth.TokenVerifier, serviceToken string) *Ownership {
+	return &Ownership{tokens: tokens, serviceToken: serviceToken}
+}
+
+func metadataValue(ctx context.Context, key string) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(key)
+	if len(values) != 1 || values[0] == "" {
+		return "", false
+	}
+	return values[0], true
+}
+
+// Вызов от auth-service с верным токеном сервиса
+func (o *Ownership) checkService(ctx context.Context) error {
+	token, ok := metadataValue(ctx, ServiceTokenMetadataKey)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "service token required")
+	}
+	if o.serviceToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(o.serviceToken)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid service token")
+	}
+	return nil
+}
+
+// Вызов от владельца профиля: user_id запроса совпадает с пользователем из токена
+func (o *Ownership) checkOwner(ctx context.Context, req interface{}) error {
+	token, ok := metadataValue(ctx, AuthorizationMetadataKey)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "access token required")
+	}
+	claims, err := o.tokens.Verify(ctx, token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "invalid or expired token")
+	}
+	r, ok := req.(userScoped)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "request is not scoped to a user")
+	}
+	if r.GetUserId() != claims.UserID {
+		return status.Error(codes.PermissionDenied, "user_id does not match caller")
+	}
+	return nil
+}
+
+// Отклоняет вызовы UserService к чужому профилю и служебные вызовы без токена сервиса
+func (o *Ownership) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, serviceMethodPrefix) {
+		return handler(ctx, req)
+	}
+	var err error
+	if serviceOnlyMethods[info.FullMethod] {
+		err = o.checkService(ctx)
+	} else {
+		err = o.checkOwner(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
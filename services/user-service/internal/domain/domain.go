@@ -1,6 +1,15 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+// Настройки нового профиля, если при создании они не указаны
+const (
+	DefaultCurrency = "RUB"
+	DefaultLanguage = "ru"
+)
 
 // Данные профиля пользователя
 type User struct {
@@ -18,6 +27,12 @@ type UserService interface {
 
 	// Обновляет имя, язык и валюту пользователя
 	UpdateUser(ctx context.Context, user User) error
+
+	// Создаёт профиль нового пользователя; false, если профиль уже есть
+	CreateUser(ctx context.Context, user User) (bool, error)
+
+	// Записывает email, сменённый в auth-service; false, если уже записана более поздняя смена
+	UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error)
 }
 
 // Интерфейс работы с данными пользователя
@@ -27,4 +42,11 @@ type UserRepository interface {
 
 	// Update обновляет профиль пользователя.
 	Update(ctx context.Context, user User) error
+
+	// Create сохраняет профиль, если его ещё нет; false, если профиль уже был.
+	Create(ctx context.Context, user User) (bool, error)
+
+	// UpdateEmail меняет email, если changedAt новее последней записанной смены.
+	// Для отсутствующего профиля возвращает sql.ErrNoRows.
+	UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error)
 }
@@ -3,6 +3,11 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	"go.uber.org/zap"
@@ -12,6 +17,8 @@ import (
 type UserRepository interface {
 	FindByID(ctx context.Context, id string) (*domain.User, error)
 	Update(ctx context.Context, user domain.User) error
+	Create(ctx context.Context, user domain.User) (bool, error)
+	UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error)
 }
 
 // Реализует UserRepository, работает с PostgreSQL
@@ -29,7 +36,7 @@ func NewPostgresUserRepository(db *sql.DB, logger *zap.Logger) *PostgresUserRepo
 func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
 	r.logger.Info("FindByID called", zap.String("userID", id))
 
-	query := `SELECT id, name, email, currency, language FROM users WHERE id = $1`
+	query := `SELECT id, name, email, currency, language FROM user_profiles WHERE id = $1`
 	row := r.db.QueryRowContext(ctx, query, id)
 
 	var user domain.User
@@ -47,7 +54,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*doma
 func (r *PostgresUserRepository) Update(ctx context.Context, user domain.User) error {
 	r.logger.Info("Update called", zap.String("userID", user.ID))
 
-	query := `UPDATE users SET name = $1, currency = $2, language = $3 WHERE id = $4`
+	query := `UPDATE user_profiles SET name = $1, currency = $2, language = $3, updated_at = now() WHERE id = $4`
 	_, err := r.db.ExecContext(ctx, query, user.Name, user.Currency, user.Language, user.ID)
 	if err != nil {
 		r.logger.Error("Update failed", zap.String("userID", user.ID), zap.Error(err))
@@ -57,3 +64,106 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user domain.User) e
 	r.logger.Info("User updated", zap.String("userID", user.ID))
 	return nil
 }
+
+// Сохраняет профиль с ID пользователя из auth-service. Повторная вставка
+// того же ID ничего не меняет, поэтому вызов можно безопасно повторять
+func (r *PostgresUserRepository) Create(ctx context.Context, user domain.User) (bool, error) {
+	r.logger.Info("Create called", zap.String("userID", user.ID))
+
+	query := `INSERT INTO user_profiles (id, name, email, currency, language) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO NOTHING`
+	res, err := r.db.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.Currency, user.Language)
+	if err != nil {
+		r.logger.Error("Create failed", zap.String("userID", user.ID), zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Меняет email профиля. События о смене могут прийти не по порядку,
+// поэтому более раннее изменение не перезаписывает более позднее
+func (r *PostgresUserRepository) UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error) {
+	r.logger.Info("UpdateEmail called", zap.String("userID", id))
+
+	query := `UPDATE user_profiles SET email = $1, email_changed_at = $2, updated_at = now()
+		WHERE id = $3 AND (email_changed_at IS NULL OR email_changed_at < $2)`
+	res, err := r.db.ExecContext(ctx, query, email, changedAt.UTC(), id)
+	if err != nil {
+		r.logger.Error("UpdateEmail failed", zap.String("userID", id), zap.Error(err))
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return true, nil
+	}
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM user_profiles WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, sql.ErrNoRows
+	}
+	return false, nil
+}
+
+// Применяет по порядку миграции *.up.sql из files, которых ещё нет в user_schema_migrations
+// (база общая с auth-service, поэтому таблица версий своя). Базы, созданные
+// до учёта версий, проходят все миграции заново: они идемпотентны
+func RunMigrations(db *sql.DB, files fs.FS, logger *zap.Logger) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS user_schema_migrations (
+        version TEXT PRIMARY KEY,
+        applied_at TIMESTAMP NOT NULL DEFAULT now()
+    )`); err != nil {
+		logger.Error("failed to run migrations", zap.Error(err))
+		return err
+	}
+	names, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		version := strings.TrimSuffix(name, ".up.sql")
+		var applied bool
+		if err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM user_schema_migrations WHERE version = $1)`, version).Scan(&applied); err != nil {
+			logger.Error("failed to run migrations", zap.Error(err))
+			return err
+		}
+		if applied {
+			continue
+		}
+		query, err := fs.ReadFile(files, name)
+		if err != nil {
+			return err
+		}
+		if err := applyMigration(db, version, string(query)); err != nil {
+			logger.Error("failed to run migrations", zap.String("version", version), zap.Error(err))
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+		logger.Info("migration applied", zap.String("version", version))
+	}
+	return nil
+}
+
+// Выполняет миграцию и отмечает её применённой в одной транзакции
+func applyMigration(db *sql.DB, version, query string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(query); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO user_schema_migrations (version) VALUES ($1)`, version); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
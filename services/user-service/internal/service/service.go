@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	"go.uber.org/zap"
@@ -12,6 +13,8 @@ import (
 type UserService interface {
 	GetUserByID(ctx context.Context, id string) (*domain.User, error)
 	UpdateUser(ctx context.Context, user domain.User) error
+	CreateUser(ctx context.Context, user domain.User) (bool, error)
+	UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error)
 }
 
 // Реализация
@@ -54,3 +57,47 @@ func (s *userService) UpdateUser(ctx context.Context, user domain.User) error {
 	s.logger.Info("user updated", zap.String("userID", user.ID))
 	return nil
 }
+
+// Создаёт профиль зарегистрированного пользователя с валютой и языком по умолчанию,
+// если они не заданы. Существующий профиль не перезаписывается
+func (s *userService) CreateUser(ctx context.Context, user domain.User) (bool, error) {
+	s.logger.Info("CreateUser called", zap.String("userID", user.ID))
+
+	if user.ID == "" || user.Email == "" {
+		err := errors.New("missing user ID or email")
+		s.logger.Error("create failed", zap.Error(err))
+		return false, err
+	}
+	if user.Currency == "" {
+		user.Currency = domain.DefaultCurrency
+	}
+	if user.Language == "" {
+		user.Language = domain.DefaultLanguage
+	}
+	created, err := s.repo.Create(ctx, user)
+	if err != nil {
+		s.logger.Error("failed to create user", zap.String("userID", user.ID), zap.Error(err))
+		return false, err
+	}
+	s.logger.Info("user profile provisioned", zap.String("userID", user.ID), zap.Bool("created", created))
+	return created, nil
+}
+
+// Записывает email, подтверждённый в auth-service. Пользователь сам email не меняет:
+// вызов приходит из auth-service после подтверждения нового адреса
+func (s *userService) UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error) {
+	s.logger.Info("UpdateEmail called", zap.String("userID", id))
+
+	if id == "" || email == "" || changedAt.IsZero() {
+		err := errors.New("missing user ID, email or change time")
+		s.logger.Error("update email failed", zap.Error(err))
+		return false, err
+	}
+	updated, err := s.repo.UpdateEmail(ctx, id, email, changedAt)
+	if err != nil {
+		s.logger.Error("failed to update email", zap.String("userID", id), zap.Error(err))
+		return false, err
+	}
+	s.logger.Info("user email synced", zap.String("userID", id), zap.Bool("updated", updated))
+	return updated, nil
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/auth"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/delivery"
+)
+
+const testKID = "test-key"
+
+var testSigningKey = func() ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// Набор ключей из одного тестового ключа вместо JWKS auth-service
+type staticKeys map[string]*auth.PublicKey
+
+func (s staticKeys) Key(_ context.Context, kid string) (*auth.PublicKey, error) {
+	if key, ok := s[kid]; ok {
+		return key, nil
+	}
+	return nil, auth.ErrUnknownKey
+}
+
+type revokedSet map[string]bool
+
+func (s revokedSet) IsRevoked(jti string) bool { return s[jti] }
+
+var ownership = delivery.NewOwnership(auth.NewVerifier(
+	staticKeys{testKID: {ID: testKID, Algorithm: "EdDSA", Key: testSigningKey.Public()}},
+	revokedSet{},
+), "service-secret")
+
+func userContext(userID string) context.Context {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"user_id": userID,
+		"jti":     userID + "-jti",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = testKID
+	tokenStr, _ := token.SignedString(testSigningKey)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(delivery.AuthorizationMetadataKey, "Bearer "+tokenStr))
+}
+
+func serviceContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(delivery.ServiceTokenMetadataKey, token))
+}
+
+func TestOwnershipUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: userpb.UserService_GetUserProfile_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	resp, err := ownership.UnaryInterceptor(userContext("user-1"), &userpb.GetUserProfileRequest{UserId: "user-1"}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = ownership.UnaryInterceptor(userContext("user-1"), &userpb.GetUserProfileRequest{UserId: "user-2"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ownership.UnaryInterceptor(context.Background(), &userpb.GetUserProfileRequest{UserId: "user-1"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Заголовок x-user-id без токена больше не подтверждает пользователя
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "user-1"))
+	_, err = ownership.UnaryInterceptor(forged, &userpb.GetUserProfileRequest{UserId: "user-1"}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestOwnershipUnaryInterceptor_ServiceOnlyMethods(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	for _, method := range []string{userpb.UserService_CreateUserProfile_FullMethodName, userpb.UserService_UpdateUserEmail_FullMethodName} {
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		req := &userpb.UpdateUserEmailRequest{UserId: "user-1"}
+
+		_, err := ownership.UnaryInterceptor(serviceContext("service-secret"), req, info, handler)
+		assert.NoError(t, err, method)
+
+		// Токен самого пользователя не даёт создать профиль или сменить email
+		_, err = ownership.UnaryInterceptor(userContext("user-1"), req, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), method)
+
+		_, err = ownership.UnaryInterceptor(serviceContext("guess"), req, info, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), method)
+	}
+
+	// Без настроенного токена служебные методы закрыты
+	closed := delivery.NewOwnership(auth.NewVerifier(staticKeys{}, revokedSet{}), "")
+	_, err := closed.UnaryInterceptor(serviceContext("service-secret"), &userpb.CreateUserProfileRequest{UserId: "user-1"},
+		&grpc.UnaryServerInfo{FullMethod: userpb.UserService_CreateUserProfile_FullMethodName}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -12,6 +12,7 @@ package tests
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateUser mocks base method.
+func (m *MockUserService) CreateUser(ctx context.Context, user domain.User) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserServiceMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserService)(nil).CreateUser), ctx, user)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserService) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +85,18 @@ func (mr *MockUserServiceMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserService)(nil).UpdateUser), ctx, user)
 }
+
+// UpdateEmail mocks base method.
+func (m *MockUserService) UpdateEmail(ctx context.Context, id, email string, changedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, email, changedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail.
+func (mr *MockUserServiceMockRecorder) UpdateEmail(ctx, id, email, changedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserService)(nil).UpdateEmail), ctx, id, email, changedAt)
+}
@@ -2,13 +2,17 @@ package tests
 
 import (
 	"context"
+	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/repo"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/migrations"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"regexp"
 	"testing"
+	"testing/fstest"
+	"time"
 )
 
 func TestFindByID_Success(t *testing.T) {
@@ -30,7 +34,7 @@ func TestFindByID_Success(t *testing.T) {
 	rows := sqlmock.NewRows([]string{"id", "name", "email", "currency", "language"}).
 		AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Email, expectedUser.Currency, expectedUser.Language)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email, currency, language FROM users WHERE id = $1")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, email, currency, language FROM user_profiles WHERE id = $1")).
 		WithArgs(expectedUser.ID).
 		WillReturnRows(rows)
 
@@ -39,3 +43,88 @@ func TestFindByID_Success(t *testing.T) {
 	assert.Equal(t, expectedUser, user)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestCreate_Idempotent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	userRepo := repo.NewPostgresUserRepository(db, zap.NewNop())
+	user := domain.User{ID: "user-123", Name: "Вася", Email: "vasya@example.com", Currency: "RUB", Language: "ru"}
+
+	query := regexp.QuoteMeta("INSERT INTO user_profiles (id, name, email, currency, language) VALUES ($1, $2, $3, $4, $5)")
+	mock.ExpectExec(query).
+		WithArgs(user.ID, user.Name, user.Email, user.Currency, user.Language).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(query).
+		WithArgs(user.ID, user.Name, user.Email, user.Currency, user.Language).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	created, err := userRepo.Create(context.Background(), user)
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = userRepo.Create(context.Background(), user)
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateEmail_IgnoresOutdatedChange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	userRepo := repo.NewPostgresUserRepository(db, zap.NewNop())
+	changedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	update := regexp.QuoteMeta("UPDATE user_profiles SET email = $1, email_changed_at = $2, updated_at = now()")
+	exists := regexp.QuoteMeta("SELECT EXISTS (SELECT 1 FROM user_profiles WHERE id = $1)")
+	mock.ExpectExec(update).WithArgs("new@example.com", changedAt, "user-123").WillReturnResult(sqlmock.NewResult(0, 1))
+	// Уже записана более поздняя смена
+	mock.ExpectExec(update).WithArgs("old@example.com", changedAt.Add(-time.Hour), "user-123").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(exists).WithArgs("user-123").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	// Профиль ещё не создан
+	mock.ExpectExec(update).WithArgs("new@example.com", changedAt, "user-404").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(exists).WithArgs("user-404").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	updated, err := userRepo.UpdateEmail(context.Background(), "user-123", "new@example.com", changedAt)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	updated, err = userRepo.UpdateEmail(context.Background(), "user-123", "old@example.com", changedAt.Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	_, err = userRepo.UpdateEmail(context.Background(), "user-404", "new@example.com", changedAt)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunMigrations_AppliesPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	files := fstest.MapFS{
+		"0001_a.up.sql": {Data: []byte("CREATE TABLE a ()")},
+		"0002_b.up.sql": {Data: []byte("CREATE TABLE b ()")},
+	}
+	applied := regexp.QuoteMeta(`SELECT EXISTS (SELECT 1 FROM user_schema_migrations WHERE version = $1)`)
+
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS user_schema_migrations`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(applied).WithArgs("0001_a").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(applied).WithArgs("0002_b").WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE b ()`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO user_schema_migrations`)).WithArgs("0002_b").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.RunMigrations(db, files, zap.NewNop()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Перенос профилей из старой таблицы users входит во встроенные миграции
+	data, err := migrations.Files.ReadFile("0002_create_user_profiles.up.sql")
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "INSERT INTO user_profiles")
+}
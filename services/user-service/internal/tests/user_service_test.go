@@ -7,7 +7,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/delivery"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/domain"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/service"
 )
@@ -33,3 +37,45 @@ func TestGetUserByID_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUser, *user)
 }
+
+func TestCreateUser_AppliesDefaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockUserRepository(ctrl)
+	userService := service.NewUserService(mockRepo, zap.NewNop())
+
+	mockRepo.EXPECT().Create(gomock.Any(), domain.User{
+		ID:       "user-123",
+		Name:     "Андрей",
+		Email:    "test@mail.com",
+		Currency: domain.DefaultCurrency,
+		Language: domain.DefaultLanguage,
+	}).Return(true, nil)
+
+	created, err := userService.CreateUser(context.Background(), domain.User{
+		ID:    "user-123",
+		Name:  "Андрей",
+		Email: "test@mail.com",
+	})
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestCreateUser_MissingID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockUserRepository(ctrl)
+	userService := service.NewUserService(mockRepo, zap.NewNop())
+
+	_, err := userService.CreateUser(context.Background(), domain.User{Email: "test@mail.com"})
+	assert.Error(t, err)
+}
+
+func TestUpdateUserEmail_RequiresChangedAt(t *testing.T) {
+	// Сервис не нужен: запрос отклоняется до него
+	h := delivery.NewUserHandler(nil)
+	_, err := h.UpdateUserEmail(context.Background(), &userpb.UpdateUserEmailRequest{UserId: "user-123", Email: "new@mail.com"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -1,19 +1,25 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"net"
 	"os"
+	"time"
 
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	authpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/auth"
 	userpb "github.com/khaldeezal/Finplan-proto/proto-definitions/gen/user"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/auth"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/delivery"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/repo"
 	"github.com/khaldeezal/Finplan-structure/services/user-service/internal/service"
+	"github.com/khaldeezal/Finplan-structure/services/user-service/migrations"
 )
 
 func main() {
@@ -25,12 +31,20 @@ func main() {
 	defer logger.Sync()
 
 	// Подключаемся к PostgreSQL
-	db, err := sql.Open("postgres", "host=localhost port=5432 user=postgres password=rewq1234 dbname=khaldee sslmode=disable")
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		dsn = "host=localhost port=5432 user=postgres password=rewq1234 dbname=khaldee sslmode=disable"
+	}
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
 	defer db.Close()
 
+	if err := repo.RunMigrations(db, migrations.Files, logger); err != nil {
+		logger.Fatal("Failed to run migrations", zap.Error(err))
+	}
+
 	addr := os.Getenv("USER_SERVICE_ADDR")
 	if addr == "" {
 		addr = ":50053"
@@ -42,7 +56,34 @@ func main() {
 		logger.Fatal("Failed to open port", zap.Error(err))
 	}
 
-	grpcServer := grpc.NewServer()
+	// Общий секрет с auth-service для создания профиля и синхронизации email
+	serviceToken := os.Getenv("SERVICE_TOKEN")
+	if serviceToken == "" {
+		logger.Fatal("SERVICE_TOKEN is required")
+	}
+
+	// Ключи подписи и отозванные токены auth-service для проверки access-токенов
+	authConn, err := grpc.Dial(os.Getenv("AUTH_SERVICE_ADDR"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("Failed to connect to auth-service", zap.Error(err))
+	}
+	defer authConn.Close()
+	authClient := authpb.NewAuthServiceClient(authConn)
+	jwks := auth.NewJWKSCache(authClient, logger)
+	if err := jwks.Sync(context.Background()); err != nil {
+		logger.Warn("initial jwks sync failed", zap.Error(err))
+	}
+	go jwks.Run(context.Background(), syncInterval("JWKS_SYNC_INTERVAL", time.Minute))
+	revocations := auth.NewRevocationCache(authClient, logger)
+	if err := revocations.Sync(context.Background()); err != nil {
+		logger.Warn("initial revocation list sync failed", zap.Error(err))
+	}
+	go revocations.Run(context.Background(), syncInterval("REVOCATION_SYNC_INTERVAL", 10*time.Second))
+
+	// Профиль доступен только самому пользователю из проверенного access-токена,
+	// создание профиля и смена email — только auth-service по токену сервиса
+	ownership := delivery.NewOwnership(auth.NewVerifier(jwks, revocations), serviceToken)
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(ownership.UnaryInterceptor))
 
 	// Инициализируем зависимости
 	userRepo := repo.NewPostgresUserRepository(db, logger)
@@ -57,3 +98,12 @@ func main() {
 		logger.Fatal("gRPC server terminated with error", zap.Error(err))
 	}
 }
+
+// Период синхронизации из переменной окружения; пустое или неверное значение — def
+func syncInterval(env string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(env))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
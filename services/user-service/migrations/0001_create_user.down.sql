@@ -1 +1,2 @@
-DROP TABLE IF EXISTS users;
+-- users принадлежит auth-service и здесь не удаляется
+SELECT 1;
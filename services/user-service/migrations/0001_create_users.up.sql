@@ -1,11 +1,4 @@
+-- Таблица users принадлежит auth-service (база общая). Раньше здесь создавалась
+-- её копия с полями профиля; профили теперь в user_profiles (0002), которая
+-- переносит данные из такой копии, если она осталась
 CREATE EXTENSION IF NOT EXISTS "pgcrypto";
-
-CREATE TABLE IF NOT EXISTS users (
-    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    name TEXT NOT NULL,
-    email TEXT UNIQUE NOT NULL,
-    currency TEXT NOT NULL,
-    language TEXT NOT NULL,
-    created_at TIMESTAMP DEFAULT now(),
-    updated_at TIMESTAMP DEFAULT now()
-);
\ No newline at end of file
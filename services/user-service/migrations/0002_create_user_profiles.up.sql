@@ -0,0 +1,21 @@
+-- Профили хранятся в отдельной таблице: users принадлежит auth-service.
+-- ID профиля совпадает с ID пользователя в auth-service
+CREATE TABLE IF NOT EXISTS user_profiles (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    currency TEXT NOT NULL,
+    language TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Переносим профили из старой таблицы users, если она была создана этим сервисом
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users' AND column_name = 'currency') THEN
+        INSERT INTO user_profiles (id, name, email, currency, language, created_at, updated_at)
+        SELECT id, name, email, currency, language, created_at, updated_at FROM users
+        ON CONFLICT (id) DO NOTHING;
+    END IF;
+END $$;
@@ -0,0 +1,3 @@
+-- Момент последней смены email в auth-service: события о смене
+-- могут прийти не по порядку, и более старое не должно перезаписать новое
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS email_changed_at TIMESTAMP;